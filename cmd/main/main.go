@@ -1,40 +1,148 @@
 package main
 
 import (
-	"net/http"
+	"context"
+	"fmt"
+	"os"
+	"time"
 
 	_ "github.com/lib/pq"
 
-	"github.com/go-playground/validator"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/env"
+	"openapi/internal/infra/repository/memory"
+	memoryTransactionInfra "openapi/internal/infra/repository/memory/stock/transaction"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
+	sqliteTransactionInfra "openapi/internal/infra/repository/sqlite/stock/transaction"
+	"openapi/internal/ui/admin"
+	"openapi/internal/ui/docs"
 	hello "openapi/internal/ui/hello"
+	"openapi/internal/ui/problem"
 	stock "openapi/internal/ui/stock"
+	"openapi/internal/ui/timeout"
+	"openapi/internal/ui/validation"
 )
 
-type CustomValidator struct {
-	validator *validator.Validate
-}
-
-func (cv *CustomValidator) Validate(i interface{}) error {
-	if err := cv.validator.Struct(i); err != nil {
-		// Optionally, you could return the error to give each route more control over the status code
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+func main() {
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(context.Background(), os.Args[2:], os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
 	}
-	return nil
-}
 
-func main() {
 	e := echo.New()
 
+	repositories, unitOfWork, closeDb, err := openRepositories(env.GetDbDriver())
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+	defer closeDb()
+
+	helloSwagger, err := hello.GetSwagger()
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	stockSwagger, err := stock.GetSwagger()
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
+	requestValidator, err := validation.RequestMiddleware(helloSwagger, stockSwagger)
+	if err != nil {
+		e.Logger.Fatal(err)
+	}
+
 	e.Use(middleware.Logger())
 	e.Use(middleware.Recover())
+	if mode := env.GetResponseValidation(); mode != "off" {
+		responseValidator, err := validation.ResponseMiddleware(mode == "strict", helloSwagger, stockSwagger)
+		if err != nil {
+			e.Logger.Fatal(err)
+		}
+		e.Use(responseValidator)
+	}
+	e.Use(timeout.Middleware(env.GetRequestTimeout()))
+	e.Use(requestValidator)
+	e.Use(admin.Middleware(env.GetAdminToken()))
+
+	e.Validator = validation.New()
+	e.HTTPErrorHandler = problem.HTTPErrorHandler
 
-	e.Validator = &CustomValidator{validator: validator.New()}
+	apis := map[string]*openapi3.T{
+		"hello": helloSwagger,
+		"stock": stockSwagger,
+	}
+	if err := docs.RegisterHandlers(e, env.GetServiceUrl(), apis); err != nil {
+		e.Logger.Fatal(err)
+	}
 
 	hello.RegisterHandlers(e, hello.New())
-	stock.RegisterHandlers(e, stock.New())
+	stock.RegisterHandlers(e, stock.New(repositories, unitOfWork, uuid.New, time.Now))
+
+	if days := env.GetStockLocationRetentionDays(); days > 0 {
+		go runRetention(context.Background(), unitOfWork, days, e.Logger)
+	}
 
 	e.Logger.Fatal(e.Start(":1323"))
 }
+
+// openRepositories returns the repositories of the driver and how to close its database.
+// The memory driver keeps the data in the process, so it is lost when the server stops.
+func openRepositories(driver string) (transaction.IRepositories, transaction.IUnitOfWork, func() error, error) {
+	if driver == "memory" {
+		db := memory.Open()
+
+		repositories, err := memoryTransactionInfra.NewRepositories(db)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		unitOfWork, err := memoryTransactionInfra.NewUnitOfWork(db)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+
+		return repositories, unitOfWork, func() error { return nil }, nil
+	}
+
+	db, err := database.Open()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	if err := checkSchema(context.Background(), db, driver, env.GetDbSchemaCheck()); err != nil {
+		db.Close()
+		return nil, nil, nil, err
+	}
+
+	var (
+		repositories transaction.IRepositories
+		unitOfWork   transaction.IUnitOfWork
+	)
+	if driver == "sqlite" {
+		repositories, err = sqliteTransactionInfra.NewRepositories(db)
+		if err == nil {
+			unitOfWork, err = sqliteTransactionInfra.NewUnitOfWork(db)
+		}
+	} else {
+		repositories, err = transactionInfra.NewRepositories(db)
+		if err == nil {
+			unitOfWork, err = transactionInfra.NewUnitOfWork(db)
+		}
+	}
+	if err != nil {
+		db.Close()
+		return nil, nil, nil, err
+	}
+
+	return repositories, unitOfWork, db.Close, nil
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/database/migration"
+	"openapi/internal/infra/env"
+)
+
+const migrateUsage = "usage: main migrate up | down [N] | status | version"
+
+// runMigrate runs the migrate subcommand: up applies every pending migration, down reverts the last N, 1 by default,
+// status lists the migrations and whether they are applied, and version prints the version of the database.
+func runMigrate(ctx context.Context, args []string, out io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	db, err := database.Open()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	m, err := migration.New(db, env.GetDbDriver())
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		if len(args) != 1 {
+			return errors.New(migrateUsage)
+		}
+		applied, err := m.Up(ctx)
+		for _, a := range applied {
+			fmt.Fprintf(out, "applied %06d_%s\n", a.Version, a.Name)
+		}
+		return err
+
+	case "down":
+		n := 1
+		if len(args) == 2 {
+			n, err = strconv.Atoi(args[1])
+			if err != nil || n < 1 {
+				return fmt.Errorf("migrate down: invalid number of migrations %s", args[1])
+			}
+		} else if len(args) > 2 {
+			return errors.New(migrateUsage)
+		}
+		reverted, err := m.Down(ctx, n)
+		for _, r := range reverted {
+			fmt.Fprintf(out, "reverted %06d_%s\n", r.Version, r.Name)
+		}
+		return err
+
+	case "status":
+		status, err := m.Status(ctx)
+		if err != nil {
+			return err
+		}
+		for _, s := range status {
+			state := "pending"
+			if s.Applied {
+				state = "applied"
+			}
+			fmt.Fprintf(out, "%06d_%s\t%s\n", s.Version, s.Name, state)
+		}
+		return nil
+
+	case "version":
+		version, dirty, err := m.Version(ctx)
+		if err != nil {
+			return err
+		}
+		if dirty {
+			fmt.Fprintf(out, "%d (dirty)\n", version)
+		} else {
+			fmt.Fprintf(out, "%d\n", version)
+		}
+		return nil
+
+	default:
+		return errors.New(migrateUsage)
+	}
+}
+
+// checkSchema does what mode asks when the database is not at the version of the migrations:
+// "strict" fails and "migrate" applies them.
+func checkSchema(ctx context.Context, db *sql.DB, driver string, mode string) error {
+	if mode == "off" {
+		return nil
+	}
+
+	m, err := migration.New(db, driver)
+	if err != nil {
+		return err
+	}
+
+	if mode == "migrate" {
+		if _, err := m.Up(ctx); err != nil {
+			return err
+		}
+	}
+
+	return m.Check(ctx)
+}
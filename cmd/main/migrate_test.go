@@ -0,0 +1,50 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunMigrate(t *testing.T) {
+	// Setup
+	t.Setenv("DB_DRIVER", "sqlite")
+	t.Setenv("DB_DSN", "file:"+filepath.Join(t.TempDir(), "test.db")+"?_txlock=immediate")
+
+	// When
+	var out bytes.Buffer
+	for _, args := range [][]string{{"up"}, {"version"}, {"down"}, {"status"}} {
+		if err := runMigrate(context.Background(), args, &out); err != nil {
+			t.Fatalf("%v: %v", args, err)
+		}
+	}
+
+	// Then
+	want := "applied 000001_stock\napplied 000002_stock_location_deleted_at\napplied 000003_stock_location_name_unique\n" +
+		"applied 000004_stock_location_parent\napplied 000005_stock_capacity\napplied 000006_stock_item_catalog\n" +
+		"applied 000007_stock_lot\napplied 000008_stock_serial\napplied 000009_stock_location_name_repair\n9\n" +
+		"reverted 000009_stock_location_name_repair\n" +
+		"000001_stock\tapplied\n000002_stock_location_deleted_at\tapplied\n000003_stock_location_name_unique\tapplied\n" +
+		"000004_stock_location_parent\tapplied\n000005_stock_capacity\tapplied\n000006_stock_item_catalog\tapplied\n" +
+		"000007_stock_lot\tapplied\n000008_stock_serial\tapplied\n000009_stock_location_name_repair\tpending\n"
+	if out.String() != want {
+		t.Errorf("%T %q want %q", out.String(), out.String(), want)
+	}
+}
+
+func TestRunMigrateFail(t *testing.T) {
+	// Setup
+	t.Setenv("DB_DRIVER", "sqlite")
+	t.Setenv("DB_DSN", "file:"+filepath.Join(t.TempDir(), "test.db")+"?_txlock=immediate")
+
+	for _, args := range [][]string{{}, {"sideways"}, {"down", "0"}, {"up", "1"}} {
+		// When
+		err := runMigrate(context.Background(), args, &bytes.Buffer{})
+
+		// Then
+		if err == nil {
+			t.Errorf("%v: error must not be nil", args)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	"openapi/internal/domain/stock/transaction"
+)
+
+// retentionInterval is how often deleted stock locations past their retention are purged.
+const retentionInterval = 24 * time.Hour
+
+// runRetention purges the stock locations deleted more than days ago, at once and then every retentionInterval
+// until ctx is done. Locations that still have stock on hand, child locations or history are kept and logged.
+func runRetention(ctx context.Context, u transaction.IUnitOfWork, days int, logger echo.Logger) {
+	ticker := time.NewTicker(retentionInterval)
+	defer ticker.Stop()
+
+	for {
+		purgeExpired(ctx, u, time.Now().AddDate(0, 0, -days), logger)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func purgeExpired(ctx context.Context, u transaction.IUnitOfWork, deletedBefore time.Time, logger echo.Logger) {
+	resDto, err := app.PurgeDeleted(ctx, &app.PurgeDeletedRequestDto{DeletedBefore: deletedBefore, Limit: 100}, u)
+	if err != nil {
+		logger.Errorf("retention: %v", err)
+		return
+	}
+
+	if len(resDto.Purged) > 0 {
+		logger.Infof("retention: purged stock locations %v", resDto.Purged)
+	}
+	if len(resDto.Refused) > 0 {
+		logger.Warnf("retention: kept stock locations still in use %v", resDto.Refused)
+	}
+}
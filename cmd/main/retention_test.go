@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/infra/repository/memory"
+	memoryTransactionInfra "openapi/internal/infra/repository/memory/stock/transaction"
+)
+
+func TestPurgeExpired(t *testing.T) {
+	// Setup
+	db := memory.Open()
+
+	repositories, err := memoryTransactionInfra.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unitOfWork, err := memoryTransactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := location.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := location.NewAggregate(id, name)
+	a.Delete()
+	if err := repositories.Location().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	purgeExpired(context.Background(), unitOfWork, time.Now().Add(time.Hour), echo.New().Logger)
+
+	// Then
+	if _, err := repositories.Location().Get(context.Background(), id); !errors.Is(err, location.ErrNotFound) {
+		t.Errorf("%T = %v, want %v", err, err, location.ErrNotFound)
+	}
+}
@@ -0,0 +1,117 @@
+package item
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+)
+
+type CreateRequestDto struct {
+	Sku  string
+	Name string
+	// BaseUnit is what the quantities of the item are counted in, each when empty.
+	BaseUnit  string
+	Barcodes  []string
+	PackSizes []PackSizeDto
+	// UnitVolume in cubic centimetres and UnitWeight in grams are zero when the item does not declare them.
+	UnitVolume int64
+	UnitWeight int64
+	// LotTracked items have every movement name its lot.
+	LotTracked bool
+	// Serialized items have every movement name the serials of its units.
+	Serialized bool
+}
+
+type CreateResponseDto struct {
+	Id         uuid.UUID
+	Sku        string
+	Name       string
+	BaseUnit   string
+	Barcodes   []string
+	PackSizes  []PackSizeDto
+	UnitVolume int64
+	UnitWeight int64
+	LotTracked bool
+	Serialized bool
+}
+
+func Create(ctx context.Context, req *CreateRequestDto, r item.IRepository, newId uuid.UUID) (*CreateResponseDto, error) {
+	// Precondition
+	sku, err := item.NewSku(req.Sku)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := item.NewName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	baseUnit := item.Each
+	if req.BaseUnit != "" {
+		baseUnit, err = item.NewUnit(req.BaseUnit)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	barcodes, err := newBarcodes(req.Barcodes)
+	if err != nil {
+		return nil, err
+	}
+
+	packSizes, err := newPackSizes(req.PackSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	unitVolume, err := measure.NewVolume(req.UnitVolume)
+	if err != nil {
+		return nil, err
+	}
+
+	unitWeight, err := measure.NewWeight(req.UnitWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	// Main
+	id, err := item.NewId(newId)
+	if err != nil {
+		return nil, err
+	}
+
+	a := item.NewAggregate(id, sku, name, baseUnit)
+	a.UnitVolume = unitVolume
+	a.UnitWeight = unitWeight
+	a.LotTracked = req.LotTracked
+	a.Serialized = req.Serialized
+
+	if err := a.SetBarcodes(barcodes); err != nil {
+		return nil, err
+	}
+
+	if err := a.SetPackSizes(packSizes); err != nil {
+		return nil, err
+	}
+
+	if err := r.Save(ctx, a); err != nil {
+		return nil, err
+	}
+
+	return &CreateResponseDto{
+		Id:         a.Id.UUID(),
+		Sku:        a.Sku.String(),
+		Name:       a.Name.String(),
+		BaseUnit:   a.BaseUnit().String(),
+		Barcodes:   newBarcodeDtos(a.Barcodes()),
+		PackSizes:  newPackSizeDtos(a.PackSizes()),
+		UnitVolume: a.UnitVolume.Int64(),
+		UnitWeight: a.UnitWeight.Int64(),
+		LotTracked: a.LotTracked,
+		Serialized: a.Serialized,
+	}, nil
+}
@@ -0,0 +1,301 @@
+package item_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/item"
+	domain "openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+	"openapi/internal/infra/database"
+	mock "openapi/internal/infra/mock/domain/stock/item"
+	infra "openapi/internal/infra/repository/sqlboiler/stock/item"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・エラーが発生しないこと
+// ・Create関数で作成したItemをリポジトリで取得できること
+// ・作成時に設定したItemの名前が一致すること
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	id, err := domain.NewId(resDto.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := repository.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Name.String() != reqDto.Name {
+		t.Errorf("%T = %v, want %v", a.Name.String(), a.Name.String(), reqDto.Name)
+	}
+}
+
+func TestCreateFailInvalidName(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: "",
+	}
+
+	// When
+	_, err = app.Create(context.Background(), reqDto, repository, uuid.New())
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestCreateFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	// When
+	_, err = app.Create(context.Background(), reqDto, repository, uuid.Nil)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestCreateFailSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	// When
+	_, err := app.Create(context.Background(), reqDto, repository, uuid.New())
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+// テスト観点
+// ・単位あたりの容積と重量が保存されること
+// ・負の容積や重量はエラーとなり、保存されないこと
+func TestCreateMeasures(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	var saved *domain.Aggregate
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *domain.Aggregate) error {
+		saved = a
+		return nil
+	})
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Sku:        uuid.NewString(),
+		Name:       "TestName" + uuid.NewString(),
+		UnitVolume: 1500,
+		UnitWeight: 250,
+	}
+	invalidDto := &app.CreateRequestDto{
+		Sku:        uuid.NewString(),
+		Name:       "TestName" + uuid.NewString(),
+		UnitWeight: -1,
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, invalid := app.Create(context.Background(), invalidDto, repository, uuid.New())
+
+	// Then
+	if resDto.UnitVolume != 1500 || resDto.UnitWeight != 250 {
+		t.Errorf("%T = %+v, want volume 1500 and weight 250", resDto, resDto)
+	}
+
+	if saved == nil || saved.UnitVolume.Int64() != 1500 || saved.UnitWeight.Int64() != 250 {
+		t.Errorf("%T = %+v, want volume 1500 and weight 250", saved, saved)
+	}
+
+	if !errors.Is(invalid, measure.ErrInvalidWeight) {
+		t.Errorf("%T = %v, want %v", invalid, invalid, measure.ErrInvalidWeight)
+	}
+}
+
+// テスト観点
+// ・SKUが大文字に正規化されて保存されること
+// ・基本単位を省略した場合はeachとなること
+// ・バーコードと荷姿、ロット管理とシリアル管理の有無が保存されること
+func TestCreateCatalog(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	repository := newMemory(t)
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Sku:        " tee-blue-m ",
+		Name:       "TestName" + uuid.NewString(),
+		Barcodes:   []string{"4006381333931", "96385074"},
+		PackSizes:  []app.PackSizeDto{{Unit: "Case", Quantity: 12}},
+		LotTracked: true,
+		Serialized: true,
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	want := &app.CreateResponseDto{
+		Id:         resDto.Id,
+		Sku:        "TEE-BLUE-M",
+		Name:       reqDto.Name,
+		BaseUnit:   "each",
+		Barcodes:   []string{"4006381333931", "96385074"},
+		PackSizes:  []app.PackSizeDto{{Unit: "case", Quantity: 12}},
+		LotTracked: true,
+		Serialized: true,
+	}
+	if !reflect.DeepEqual(resDto, want) {
+		t.Errorf("%T = %+v, want %+v", resDto, resDto, want)
+	}
+
+	id, err := domain.NewId(resDto.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := repository.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := a.ToBase(a.PackSizes()[0].Unit(), 2); err != nil || got != 24 {
+		t.Errorf("%T = %v %v, want 24", got, got, err)
+	}
+
+	if !a.LotTracked || !a.Serialized {
+		t.Errorf("%T = %+v, want lot tracked and serialized", a, a)
+	}
+}
+
+// テスト観点
+// ・SKU、バーコード、単位、荷姿が不正な場合はエラーとなり、保存されないこと
+// ・有効なItemと同じSKUはErrSkuTakenとなること
+func TestCreateFailCatalog(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	repository := newMemory(t)
+
+	taken := &app.CreateRequestDto{Sku: "TAKEN-1", Name: "TestName" + uuid.NewString()}
+	if _, err := app.Create(context.Background(), taken, repository, uuid.New()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		reqDto *app.CreateRequestDto
+		err    error
+	}{
+		{&app.CreateRequestDto{Sku: "", Name: "test"}, domain.ErrInvalidSku},
+		{&app.CreateRequestDto{Sku: "A 1", Name: "test"}, domain.ErrInvalidSku},
+		{&app.CreateRequestDto{Sku: uuid.NewString(), Name: "test", Barcodes: []string{"4006381333932"}}, domain.ErrInvalidBarcode},
+		{&app.CreateRequestDto{Sku: uuid.NewString(), Name: "test", Barcodes: []string{"96385074", "96385074"}}, domain.ErrDuplicateBarcode},
+		{&app.CreateRequestDto{Sku: uuid.NewString(), Name: "test", BaseUnit: "kilo gram"}, domain.ErrInvalidUnit},
+		{&app.CreateRequestDto{Sku: uuid.NewString(), Name: "test", PackSizes: []app.PackSizeDto{{Unit: "case", Quantity: 1}}}, domain.ErrInvalidPackSize},
+		{&app.CreateRequestDto{Sku: uuid.NewString(), Name: "test", PackSizes: []app.PackSizeDto{{Unit: "each", Quantity: 6}}}, domain.ErrDuplicateUnit},
+		{&app.CreateRequestDto{Sku: "taken-1", Name: "test"}, domain.ErrSkuTaken},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := app.Create(context.Background(), tt.reqDto, repository, uuid.New())
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, err, err, tt.err)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package item
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type DeleteRequestDto struct {
+	Id uuid.UUID
+	// Versions are the versions the caller expects the stock item to be at, nil to skip the check.
+	Versions []int64
+}
+
+func Delete(ctx context.Context, req *DeleteRequestDto, u transaction.IUnitOfWork) error {
+	// Precondition
+	id, err := item.NewId(req.Id)
+	if err != nil {
+		return err
+	}
+
+	return u.Do(ctx, func(r transaction.IRepositories) error {
+		a, err := r.Item().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := a.CheckVersion(req.Versions); err != nil {
+			return err
+		}
+
+		// Main
+		a.Delete()
+
+		if err = r.Item().Save(ctx, a); err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
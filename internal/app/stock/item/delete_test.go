@@ -0,0 +1,206 @@
+package item_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/item"
+	domain "openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+	"openapi/internal/infra/database"
+	mock "openapi/internal/infra/mock/domain/stock/item"
+	infra "openapi/internal/infra/repository/sqlboiler/stock/item"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqCreateDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: uuid.NewString(),
+	}
+
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	reqDeleteDto := &app.DeleteRequestDto{
+		Id: resCreateDto.Id,
+	}
+
+	if err := app.Delete(context.Background(), reqDeleteDto, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	id, err := domain.NewId(resCreateDto.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := repository.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.IsDeleted() {
+		t.Errorf("%T = %v, want %v", a.IsDeleted(), a.IsDeleted(), false)
+	}
+}
+
+func TestDeleteFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.DeleteRequestDto{
+		Id: uuid.Nil,
+	}
+
+	// When
+	err = app.Delete(context.Background(), reqDto, unitOfWork)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestDeleteFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.DeleteRequestDto{
+		Id: uuid.New(),
+	}
+
+	// When
+	err = app.Delete(context.Background(), reqDto, unitOfWork)
+
+	// Then
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
+
+func TestDeleteFailSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.NewAggregate(id, domain.Sku{}, name, domain.Each)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
+
+	// Given
+	reqDto := &app.DeleteRequestDto{
+		Id: id.UUID(),
+	}
+
+	// When
+	err = app.Delete(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+// テスト観点
+// ・期待するバージョンと一致しない場合はErrVersionMismatchとなり、保存されないこと
+func TestDeleteFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, domain.Sku{}, name, domain.Each, nil, nil, measure.Volume{}, measure.Weight{}, false, false, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.DeleteRequestDto{
+		Id:       id.UUID(),
+		Versions: []int64{1},
+	}
+
+	// When
+	err = app.Delete(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrVersionMismatch)
+	}
+}
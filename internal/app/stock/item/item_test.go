@@ -0,0 +1,39 @@
+package item_test
+
+import (
+	"context"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/transaction"
+	mock "openapi/internal/infra/mock/domain/stock/item"
+	mock_transaction "openapi/internal/infra/mock/domain/stock/transaction"
+	"openapi/internal/infra/repository/memory"
+	memoryInfra "openapi/internal/infra/repository/memory/stock/item"
+
+	"github.com/golang/mock/gomock"
+)
+
+// newUnitOfWork returns a unit of work that runs the use case against repository.
+func newUnitOfWork(ctrl *gomock.Controller, repository *mock.MockIRepository) *mock_transaction.MockIUnitOfWork {
+	tx := mock_transaction.NewMockIRepositories(ctrl)
+	tx.EXPECT().Item().Return(repository).AnyTimes()
+
+	u := mock_transaction.NewMockIUnitOfWork(ctrl)
+	u.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(r transaction.IRepositories) error) error {
+			return fn(tx)
+		},
+	).AnyTimes()
+
+	return u
+}
+
+// newMemory returns an empty stock item repository kept in memory.
+func newMemory(t *testing.T) item.IRepository {
+	r, err := memoryInfra.NewRepository(memory.Open())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return r
+}
@@ -0,0 +1,197 @@
+package item
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+var ErrInvalidCursor = failure.Validation("invalid_cursor", "invalid cursor")
+
+type ListRequestDto struct {
+	Limit int
+	After string
+	// Sku limits the list to the stock item with the SKU, and Barcode to the stock items with the barcode.
+	// Neither applies when empty.
+	Sku     string
+	Barcode string
+}
+
+type ListResponseDto struct {
+	Items      []*ListItemDto
+	NextCursor string
+}
+
+type ListItemDto struct {
+	Id         uuid.UUID
+	Sku        string
+	Name       string
+	BaseUnit   string
+	Barcodes   []string
+	PackSizes  []PackSizeDto
+	UnitVolume int64
+	UnitWeight int64
+	LotTracked bool
+	Serialized bool
+}
+
+// PackSizeDto tells how many base units one Unit holds.
+type PackSizeDto struct {
+	Unit     string
+	Quantity int64
+}
+
+// List returns the active stock items ordered by SKU.
+func List(ctx context.Context, req *ListRequestDto, r item.IRepository) (*ListResponseDto, error) {
+	// Precondition
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit < 0 || limit > MaxListLimit {
+		return nil, errors.New("invalid limit")
+	}
+
+	var after *item.Sku
+	if req.After != "" {
+		c, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, err
+		}
+		after = c
+	}
+
+	var sku *item.Sku
+	if req.Sku != "" {
+		v, err := item.NewSku(req.Sku)
+		if err != nil {
+			return nil, err
+		}
+		sku = &v
+	}
+
+	var barcode *item.Barcode
+	if req.Barcode != "" {
+		v, err := item.NewBarcode(req.Barcode)
+		if err != nil {
+			return nil, err
+		}
+		barcode = &v
+	}
+
+	// Main
+	// One extra row is fetched to find out whether a next page exists.
+	as, err := r.List(ctx, item.ListQuery{
+		Sku:     sku,
+		Barcode: barcode,
+		After:   after,
+		Limit:   limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListResponseDto{
+		Items: make([]*ListItemDto, 0, len(as)),
+	}
+
+	if len(as) > limit {
+		as = as[:limit]
+		res.NextCursor = encodeCursor(as[len(as)-1])
+	}
+
+	for _, a := range as {
+		res.Items = append(res.Items, newListItemDto(a))
+	}
+
+	return res, nil
+}
+
+func newListItemDto(a *item.Aggregate) *ListItemDto {
+	return &ListItemDto{
+		Id:         a.Id.UUID(),
+		Sku:        a.Sku.String(),
+		Name:       a.Name.String(),
+		BaseUnit:   a.BaseUnit().String(),
+		Barcodes:   newBarcodeDtos(a.Barcodes()),
+		PackSizes:  newPackSizeDtos(a.PackSizes()),
+		UnitVolume: a.UnitVolume.Int64(),
+		UnitWeight: a.UnitWeight.Int64(),
+		LotTracked: a.LotTracked,
+		Serialized: a.Serialized,
+	}
+}
+
+func newBarcodeDtos(bs []item.Barcode) []string {
+	vs := make([]string, 0, len(bs))
+	for _, b := range bs {
+		vs = append(vs, b.String())
+	}
+	return vs
+}
+
+func newBarcodes(vs []string) ([]item.Barcode, error) {
+	bs := make([]item.Barcode, 0, len(vs))
+	for _, v := range vs {
+		b, err := item.NewBarcode(v)
+		if err != nil {
+			return nil, err
+		}
+		bs = append(bs, b)
+	}
+	return bs, nil
+}
+
+func newPackSizeDtos(ps []item.PackSize) []PackSizeDto {
+	dtos := make([]PackSizeDto, 0, len(ps))
+	for _, p := range ps {
+		dtos = append(dtos, PackSizeDto{Unit: p.Unit().String(), Quantity: p.Quantity()})
+	}
+	return dtos
+}
+
+func newPackSizes(dtos []PackSizeDto) ([]item.PackSize, error) {
+	ps := make([]item.PackSize, 0, len(dtos))
+	for _, dto := range dtos {
+		unit, err := item.NewUnit(dto.Unit)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := item.NewPackSize(unit, dto.Quantity)
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+	return ps, nil
+}
+
+// The SKUs of the active items are unique, so the SKU of the last item of a page is the cursor.
+func encodeCursor(a *item.Aggregate) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(a.Sku.String()))
+}
+
+func decodeCursor(v string) (*item.Sku, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	sku, err := item.NewSku(string(b))
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &sku, nil
+}
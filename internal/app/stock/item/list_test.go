@@ -0,0 +1,131 @@
+package item_test
+
+import (
+	"context"
+	"errors"
+	app "openapi/internal/app/stock/item"
+	domain "openapi/internal/domain/stock/item"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・SKUまたはバーコードで絞り込めること
+// ・SKU順にカーソルでページングできること
+// ・削除されたItemは含まれないこと
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	repository := newMemory(t)
+
+	// Given
+	for _, reqDto := range []*app.CreateRequestDto{
+		{Sku: "B-2", Name: "b", Barcodes: []string{"4006381333931"}},
+		{Sku: "A-1", Name: "a", Barcodes: []string{"4006381333931", "96385074"}},
+		{Sku: "C-3", Name: "c"},
+	} {
+		if _, err := app.Create(context.Background(), reqDto, repository, uuid.New()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deleted, err := app.Create(context.Background(), &app.CreateRequestDto{Sku: "D-4", Name: "d", Barcodes: []string{"96385074"}}, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(deleted.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := repository.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Delete()
+	if err := repository.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		reqDto *app.ListRequestDto
+		want   []string
+		next   bool
+	}{
+		{&app.ListRequestDto{}, []string{"A-1", "B-2", "C-3"}, false},
+		{&app.ListRequestDto{Sku: "b-2"}, []string{"B-2"}, false},
+		{&app.ListRequestDto{Barcode: "4006381333931"}, []string{"A-1", "B-2"}, false},
+		{&app.ListRequestDto{Barcode: "96385074"}, []string{"A-1"}, false},
+		{&app.ListRequestDto{Sku: "C-3", Barcode: "4006381333931"}, []string{}, false},
+		{&app.ListRequestDto{Limit: 2}, []string{"A-1", "B-2"}, true},
+	}
+
+	for _, tt := range tests {
+		// When
+		resDto, err := app.List(context.Background(), tt.reqDto, repository)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		got := []string{}
+		for _, item := range resDto.Items {
+			got = append(got, item.Sku)
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, got, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%+v %T = %v, want %v", tt.reqDto, got, got, tt.want)
+			}
+		}
+
+		if (resDto.NextCursor != "") != tt.next {
+			t.Errorf("%+v %T = %q, want next page %v", tt.reqDto, resDto.NextCursor, resDto.NextCursor, tt.next)
+		}
+
+		if tt.next {
+			next, err := app.List(context.Background(), &app.ListRequestDto{Limit: 2, After: resDto.NextCursor}, repository)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(next.Items) != 1 || next.Items[0].Sku != "C-3" || next.NextCursor != "" {
+				t.Errorf("%T = %+v, want the last page with C-3", next, next)
+			}
+		}
+	}
+}
+
+func TestListFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	repository := newMemory(t)
+
+	tests := []struct {
+		reqDto *app.ListRequestDto
+		err    error
+	}{
+		{&app.ListRequestDto{After: "!"}, app.ErrInvalidCursor},
+		{&app.ListRequestDto{Sku: "A 1"}, domain.ErrInvalidSku},
+		{&app.ListRequestDto{Barcode: "4006381333932"}, domain.ErrInvalidBarcode},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := app.List(context.Background(), tt.reqDto, repository)
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, err, err, tt.err)
+		}
+	}
+
+	if _, err := app.List(context.Background(), &app.ListRequestDto{Limit: app.MaxListLimit + 1}, repository); err == nil {
+		t.Errorf("error must not be nil")
+	}
+}
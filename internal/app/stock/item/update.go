@@ -0,0 +1,157 @@
+package item
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type UpdateRequestDto struct {
+	Id uuid.UUID
+	// Sku keeps the current SKU when nil.
+	Sku  *string
+	Name string
+	// Barcodes and PackSizes keep the current ones when nil, and remove them all when empty.
+	// The base unit of a stock item never changes.
+	Barcodes  *[]string
+	PackSizes *[]PackSizeDto
+	// Versions are the versions the caller expects the stock item to be at, nil to skip the check.
+	Versions []int64
+	// UnitVolume and UnitWeight keep the current measures when nil.
+	UnitVolume *int64
+	UnitWeight *int64
+	// LotTracked keeps the current setting when nil. It only changes while the stock item has no stock,
+	// as the stock on hand is counted either per lot or without one.
+	LotTracked *bool
+	// Serialized keeps the current setting when nil. Like LotTracked, it only changes while the stock item
+	// has no stock, as the units on hand either all have their serials registered or none do.
+	Serialized *bool
+}
+
+type UpdateResponseDto struct {
+	Version int64
+}
+
+func Update(ctx context.Context, req *UpdateRequestDto, u transaction.IUnitOfWork) (*UpdateResponseDto, error) {
+	// Precondition
+	id, err := item.NewId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var newSku *item.Sku
+	if req.Sku != nil {
+		v, err := item.NewSku(*req.Sku)
+		if err != nil {
+			return nil, err
+		}
+		newSku = &v
+	}
+
+	newName, err := item.NewName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	var barcodes []item.Barcode
+	if req.Barcodes != nil {
+		barcodes, err = newBarcodes(*req.Barcodes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var packSizes []item.PackSize
+	if req.PackSizes != nil {
+		packSizes, err = newPackSizes(*req.PackSizes)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var newUnitVolume *measure.Volume
+	if req.UnitVolume != nil {
+		v, err := measure.NewVolume(*req.UnitVolume)
+		if err != nil {
+			return nil, err
+		}
+		newUnitVolume = &v
+	}
+
+	var newUnitWeight *measure.Weight
+	if req.UnitWeight != nil {
+		v, err := measure.NewWeight(*req.UnitWeight)
+		if err != nil {
+			return nil, err
+		}
+		newUnitWeight = &v
+	}
+
+	res := &UpdateResponseDto{}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		a, err := r.Item().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := a.CheckVersion(req.Versions); err != nil {
+			return err
+		}
+
+		// Main
+		if newSku != nil {
+			a.Sku = *newSku
+		}
+		a.Name = newName
+		if req.Barcodes != nil {
+			if err := a.SetBarcodes(barcodes); err != nil {
+				return err
+			}
+		}
+		if req.PackSizes != nil {
+			if err := a.SetPackSizes(packSizes); err != nil {
+				return err
+			}
+		}
+		if newUnitVolume != nil {
+			a.UnitVolume = *newUnitVolume
+		}
+		if newUnitWeight != nil {
+			a.UnitWeight = *newUnitWeight
+		}
+		lotTrackedChanges := req.LotTracked != nil && *req.LotTracked != a.LotTracked
+		serializedChanges := req.Serialized != nil && *req.Serialized != a.Serialized
+		if lotTrackedChanges || serializedChanges {
+			bs, err := r.Movement().ListBalances(ctx, movement.BalanceQuery{ItemId: &id})
+			if err != nil {
+				return err
+			}
+			if len(bs) > 0 {
+				return item.ErrHasStock
+			}
+		}
+		if lotTrackedChanges {
+			a.LotTracked = *req.LotTracked
+		}
+		if serializedChanges {
+			a.Serialized = *req.Serialized
+		}
+
+		if err = r.Item().Save(ctx, a); err != nil {
+			return err
+		}
+
+		res.Version = a.Version()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
@@ -0,0 +1,569 @@
+package item_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/item"
+	domain "openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/measure"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/infra/database"
+	mock "openapi/internal/infra/mock/domain/stock/item"
+	"openapi/internal/infra/repository/memory"
+	memoryTransaction "openapi/internal/infra/repository/memory/stock/transaction"
+	infra "openapi/internal/infra/repository/sqlboiler/stock/item"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・エラーが発生しないこと
+// ・Update関数で作成したItemをリポジトリで取得できること
+// ・変更時に設定したItemの名前が一致すること
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	beforeName := "TestName" + uuid.NewString()
+	reqCreateDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: beforeName,
+	}
+
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	afterName := "TestName" + uuid.NewString()
+	reqUpdateDto := &app.UpdateRequestDto{
+		Id:   resCreateDto.Id,
+		Name: afterName,
+	}
+
+	_, err = app.Update(context.Background(), reqUpdateDto, unitOfWork)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	id, err := domain.NewId(resCreateDto.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := repository.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.Name.String() != afterName {
+		t.Errorf("%T = %v, want %v", a.Name.String(), a.Name.String(), afterName)
+	}
+}
+
+func TestUpdateFailInvalidName(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	beforeName := "TestName" + uuid.NewString()
+	reqCreateDto := &app.CreateRequestDto{
+		Sku:  uuid.NewString(),
+		Name: beforeName,
+	}
+
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, repository, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	afterName := ""
+	reqUpdateDto := &app.UpdateRequestDto{
+		Id:   resCreateDto.Id,
+		Name: afterName,
+	}
+	_, err = app.Update(context.Background(), reqUpdateDto, unitOfWork)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestUpdateFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.UpdateRequestDto{
+		Id:   uuid.Nil,
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	// When
+	_, err = app.Update(context.Background(), reqDto, unitOfWork)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestUpdateFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqDto := &app.UpdateRequestDto{
+		Id:   uuid.New(),
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	// When
+	_, err = app.Update(context.Background(), reqDto, unitOfWork)
+
+	// Then
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
+
+func TestUpdateFailSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.NewAggregate(id, domain.Sku{}, name, domain.Each)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
+
+	// Given
+	reqDto := &app.UpdateRequestDto{
+		Id:   id.UUID(),
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	// When
+	_, err = app.Update(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+// テスト観点
+// ・期待するバージョンと一致しない場合はErrVersionMismatchとなり、保存されないこと
+func TestUpdateFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, domain.Sku{}, name, domain.Each, nil, nil, measure.Volume{}, measure.Weight{}, false, false, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.UpdateRequestDto{
+		Id:       id.UUID(),
+		Name:     "TestName" + uuid.NewString(),
+		Versions: []int64{1},
+	}
+
+	// When
+	_, err = app.Update(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrVersionMismatch)
+	}
+}
+
+// テスト観点
+// ・容積と重量を指定しない場合は現在の値が維持されること
+// ・容積と重量を指定した場合は値が変更されること
+func TestUpdateMeasures(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	volume, err := measure.NewVolume(1500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, domain.Sku{}, name, domain.Each, nil, nil, volume, measure.Weight{}, false, false, false, 1)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil).Times(2)
+
+	var saved []domain.Aggregate
+	repository.EXPECT().Save(gomock.Any(), a).DoAndReturn(func(_ context.Context, a *domain.Aggregate) error {
+		saved = append(saved, *a)
+		return nil
+	}).Times(2)
+
+	unitOfWork := newUnitOfWork(ctrl, repository)
+
+	// When
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String()}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	zero, weight := int64(0), int64(250)
+	reqDto := &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), UnitVolume: &zero, UnitWeight: &weight}
+	if _, err := app.Update(context.Background(), reqDto, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(saved) != 2 {
+		t.Fatalf("%T = %v, want 2 saves", saved, saved)
+	}
+
+	if saved[0].UnitVolume.Int64() != 1500 || saved[0].UnitWeight.Int64() != 0 {
+		t.Errorf("%T = %+v, want volume 1500 and no weight", saved[0], saved[0])
+	}
+
+	if saved[1].UnitVolume.Int64() != 0 || saved[1].UnitWeight.Int64() != 250 {
+		t.Errorf("%T = %+v, want no volume and weight 250", saved[1], saved[1])
+	}
+}
+
+// テスト観点
+// ・SKU、バーコード、荷姿を指定しない場合は現在の値が維持されること
+// ・空のバーコードを指定した場合はすべて削除されること
+// ・基本単位は変更されないこと
+func TestUpdateCatalog(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku, err := domain.NewSku("BEFORE-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	barcode, err := domain.NewBarcode("4006381333931")
+	if err != nil {
+		t.Fatal(err)
+	}
+	kg, err := domain.NewUnit("kg")
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, sku, name, kg, []domain.Barcode{barcode}, nil, measure.Volume{}, measure.Weight{}, false, false, false, 1)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil).Times(2)
+
+	var saved []domain.Aggregate
+	repository.EXPECT().Save(gomock.Any(), a).DoAndReturn(func(_ context.Context, a *domain.Aggregate) error {
+		saved = append(saved, *a)
+		return nil
+	}).Times(2)
+
+	unitOfWork := newUnitOfWork(ctrl, repository)
+
+	// When
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String()}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	afterSku := "after-1"
+	reqDto := &app.UpdateRequestDto{
+		Id:        id.UUID(),
+		Sku:       &afterSku,
+		Name:      name.String(),
+		Barcodes:  &[]string{},
+		PackSizes: &[]app.PackSizeDto{{Unit: "sack", Quantity: 25}},
+	}
+	if _, err := app.Update(context.Background(), reqDto, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(saved) != 2 {
+		t.Fatalf("%T = %v, want 2 saves", saved, saved)
+	}
+
+	if saved[0].Sku != sku || len(saved[0].Barcodes()) != 1 || saved[0].PackSizes() != nil {
+		t.Errorf("%T = %+v, want sku %v and barcode %v", saved[0], saved[0], sku, barcode)
+	}
+
+	if saved[1].Sku.String() != "AFTER-1" || saved[1].Barcodes() != nil || len(saved[1].PackSizes()) != 1 {
+		t.Errorf("%T = %+v, want sku AFTER-1, no barcode and a sack", saved[1], saved[1])
+	}
+
+	if saved[1].BaseUnit() != kg {
+		t.Errorf("%T = %+v, want %v", saved[1].BaseUnit(), saved[1].BaseUnit(), kg)
+	}
+}
+
+// テスト観点
+// ・在庫がない間はロット管理を切り替えられること
+// ・在庫がある場合はエラーとなり、保存されないこと
+func TestUpdateLotTracked(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := memory.Open()
+	unitOfWork, err := memoryTransaction.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := memoryTransaction.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku, err := domain.NewSku("LOT-" + id.UUID().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Item().Save(context.Background(), domain.NewAggregate(id, sku, name, domain.Each)); err != nil {
+		t.Fatal(err)
+	}
+
+	tracked, untracked := true, false
+
+	// When
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), LotTracked: &tracked}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	movementId, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	quantity, err := movement.NewQuantity(movement.Receipt, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	number, err := lot.NewNumber("L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := movement.NewAggregate(movementId, id, locationId, movement.Receipt, quantity)
+	m.Lot = number
+	if err := r.Movement().Save(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+
+	_, hasStockErr := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), LotTracked: &untracked}, unitOfWork)
+
+	// Then
+	a, err := r.Item().Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.LotTracked {
+		t.Errorf("%T = %+v, want lot tracked", a, a)
+	}
+
+	if !errors.Is(hasStockErr, domain.ErrHasStock) {
+		t.Errorf("%T = %v, want %v", hasStockErr, hasStockErr, domain.ErrHasStock)
+	}
+}
+
+// テスト観点
+// ・在庫がない間はシリアル管理を切り替えられること
+// ・在庫がある場合はエラーとなり、保存されないこと
+func TestUpdateSerialized(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := memory.Open()
+	unitOfWork, err := memoryTransaction.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r, err := memoryTransaction.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku, err := domain.NewSku("SER-" + id.UUID().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Item().Save(context.Background(), domain.NewAggregate(id, sku, name, domain.Each)); err != nil {
+		t.Fatal(err)
+	}
+
+	serialized, unserialized := true, false
+
+	// When
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), Serialized: &serialized}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	movementId, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	quantity, err := movement.NewQuantity(movement.Receipt, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	numbers, err := serial.NewNumbers([]string{"SN-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := movement.NewAggregate(movementId, id, locationId, movement.Receipt, quantity)
+	m.Serials = numbers
+	if err := r.Movement().Save(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+
+	_, hasStockErr := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), Serialized: &unserialized}, unitOfWork)
+
+	// Then
+	a, err := r.Item().Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !a.Serialized {
+		t.Errorf("%T = %+v, want serialized", a, a)
+	}
+
+	if !errors.Is(hasStockErr, domain.ErrHasStock) {
+		t.Errorf("%T = %v, want %v", hasStockErr, hasStockErr, domain.ErrHasStock)
+	}
+}
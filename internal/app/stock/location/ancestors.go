@@ -0,0 +1,49 @@
+package location
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/location"
+
+	"github.com/google/uuid"
+)
+
+type ListAncestorsRequestDto struct {
+	Id uuid.UUID
+}
+
+type ListAncestorsResponseDto struct {
+	// Items is the path from the root down to the parent of the stock location.
+	Items []*GetResponseDto
+}
+
+func ListAncestors(ctx context.Context, req *ListAncestorsRequestDto, r location.IRepository) (*ListAncestorsResponseDto, error) {
+	// Precondition
+	id, err := location.NewId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Main
+	found, err := r.Find(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, location.ErrNotFound
+	}
+
+	as, err := r.Ancestors(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListAncestorsResponseDto{
+		Items: make([]*GetResponseDto, 0, len(as)),
+	}
+	for _, a := range as {
+		res.Items = append(res.Items, newGetResponseDto(a))
+	}
+
+	return res, nil
+}
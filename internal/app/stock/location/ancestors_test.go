@@ -0,0 +1,51 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・ルートから親ロケーションまでの経路が返されること
+func TestListAncestors(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, r := newMemory(t)
+
+	// Given
+	root := saveLocation(t, r, false)
+	parent := saveChild(t, r, root)
+	a := saveChild(t, r, parent)
+
+	// When
+	resDto, err := app.ListAncestors(context.Background(), &app.ListAncestorsRequestDto{Id: a.Id.UUID()}, r.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(resDto.Items) != 2 || resDto.Items[0].Id != root.Id.UUID() || resDto.Items[1].Id != parent.Id.UUID() {
+		t.Errorf("%+v, want %v and %v", resDto.Items, root.Id, parent.Id)
+	}
+}
+
+func TestListAncestorsFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, r := newMemory(t)
+
+	// When
+	_, err := app.ListAncestors(context.Background(), &app.ListAncestorsRequestDto{Id: uuid.New()}, r.Location())
+
+	// Then
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
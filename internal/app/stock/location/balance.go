@@ -0,0 +1,88 @@
+package location
+
+import (
+	"context"
+	"sort"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type ListBalancesRequestDto struct {
+	Id uuid.UUID
+}
+
+type BalanceDto struct {
+	ItemId   uuid.UUID
+	Quantity int64
+}
+
+type ListBalancesResponseDto struct {
+	Items []*BalanceDto
+}
+
+// ListBalances returns the non-zero quantities on hand in the stock location and every location below it,
+// summed per item. Deleted descendants count, as their stock is still there until it is moved out.
+func ListBalances(ctx context.Context, req *ListBalancesRequestDto, u transaction.IUnitOfWork) (*ListBalancesResponseDto, error) {
+	// Precondition
+	id, err := location.NewId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Main
+	var bs []movement.Balance
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		found, err := r.Location().Find(ctx, id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return location.ErrNotFound
+		}
+
+		ds, err := r.Location().Descendants(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		ids := []location.Id{id}
+		for _, d := range ds {
+			ids = append(ids, d.Id)
+		}
+
+		bs, err = r.Movement().ListBalances(ctx, movement.BalanceQuery{LocationIds: ids})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sums := map[item.Id]int64{}
+	for _, b := range bs {
+		sums[b.ItemId] += b.Quantity
+	}
+
+	res := &ListBalancesResponseDto{
+		Items: make([]*BalanceDto, 0, len(sums)),
+	}
+	for itemId, quantity := range sums {
+		if quantity == 0 {
+			continue
+		}
+		res.Items = append(res.Items, &BalanceDto{
+			ItemId:   itemId.UUID(),
+			Quantity: quantity,
+		})
+	}
+
+	sort.Slice(res.Items, func(i, j int) bool {
+		return res.Items[i].ItemId.String() < res.Items[j].ItemId.String()
+	})
+
+	return res, nil
+}
@@ -0,0 +1,90 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	app "openapi/internal/app/stock/location"
+	"openapi/internal/domain/stock/item"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・ロケーション自身と子孫ロケーションの在庫が品目ごとに合算されること
+// ・他のロケーションの在庫は含まれないこと
+func TestListBalances(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	root := saveLocation(t, r, false)
+	child := saveChild(t, r, root)
+	grandchild := saveChild(t, r, child)
+	other := saveLocation(t, r, false)
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rc := range []struct {
+		location *domain.Aggregate
+		quantity int64
+	}{
+		{root, 1},
+		{child, 2},
+		{grandchild, 4},
+		{other, 8},
+	} {
+		id, err := movement.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		q, err := movement.NewQuantity(movement.Receipt, rc.quantity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Movement().Save(context.Background(), movement.NewAggregate(id, itemId, rc.location.Id, movement.Receipt, q)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	all, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{Id: root.Id.UUID()}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leaf, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{Id: grandchild.Id.UUID()}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(all.Items) != 1 || all.Items[0].ItemId != itemId.UUID() || all.Items[0].Quantity != 7 {
+		t.Errorf("%+v, want 7", all.Items)
+	}
+
+	if len(leaf.Items) != 1 || leaf.Items[0].Quantity != 4 {
+		t.Errorf("%+v, want 4", leaf.Items)
+	}
+}
+
+func TestListBalancesFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, _ := newMemory(t)
+
+	// When
+	_, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{Id: uuid.New()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
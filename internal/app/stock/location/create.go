@@ -1,27 +1,66 @@
 package location
 
 import (
+	"context"
+
 	"github.com/google/uuid"
 
 	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
 )
 
 type CreateRequestDto struct {
 	Name string
+	// Type is a warehouse when empty.
+	Type string
+	// Capacity is unlimited when nil.
+	Capacity *CapacityDto
+	// ParentId is the stock location to create the new one under, nil for a root location.
+	ParentId           *uuid.UUID
+	AllowNegativeStock bool
 }
 
 type CreateResponseDto struct {
-	Id   uuid.UUID
-	Name string
+	Id                 uuid.UUID
+	Name               string
+	Type               string
+	Capacity           *CapacityDto
+	ParentId           *uuid.UUID
+	AllowNegativeStock bool
 }
 
-func Create(req *CreateRequestDto, r location.IRepository, newId uuid.UUID) (*CreateResponseDto, error) {
+// Create saves a new stock location. The parent is read and the new location saved in one transaction, so that
+// the parent cannot be deleted or moved in between.
+func Create(ctx context.Context, req *CreateRequestDto, u transaction.IUnitOfWork, newId uuid.UUID) (*CreateResponseDto, error) {
 	// Precondition
 	name, err := location.NewName(req.Name)
 	if err != nil {
 		return nil, err
 	}
 
+	typ := location.Warehouse
+	if req.Type != "" {
+		t, err := location.NewType(req.Type)
+		if err != nil {
+			return nil, err
+		}
+		typ = t
+	}
+
+	capacity, err := newCapacity(req.Capacity)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentId *location.Id
+	if req.ParentId != nil {
+		id, err := location.NewId(*req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &id
+	}
+
 	// Main
 	id, err := location.NewId(newId)
 	if err != nil {
@@ -29,13 +68,33 @@ func Create(req *CreateRequestDto, r location.IRepository, newId uuid.UUID) (*Cr
 	}
 
 	a := location.NewAggregate(id, name)
+	a.Type = typ
+	a.Capacity = capacity
+	a.AllowNegativeStock = req.AllowNegativeStock
 
-	if err := r.Save(a); err != nil {
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		if parentId != nil {
+			if err := moveUnder(ctx, r.Location(), a, *parentId); err != nil {
+				return err
+			}
+		}
+
+		if err := location.CheckNameAvailable(ctx, r.Location(), a); err != nil {
+			return err
+		}
+
+		return r.Location().Save(ctx, a)
+	})
+	if err != nil {
 		return nil, err
 	}
 
 	return &CreateResponseDto{
-		Id:   a.Id.UUID(),
-		Name: a.Name.String(),
+		Id:                 a.Id.UUID(),
+		Name:               a.Name.String(),
+		Type:               a.Type.String(),
+		Capacity:           newCapacityDto(a.Capacity),
+		ParentId:           parentUuid(a),
+		AllowNegativeStock: a.AllowNegativeStock,
 	}, nil
 }
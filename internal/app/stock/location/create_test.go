@@ -1,12 +1,16 @@
 package location_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	app "openapi/internal/app/stock/location"
 	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
 	"openapi/internal/infra/database"
 	mock "openapi/internal/infra/mock/domain/stock/location"
 	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -31,13 +35,18 @@ func TestCreate(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	// Given
 	reqDto := &app.CreateRequestDto{
 		Name: "TestName" + uuid.NewString(),
 	}
 
 	// When
-	resDto, err := app.Create(reqDto, repository, uuid.New())
+	resDto, err := app.Create(context.Background(), reqDto, unitOfWork, uuid.New())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -48,7 +57,7 @@ func TestCreate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	a, err := repository.Get(id)
+	a, err := repository.Get(context.Background(), id)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -68,7 +77,7 @@ func TestCreateFailInvalidName(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -79,7 +88,7 @@ func TestCreateFailInvalidName(t *testing.T) {
 	}
 
 	// When
-	_, err = app.Create(reqDto, repository, uuid.New())
+	_, err = app.Create(context.Background(), reqDto, unitOfWork, uuid.New())
 
 	// Then
 	if err == nil {
@@ -97,7 +106,7 @@ func TestCreateFailInvalidId(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -108,7 +117,7 @@ func TestCreateFailInvalidId(t *testing.T) {
 	}
 
 	// When
-	_, err = app.Create(reqDto, repository, uuid.Nil)
+	_, err = app.Create(context.Background(), reqDto, unitOfWork, uuid.Nil)
 
 	// Then
 	if err == nil {
@@ -124,7 +133,8 @@ func TestCreateFailSave(t *testing.T) {
 	defer ctrl.Finish()
 
 	repository := mock.NewMockIRepository(ctrl)
-	repository.EXPECT().Save(gomock.Any()).Return(fmt.Errorf("fail save"))
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
 
 	// Given
 	reqDto := &app.CreateRequestDto{
@@ -132,10 +142,294 @@ func TestCreateFailSave(t *testing.T) {
 	}
 
 	// When
-	_, err := app.Create(reqDto, repository, uuid.New())
+	_, err := app.Create(context.Background(), reqDto, newUnitOfWork(ctrl, repository), uuid.New())
 
 	// Then
 	if err == nil {
 		t.Fatalf("error must not be nil")
 	}
 }
+
+// テスト観点
+// ・有効なロケーションと同じ名前の場合はErrNameTakenとなり、保存されないこと
+func TestCreateFailNameTaken(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository.EXPECT().FindByName(gomock.Any(), name).Return(domain.NewAggregate(id, name), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		Name: " " + name.String() + " ",
+	}
+
+	// When
+	_, err = app.Create(context.Background(), reqDto, newUnitOfWork(ctrl, repository), uuid.New())
+
+	// Then
+	if !errors.Is(err, domain.ErrNameTaken) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNameTaken)
+	}
+}
+
+// テスト観点
+// ・親ロケーションを指定した場合はその下に作成されること
+func TestCreateWithParent(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	parentId := parent.Id.UUID()
+	reqDto := &app.CreateRequestDto{
+		Name:     "TestName" + uuid.NewString(),
+		ParentId: &parentId,
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, u, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.ParentId == nil || *resDto.ParentId != parentId {
+		t.Errorf("%T = %v, want %v", resDto.ParentId, resDto.ParentId, parentId)
+	}
+
+	id, err := domain.NewId(resDto.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, err := r.Location().Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p := a.ParentId(); p == nil || *p != parent.Id {
+		t.Errorf("%T = %v, want %v", p, p, parent.Id)
+	}
+}
+
+// テスト観点
+// ・存在しない親ロケーションや削除済みの親ロケーションを指定した場合はErrParentNotAvailableとなること
+func TestCreateFailParentNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	deleted := saveLocation(t, r, true).Id.UUID()
+	missing := uuid.New()
+
+	for _, parentId := range []uuid.UUID{deleted, missing} {
+		reqDto := &app.CreateRequestDto{
+			Name:     "TestName" + uuid.NewString(),
+			ParentId: &parentId,
+		}
+
+		// When
+		_, err := app.Create(context.Background(), reqDto, u, uuid.New())
+
+		// Then
+		if !errors.Is(err, domain.ErrParentNotAvailable) {
+			t.Errorf("%v: %T = %v, want %v", parentId, err, err, domain.ErrParentNotAvailable)
+		}
+	}
+}
+
+// テスト観点
+// ・作成中に親ロケーションが削除されても、削除は作成のトランザクションの後に行われてErrHasChildrenとなること
+// ・親ロケーションは有効なまま、子ロケーションがその下に作成されること
+func TestCreateParentDeletedMidCreate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	parentId := parent.Id.UUID()
+
+	deleted := make(chan error, 1)
+	deleting := &onGetUnitOfWork{IUnitOfWork: u, onGet: func(id domain.Id) {
+		if id != parent.Id {
+			return
+		}
+		go func() {
+			deleted <- app.Delete(context.Background(), &app.DeleteRequestDto{Id: parentId}, u)
+		}()
+	}}
+
+	reqDto := &app.CreateRequestDto{
+		Name:     "TestName" + uuid.NewString(),
+		ParentId: &parentId,
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, deleting, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if err := <-deleted; !errors.Is(err, domain.ErrHasChildren) {
+		t.Errorf("%T = %v, want %v", err, err, domain.ErrHasChildren)
+	}
+
+	p, err := r.Location().Get(context.Background(), parent.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.IsDeleted() {
+		t.Errorf("%T = %v, want %v", p.IsDeleted(), p.IsDeleted(), false)
+	}
+
+	a, err := r.Location().Get(context.Background(), mustId(t, resDto.Id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id := a.ParentId(); id == nil || *id != parent.Id {
+		t.Errorf("%T = %v, want %v", id, id, parent.Id)
+	}
+}
+
+// onGetUnitOfWork runs onGet with the id of every stock location read in its transactions, before it is read.
+type onGetUnitOfWork struct {
+	transaction.IUnitOfWork
+	onGet func(id domain.Id)
+}
+
+func (u *onGetUnitOfWork) Do(ctx context.Context, fn func(r transaction.IRepositories) error) error {
+	return u.IUnitOfWork.Do(ctx, func(r transaction.IRepositories) error {
+		return fn(&onGetRepositories{IRepositories: r, onGet: u.onGet})
+	})
+}
+
+type onGetRepositories struct {
+	transaction.IRepositories
+	onGet func(id domain.Id)
+}
+
+func (r *onGetRepositories) Location() domain.IRepository {
+	return &onGetRepository{IRepository: r.IRepositories.Location(), onGet: r.onGet}
+}
+
+type onGetRepository struct {
+	domain.IRepository
+	onGet func(id domain.Id)
+}
+
+func (r *onGetRepository) Get(ctx context.Context, id domain.Id) (*domain.Aggregate, error) {
+	r.onGet(id)
+	return r.IRepository.Get(ctx, id)
+}
+
+// テスト観点
+// ・種別と容量を指定した場合はそのまま保存されること
+// ・種別を省略した場合は倉庫となり、容量は無制限となること
+func TestCreateWithTypeAndCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, _ := newMemory(t)
+
+	// Given
+	units, weight := int64(100), int64(20000)
+	reqDto := &app.CreateRequestDto{
+		Name:     "TestName" + uuid.NewString(),
+		Type:     "bin",
+		Capacity: &app.CapacityDto{MaxUnits: &units, MaxWeight: &weight},
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, u, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	defaultDto, err := app.Create(context.Background(), &app.CreateRequestDto{Name: "TestName" + uuid.NewString()}, u, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Type != "bin" {
+		t.Errorf("%T = %v, want %v", resDto.Type, resDto.Type, "bin")
+	}
+
+	if c := resDto.Capacity; c == nil || *c.MaxUnits != units || c.MaxVolume != nil || *c.MaxWeight != weight {
+		t.Errorf("%T = %+v, want units %v and weight %v", c, c, units, weight)
+	}
+
+	if defaultDto.Type != "warehouse" || defaultDto.Capacity != nil {
+		t.Errorf("%T = %+v, want an unlimited warehouse", defaultDto, defaultDto)
+	}
+}
+
+// テスト観点
+// ・不正な種別や容量を指定した場合はエラーとなり、保存されないこと
+func TestCreateFailInvalidTypeOrCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	zero := int64(0)
+	for _, tt := range []struct {
+		reqDto *app.CreateRequestDto
+		want   error
+	}{
+		{&app.CreateRequestDto{Name: "TestName" + uuid.NewString(), Type: "shelf"}, domain.ErrInvalidType},
+		{&app.CreateRequestDto{Name: "TestName" + uuid.NewString(), Capacity: &app.CapacityDto{MaxVolume: &zero}}, domain.ErrInvalidCapacity},
+	} {
+		// When
+		_, err := app.Create(context.Background(), tt.reqDto, u, uuid.New())
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%T = %v, want %v", err, err, tt.want)
+		}
+
+		if _, err := r.Location().FindByName(context.Background(), mustName(t, tt.reqDto.Name)); !errors.Is(err, domain.ErrNotFound) {
+			t.Errorf("%T = %v, want %v", err, err, domain.ErrNotFound)
+		}
+	}
+}
+
+func mustName(t *testing.T, v string) domain.Name {
+	t.Helper()
+
+	name, err := domain.NewName(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return name
+}
+
+func mustId(t *testing.T, v uuid.UUID) domain.Id {
+	t.Helper()
+
+	id, err := domain.NewId(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
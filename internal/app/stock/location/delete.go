@@ -1,33 +1,70 @@
 package location
 
 import (
+	"context"
+
 	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
 
 	"github.com/google/uuid"
 )
 
 type DeleteRequestDto struct {
 	Id uuid.UUID
+	// Versions are the versions the caller expects the stock location to be at, nil to skip the check.
+	Versions []int64
+	// Cascade deletes the active descendants of the stock location along with it.
+	Cascade bool
 }
 
-func Delete(req *DeleteRequestDto, r location.IRepository) error {
+// Delete soft-deletes the stock location. It is refused while the location has active descendants,
+// unless req.Cascade asks to delete them too. Deleting a location that is already deleted
+// succeeds without changing it, so its version stays the same.
+func Delete(ctx context.Context, req *DeleteRequestDto, u transaction.IUnitOfWork) error {
 	// Precondition
 	id, err := location.NewId(req.Id)
 	if err != nil {
 		return err
 	}
 
-	a, err := r.Get(id)
-	if err != nil {
-		return err
-	}
+	return u.Do(ctx, func(r transaction.IRepositories) error {
+		a, err := r.Location().Get(ctx, id)
+		if err != nil {
+			return err
+		}
 
-	// Main
-	a.Delete()
+		if err := a.CheckVersion(req.Versions); err != nil {
+			return err
+		}
 
-	if err = r.Save(a); err != nil {
-		return err
-	}
+		// Main
+		if a.IsDeleted() {
+			return nil
+		}
+
+		ds, err := r.Location().Descendants(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		active := make([]*location.Aggregate, 0, len(ds))
+		for _, d := range ds {
+			if !d.IsDeleted() {
+				active = append(active, d)
+			}
+		}
+		if len(active) > 0 && !req.Cascade {
+			return location.ErrHasChildren
+		}
+
+		for _, d := range append(active, a) {
+			d.Delete()
+
+			if err = r.Location().Save(ctx, d); err != nil {
+				return err
+			}
+		}
 
-	return nil
+		return nil
+	})
 }
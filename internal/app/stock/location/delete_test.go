@@ -1,12 +1,15 @@
 package location_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	app "openapi/internal/app/stock/location"
 	domain "openapi/internal/domain/stock/location"
 	"openapi/internal/infra/database"
 	mock "openapi/internal/infra/mock/domain/stock/location"
 	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -28,12 +31,17 @@ func TestDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	// Given
 	reqCreateDto := &app.CreateRequestDto{
 		Name: uuid.NewString(),
 	}
 
-	resCreateDto, err := app.Create(reqCreateDto, repository, uuid.New())
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, unitOfWork, uuid.New())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -43,7 +51,7 @@ func TestDelete(t *testing.T) {
 		Id: resCreateDto.Id,
 	}
 
-	if err := app.Delete(reqDeleteDto, repository); err != nil {
+	if err := app.Delete(context.Background(), reqDeleteDto, unitOfWork); err != nil {
 		t.Fatal(err)
 	}
 
@@ -53,7 +61,7 @@ func TestDelete(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	a, err := repository.Get(id)
+	a, err := repository.Get(context.Background(), id)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -73,7 +81,7 @@ func TestDeleteFailInvalidId(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -84,7 +92,7 @@ func TestDeleteFailInvalidId(t *testing.T) {
 	}
 
 	// When
-	err = app.Delete(reqDto, repository)
+	err = app.Delete(context.Background(), reqDto, unitOfWork)
 
 	// Then
 	if err == nil {
@@ -92,7 +100,7 @@ func TestDeleteFailInvalidId(t *testing.T) {
 	}
 }
 
-func TestDeleteFailFind(t *testing.T) {
+func TestDeleteFailNotFound(t *testing.T) {
 	t.Parallel()
 
 	// Setup
@@ -102,7 +110,7 @@ func TestDeleteFailFind(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -113,11 +121,11 @@ func TestDeleteFailFind(t *testing.T) {
 	}
 
 	// When
-	err = app.Delete(reqDto, repository)
+	err = app.Delete(context.Background(), reqDto, unitOfWork)
 
 	// Then
-	if err == nil {
-		t.Fatalf("error must not be nil")
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
 	}
 }
 
@@ -130,7 +138,7 @@ func TestDeleteFailSave(t *testing.T) {
 
 	repository := mock.NewMockIRepository(ctrl)
 
-	repository.EXPECT().Save(gomock.Any()).Return(fmt.Errorf("fail save"))
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
 
 	name, err := domain.NewName("TestName" + uuid.NewString())
 	if err != nil {
@@ -141,7 +149,8 @@ func TestDeleteFailSave(t *testing.T) {
 		t.Fatal(err)
 	}
 	a := domain.NewAggregate(id, name)
-	repository.EXPECT().Get(gomock.Any()).Return(a, nil)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
+	repository.EXPECT().Descendants(gomock.Any(), id).Return([]*domain.Aggregate{}, nil)
 
 	// Given
 	reqDto := &app.DeleteRequestDto{
@@ -149,10 +158,163 @@ func TestDeleteFailSave(t *testing.T) {
 	}
 
 	// When
-	err = app.Delete(reqDto, repository)
+	err = app.Delete(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
 
 	// Then
 	if err == nil {
 		t.Fatalf("error must not be nil")
 	}
 }
+
+// テスト観点
+// ・期待するバージョンと一致しない場合はErrVersionMismatchとなり、保存されないこと
+func TestDeleteFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, false, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.DeleteRequestDto{
+		Id:       id.UUID(),
+		Versions: []int64{1},
+	}
+
+	// When
+	err = app.Delete(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrVersionMismatch)
+	}
+}
+
+// テスト観点
+// ・削除済みのロケーションを削除してもエラーにならず、保存されないこと
+func TestDeleteAlreadyDeleted(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, true, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.DeleteRequestDto{
+		Id:       id.UUID(),
+		Versions: []int64{2},
+	}
+
+	// When
+	err = app.Delete(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// テスト観点
+// ・有効な子ロケーションがある場合はErrHasChildrenとなり、どれも削除されないこと
+func TestDeleteFailHasChildren(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	child := saveChild(t, r, parent)
+
+	// When
+	err := app.Delete(context.Background(), &app.DeleteRequestDto{Id: parent.Id.UUID()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrHasChildren) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrHasChildren)
+	}
+
+	for _, a := range []*domain.Aggregate{parent, child} {
+		got, err := r.Location().Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got.IsDeleted() {
+			t.Errorf("%v must not be deleted", a.Id)
+		}
+	}
+}
+
+// テスト観点
+// ・カスケード指定の場合は有効な子孫ロケーションもすべて削除されること
+// ・削除済みの子孫ロケーションは保存されないこと
+func TestDeleteCascade(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	child := saveChild(t, r, parent)
+	grandchild := saveChild(t, r, child)
+	deleted := saveChild(t, r, parent)
+	deleted.Delete()
+	if err := r.Location().Save(context.Background(), deleted); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	err := app.Delete(context.Background(), &app.DeleteRequestDto{Id: parent.Id.UUID(), Cascade: true}, u)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range []*domain.Aggregate{parent, child, grandchild} {
+		got, err := r.Location().Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.IsDeleted() {
+			t.Errorf("%v must be deleted", a.Id)
+		}
+	}
+
+	got, err := r.Location().Get(context.Background(), deleted.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Version() != deleted.Version() {
+		t.Errorf("%T %+v want %+v", got.Version(), got.Version(), deleted.Version())
+	}
+}
@@ -0,0 +1,98 @@
+package location
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/location"
+
+	"github.com/google/uuid"
+)
+
+type GetRequestDto struct {
+	Id uuid.UUID
+}
+
+type GetResponseDto struct {
+	Id   uuid.UUID
+	Name string
+	Type string
+	// Capacity is nil when the stock location is unlimited.
+	Capacity *CapacityDto
+	// ParentId is nil for a root location.
+	ParentId           *uuid.UUID
+	Deleted            bool
+	AllowNegativeStock bool
+	Version            int64
+}
+
+func Get(ctx context.Context, req *GetRequestDto, r location.IRepository) (*GetResponseDto, error) {
+	// Precondition
+	id, err := location.NewId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Main
+	a, err := r.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return newGetResponseDto(a), nil
+}
+
+func newGetResponseDto(a *location.Aggregate) *GetResponseDto {
+	return &GetResponseDto{
+		Id:                 a.Id.UUID(),
+		Name:               a.Name.String(),
+		Type:               a.Type.String(),
+		Capacity:           newCapacityDto(a.Capacity),
+		ParentId:           parentUuid(a),
+		Deleted:            a.IsDeleted(),
+		AllowNegativeStock: a.AllowNegativeStock,
+		Version:            a.Version(),
+	}
+}
+
+// parentUuid returns the id of the parent of a, nil for a root location.
+func parentUuid(a *location.Aggregate) *uuid.UUID {
+	p := a.ParentId()
+	if p == nil {
+		return nil
+	}
+	v := p.UUID()
+	return &v
+}
+
+// CapacityDto holds the limits of a stock location in units, cubic centimetres and grams, nil where there is none.
+type CapacityDto struct {
+	MaxUnits  *int64
+	MaxVolume *int64
+	MaxWeight *int64
+}
+
+// newCapacityDto returns nil for an unlimited capacity.
+func newCapacityDto(c location.Capacity) *CapacityDto {
+	if c.IsUnlimited() {
+		return nil
+	}
+
+	dto := &CapacityDto{MaxUnits: c.Units()}
+	if v := c.Volume(); v != nil {
+		n := v.Int64()
+		dto.MaxVolume = &n
+	}
+	if v := c.Weight(); v != nil {
+		n := v.Int64()
+		dto.MaxWeight = &n
+	}
+	return dto
+}
+
+// newCapacity returns an unlimited capacity for a nil dto.
+func newCapacity(dto *CapacityDto) (location.Capacity, error) {
+	if dto == nil {
+		return location.Capacity{}, nil
+	}
+	return location.NewCapacity(dto.MaxUnits, dto.MaxVolume, dto.MaxWeight)
+}
@@ -0,0 +1,102 @@
+package location_test
+
+import (
+	"context"
+	"fmt"
+	app "openapi/internal/app/stock/location"
+	"openapi/internal/infra/database"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	reqCreateDto := &app.CreateRequestDto{
+		Name: "TestName" + uuid.NewString(),
+	}
+
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, unitOfWork, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	resDto, err := app.Get(context.Background(), &app.GetRequestDto{Id: resCreateDto.Id}, repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Id != resCreateDto.Id {
+		t.Errorf("%T = %v, want %v", resDto.Id, resDto.Id, resCreateDto.Id)
+	}
+
+	if resDto.Name != reqCreateDto.Name {
+		t.Errorf("%T = %v, want %v", resDto.Name, resDto.Name, reqCreateDto.Name)
+	}
+
+	if resDto.Deleted {
+		t.Errorf("%T = %v, want %v", resDto.Deleted, resDto.Deleted, false)
+	}
+}
+
+func TestGetFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	// When
+	_, err := app.Get(context.Background(), &app.GetRequestDto{Id: uuid.Nil}, repository)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestGetFailGet(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("fail get"))
+
+	// When
+	_, err := app.Get(context.Background(), &app.GetRequestDto{Id: uuid.New()}, repository)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
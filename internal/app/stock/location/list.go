@@ -0,0 +1,158 @@
+package location
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/location"
+
+	"github.com/google/uuid"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+var ErrInvalidCursor = failure.Validation("invalid_cursor", "invalid cursor")
+
+type ListRequestDto struct {
+	Limit      int
+	After      string
+	NamePrefix string
+	// ParentId limits the list to the children of the stock location, which must exist.
+	ParentId *uuid.UUID
+	// Type limits the list to the stock locations of the type, any type when empty.
+	Type           string
+	Order          string
+	IncludeDeleted bool
+}
+
+type ListResponseDto struct {
+	Items      []*GetResponseDto
+	NextCursor string
+}
+
+func List(ctx context.Context, req *ListRequestDto, r location.IRepository) (*ListResponseDto, error) {
+	// Precondition
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit < 0 || limit > MaxListLimit {
+		return nil, errors.New("invalid limit")
+	}
+
+	order := location.OrderAsc
+	if req.Order != "" {
+		o, err := location.NewOrder(req.Order)
+		if err != nil {
+			return nil, err
+		}
+		order = o
+	}
+
+	var after *location.Cursor
+	if req.After != "" {
+		c, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, err
+		}
+		after = c
+	}
+
+	var parentId *location.Id
+	if req.ParentId != nil {
+		id, err := location.NewId(*req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &id
+	}
+
+	var typ *location.Type
+	if req.Type != "" {
+		t, err := location.NewType(req.Type)
+		if err != nil {
+			return nil, err
+		}
+		typ = &t
+	}
+
+	// Main
+	if parentId != nil {
+		found, err := r.Find(ctx, *parentId)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			return nil, location.ErrNotFound
+		}
+	}
+
+	// One extra row is fetched to find out whether a next page exists.
+	as, err := r.List(ctx, location.ListQuery{
+		NamePrefix:     req.NamePrefix,
+		ParentId:       parentId,
+		Type:           typ,
+		IncludeDeleted: req.IncludeDeleted,
+		Order:          order,
+		After:          after,
+		Limit:          limit + 1,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListResponseDto{
+		Items: make([]*GetResponseDto, 0, len(as)),
+	}
+
+	if len(as) > limit {
+		as = as[:limit]
+		res.NextCursor = encodeCursor(as[len(as)-1])
+	}
+
+	for _, a := range as {
+		res.Items = append(res.Items, newGetResponseDto(a))
+	}
+
+	return res, nil
+}
+
+type cursor struct {
+	Name string    `json:"n"`
+	Id   uuid.UUID `json:"i"`
+}
+
+func encodeCursor(a *location.Aggregate) string {
+	b, _ := json.Marshal(&cursor{Name: a.Name.String(), Id: a.Id.UUID()})
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeCursor(v string) (*location.Cursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &cursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	name, err := location.NewName(c.Name)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	id, err := location.NewId(c.Id)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	return &location.Cursor{Name: name, Id: id}, nil
+}
@@ -0,0 +1,266 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/infra/database"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・名前の前方一致で絞り込めること
+// ・カーソルを辿って全件を重複なく取得できること
+// ・削除済みのLocationは既定で除外されること
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	repository, err := infra.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	prefix := "TestList" + uuid.NewString()
+	for i := 0; i < 3; i++ {
+		if _, err := app.Create(context.Background(), &app.CreateRequestDto{Name: fmt.Sprintf("%s-%d", prefix, i)}, unitOfWork, uuid.New()); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	deleted, err := app.Create(context.Background(), &app.CreateRequestDto{Name: prefix + "-9"}, unitOfWork, uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := app.Delete(context.Background(), &app.DeleteRequestDto{Id: deleted.Id}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	first, err := app.List(context.Background(), &app.ListRequestDto{Limit: 2, NamePrefix: prefix}, repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := app.List(context.Background(), &app.ListRequestDto{Limit: 2, NamePrefix: prefix, After: first.NextCursor}, repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withDeleted, err := app.List(context.Background(), &app.ListRequestDto{NamePrefix: prefix, IncludeDeleted: true, Order: "desc"}, repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(first.Items) != 2 || first.NextCursor == "" {
+		t.Fatalf("first page = %+v, want 2 items with a next cursor", first)
+	}
+
+	if len(second.Items) != 1 || second.NextCursor != "" {
+		t.Fatalf("second page = %+v, want 1 item without a next cursor", second)
+	}
+
+	names := []string{first.Items[0].Name, first.Items[1].Name, second.Items[0].Name}
+	for i, name := range names {
+		if want := fmt.Sprintf("%s-%d", prefix, i); name != want {
+			t.Errorf("%T = %v, want %v", name, name, want)
+		}
+	}
+
+	if len(withDeleted.Items) != 4 {
+		t.Fatalf("%T = %v, want %v", len(withDeleted.Items), len(withDeleted.Items), 4)
+	}
+
+	if withDeleted.Items[0].Id != deleted.Id || !withDeleted.Items[0].Deleted {
+		t.Errorf("%T = %+v, want deleted %v first", withDeleted.Items[0], withDeleted.Items[0], deleted.Id)
+	}
+}
+
+func TestListFailInvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	// When
+	_, err := app.List(context.Background(), &app.ListRequestDto{After: "not a cursor"}, repository)
+
+	// Then
+	if !errors.Is(err, app.ErrInvalidCursor) {
+		t.Fatalf("%T = %v, want %v", err, err, app.ErrInvalidCursor)
+	}
+}
+
+func TestListFailInvalidOrder(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	// When
+	_, err := app.List(context.Background(), &app.ListRequestDto{Order: "random"}, repository)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestListQuery(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	var actual domain.ListQuery
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, q domain.ListQuery) ([]*domain.Aggregate, error) {
+		actual = q
+		return []*domain.Aggregate{}, nil
+	})
+
+	// When
+	res, err := app.List(context.Background(), &app.ListRequestDto{NamePrefix: "prefix"}, repository)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if actual.Limit != app.DefaultListLimit+1 {
+		t.Errorf("%T = %v, want %v", actual.Limit, actual.Limit, app.DefaultListLimit+1)
+	}
+
+	if actual.Order != domain.OrderAsc {
+		t.Errorf("%T = %v, want %v", actual.Order, actual.Order, domain.OrderAsc)
+	}
+
+	if actual.NamePrefix != "prefix" || actual.IncludeDeleted || actual.After != nil {
+		t.Errorf("%T = %+v", actual, actual)
+	}
+
+	if len(res.Items) != 0 || res.NextCursor != "" {
+		t.Errorf("%T = %+v, want empty page", res, res)
+	}
+}
+
+func TestListFailList(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("fail list"))
+
+	// When
+	_, err := app.List(context.Background(), &app.ListRequestDto{}, repository)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+// テスト観点
+// ・親ロケーションを指定した場合はその子ロケーションだけが返されること
+// ・存在しない親ロケーションを指定した場合はErrNotFoundとなること
+func TestListChildren(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	child := saveChild(t, r, parent)
+	saveChild(t, r, child)
+	saveLocation(t, r, false)
+	parentId := parent.Id.UUID()
+	missing := uuid.New()
+
+	// When
+	res, err := app.List(context.Background(), &app.ListRequestDto{ParentId: &parentId}, r.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, notFound := app.List(context.Background(), &app.ListRequestDto{ParentId: &missing}, r.Location())
+
+	// Then
+	if len(res.Items) != 1 || res.Items[0].Id != child.Id.UUID() {
+		t.Errorf("%+v, want %v", res.Items, child.Id)
+	}
+
+	if p := res.Items[0].ParentId; p == nil || *p != parentId {
+		t.Errorf("%T = %v, want %v", p, p, parentId)
+	}
+
+	if !errors.Is(notFound, domain.ErrNotFound) {
+		t.Errorf("%T = %v, want %v", notFound, notFound, domain.ErrNotFound)
+	}
+}
+
+// テスト観点
+// ・種別を指定した場合はその種別のロケーションだけが返されること
+// ・不正な種別を指定した場合はエラーとなること
+func TestListByType(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	_, r := newMemory(t)
+
+	// Given
+	saveLocation(t, r, false)
+	quarantine := saveLocation(t, r, false)
+	quarantine.Type = domain.Quarantine
+	if err := r.Location().Save(context.Background(), quarantine); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	res, err := app.List(context.Background(), &app.ListRequestDto{Type: "quarantine"}, r.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, invalid := app.List(context.Background(), &app.ListRequestDto{Type: "shelf"}, r.Location())
+
+	// Then
+	if len(res.Items) != 1 || res.Items[0].Id != quarantine.Id.UUID() || res.Items[0].Type != "quarantine" {
+		t.Errorf("%+v, want %v", res.Items, quarantine.Id)
+	}
+
+	if !errors.Is(invalid, domain.ErrInvalidType) {
+		t.Errorf("%T = %v, want %v", invalid, invalid, domain.ErrInvalidType)
+	}
+}
@@ -0,0 +1,26 @@
+package location_test
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/transaction"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	mock_transaction "openapi/internal/infra/mock/domain/stock/transaction"
+
+	"github.com/golang/mock/gomock"
+)
+
+// newUnitOfWork returns a unit of work that runs the use case against repository.
+func newUnitOfWork(ctrl *gomock.Controller, repository *mock.MockIRepository) *mock_transaction.MockIUnitOfWork {
+	tx := mock_transaction.NewMockIRepositories(ctrl)
+	tx.EXPECT().Location().Return(repository).AnyTimes()
+
+	u := mock_transaction.NewMockIUnitOfWork(ctrl)
+	u.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(r transaction.IRepositories) error) error {
+			return fn(tx)
+		},
+	).AnyTimes()
+
+	return u
+}
@@ -0,0 +1,102 @@
+package location
+
+import (
+	"context"
+	"errors"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type MoveRequestDto struct {
+	Id uuid.UUID
+	// ParentId is the stock location to move under, nil to make the location a root.
+	ParentId *uuid.UUID
+	// Versions are the versions the caller expects the stock location to be at, nil to skip the check.
+	Versions []int64
+}
+
+type MoveResponseDto struct {
+	Version int64
+}
+
+// Move places the stock location under another one, or at the root. The location takes its
+// descendants along. Moving a location where it already is succeeds without changing it.
+func Move(ctx context.Context, req *MoveRequestDto, u transaction.IUnitOfWork) (*MoveResponseDto, error) {
+	// Precondition
+	id, err := location.NewId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	var parentId *location.Id
+	if req.ParentId != nil {
+		v, err := location.NewId(*req.ParentId)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &v
+	}
+
+	res := &MoveResponseDto{}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		a, err := r.Location().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := a.CheckVersion(req.Versions); err != nil {
+			return err
+		}
+
+		// Main
+		before := a.ParentId()
+		if parentId == nil {
+			a.MoveToRoot()
+		} else if err := moveUnder(ctx, r.Location(), a, *parentId); err != nil {
+			return err
+		}
+
+		if !sameId(before, a.ParentId()) {
+			if err := r.Location().Save(ctx, a); err != nil {
+				return err
+			}
+		}
+
+		res.Version = a.Version()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// moveUnder places a under the stock location with the id. A parent that does not exist
+// is refused the same way as a deleted one.
+func moveUnder(ctx context.Context, r location.IRepository, a *location.Aggregate, parentId location.Id) error {
+	parent, err := r.Get(ctx, parentId)
+	if errors.Is(err, location.ErrNotFound) {
+		return location.ErrParentNotAvailable
+	}
+	if err != nil {
+		return err
+	}
+
+	path, err := r.Ancestors(ctx, parentId)
+	if err != nil {
+		return err
+	}
+
+	return a.MoveUnder(parent, path)
+}
+
+func sameId(a, b *location.Id) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
@@ -0,0 +1,168 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・指定した親ロケーションの下に移動し、バージョンが上がること
+// ・親ロケーションを指定しない場合はルートに移動すること
+func TestMove(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	a := saveLocation(t, r, false)
+	parentId := parent.Id.UUID()
+
+	// When
+	moved, err := app.Move(context.Background(), &app.MoveRequestDto{Id: a.Id.UUID(), ParentId: &parentId, Versions: []int64{1}}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	under, err := r.Location().Get(context.Background(), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root, err := app.Move(context.Background(), &app.MoveRequestDto{Id: a.Id.UUID()}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := r.Location().Get(context.Background(), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if moved.Version != 2 || root.Version != 3 {
+		t.Errorf("%v and %v, want 2 and 3", moved.Version, root.Version)
+	}
+
+	if p := under.ParentId(); p == nil || *p != parent.Id {
+		t.Errorf("%T = %v, want %v", p, p, parent.Id)
+	}
+
+	if p := after.ParentId(); p != nil {
+		t.Errorf("%T = %v, want nil", p, p)
+	}
+}
+
+// テスト観点
+// ・同じ親ロケーションの下に移動しても保存されず、バージョンが変わらないこと
+func TestMoveUnchanged(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	a := saveChild(t, r, parent)
+	parentId := parent.Id.UUID()
+
+	// When
+	resDto, err := app.Move(context.Background(), &app.MoveRequestDto{Id: a.Id.UUID(), ParentId: &parentId}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Version != a.Version() {
+		t.Errorf("%T = %v, want %v", resDto.Version, resDto.Version, a.Version())
+	}
+}
+
+// テスト観点
+// ・自分自身や子孫ロケーションの下には移動できずErrParentCycleとなること
+func TestMoveFailCycle(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, false)
+	child := saveChild(t, r, a)
+	grandchild := saveChild(t, r, child)
+
+	for _, parent := range []*domain.Aggregate{a, child, grandchild} {
+		parentId := parent.Id.UUID()
+
+		// When
+		_, err := app.Move(context.Background(), &app.MoveRequestDto{Id: a.Id.UUID(), ParentId: &parentId}, u)
+
+		// Then
+		if !errors.Is(err, domain.ErrParentCycle) {
+			t.Errorf("%v: %T = %v, want %v", parentId, err, err, domain.ErrParentCycle)
+		}
+	}
+}
+
+// テスト観点
+// ・存在しない親ロケーションや削除済みの親ロケーションの下には移動できずErrParentNotAvailableとなること
+func TestMoveFailParentNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, false)
+
+	for _, parentId := range []uuid.UUID{saveLocation(t, r, true).Id.UUID(), uuid.New()} {
+		// When
+		_, err := app.Move(context.Background(), &app.MoveRequestDto{Id: a.Id.UUID(), ParentId: &parentId}, u)
+
+		// Then
+		if !errors.Is(err, domain.ErrParentNotAvailable) {
+			t.Errorf("%v: %T = %v, want %v", parentId, err, err, domain.ErrParentNotAvailable)
+		}
+	}
+}
+
+// テスト観点
+// ・期待するバージョンと一致しない場合はErrVersionMismatchとなること
+func TestMoveFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, false)
+
+	// When
+	_, err := app.Move(context.Background(), &app.MoveRequestDto{Id: a.Id.UUID(), Versions: []int64{2}}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrVersionMismatch)
+	}
+}
+
+func TestMoveFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, _ := newMemory(t)
+
+	// When
+	_, err := app.Move(context.Background(), &app.MoveRequestDto{Id: uuid.New()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
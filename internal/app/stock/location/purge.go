@@ -0,0 +1,143 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type PurgeRequestDto struct {
+	Id uuid.UUID
+}
+
+// Purge removes a deleted stock location for good. It is refused while the location still has
+// stock on hand, child locations, or movements, transfers or serials, since they would point at nothing.
+func Purge(ctx context.Context, req *PurgeRequestDto, u transaction.IUnitOfWork) error {
+	// Precondition
+	id, err := location.NewId(req.Id)
+	if err != nil {
+		return err
+	}
+
+	return u.Do(ctx, func(r transaction.IRepositories) error {
+		return purge(ctx, r, id)
+	})
+}
+
+type PurgeDeletedRequestDto struct {
+	// DeletedBefore is when a stock location must have been deleted before to be purged.
+	DeletedBefore time.Time
+	// Limit is how many stock locations are looked at per transaction.
+	Limit int
+}
+
+type PurgeDeletedResponseDto struct {
+	Purged []uuid.UUID
+	// Refused are the stock locations that still have stock on hand, child locations or history.
+	Refused []uuid.UUID
+}
+
+// PurgeDeleted purges every stock location deleted before req.DeletedBefore, a page per transaction.
+// Locations that still have stock on hand, child locations or history are skipped and reported as refused.
+func PurgeDeleted(ctx context.Context, req *PurgeDeletedRequestDto, u transaction.IUnitOfWork) (*PurgeDeletedResponseDto, error) {
+	// Precondition
+	q := location.ListQuery{
+		DeletedBefore: &req.DeletedBefore,
+		Order:         location.OrderAsc,
+		Limit:         req.Limit,
+	}
+	if q.Limit <= 0 {
+		return nil, errors.New("PurgeDeleted: limit must be positive")
+	}
+
+	// Main
+	res := &PurgeDeletedResponseDto{
+		Purged:  []uuid.UUID{},
+		Refused: []uuid.UUID{},
+	}
+	for {
+		var as []*location.Aggregate
+		err := u.Do(ctx, func(r transaction.IRepositories) error {
+			var err error
+			as, err = r.Location().List(ctx, q)
+			if err != nil {
+				return err
+			}
+
+			for _, a := range as {
+				err := purge(ctx, r, a.Id)
+				switch {
+				case errors.Is(err, location.ErrInUse), errors.Is(err, location.ErrHasChildren), errors.Is(err, location.ErrHasHistory):
+					res.Refused = append(res.Refused, a.Id.UUID())
+				case err != nil:
+					return err
+				default:
+					res.Purged = append(res.Purged, a.Id.UUID())
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if len(as) < q.Limit {
+			return res, nil
+		}
+
+		// refused locations stay, so the next page starts after the last one looked at
+		last := as[len(as)-1]
+		q.After = &location.Cursor{Name: last.Name, Id: last.Id}
+	}
+}
+
+func purge(ctx context.Context, r transaction.IRepositories, id location.Id) error {
+	a, err := r.Location().Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := a.CheckPurgeable(); err != nil {
+		return err
+	}
+
+	// deleted children count too, as they keep pointing at their parent
+	cs, err := r.Location().List(ctx, location.ListQuery{ParentId: &id, IncludeDeleted: true, Order: location.OrderAsc, Limit: 1})
+	if err != nil {
+		return err
+	}
+	if len(cs) > 0 {
+		return location.ErrHasChildren
+	}
+
+	bs, err := r.Movement().ListBalances(ctx, movement.BalanceQuery{LocationId: &id})
+	if err != nil {
+		return err
+	}
+	if len(bs) > 0 {
+		return location.ErrInUse
+	}
+
+	// movements that net to zero are history all the same, and so are the transfers and serials
+	for _, find := range []func(context.Context, location.Id) (bool, error){
+		r.Movement().FindByLocation,
+		r.Transfer().FindByLocation,
+		r.Serial().FindByLocation,
+	} {
+		found, err := find(ctx, id)
+		if err != nil {
+			return err
+		}
+		if found {
+			return location.ErrHasHistory
+		}
+	}
+
+	return r.Location().Purge(ctx, id)
+}
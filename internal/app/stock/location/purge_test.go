@@ -0,0 +1,400 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	app "openapi/internal/app/stock/location"
+	"openapi/internal/domain/stock/item"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/repository/memory"
+	transactionInfra "openapi/internal/infra/repository/memory/stock/transaction"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// newMemory returns a unit of work and repositories over an empty in-memory database.
+func newMemory(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories) {
+	db := memory.Open()
+
+	u, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := transactionInfra.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return u, r
+}
+
+// saveLocation saves a new stock location, deleted when deleted is true.
+func saveLocation(t *testing.T, r transaction.IRepositories, deleted bool) *domain.Aggregate {
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := domain.NewAggregate(id, name)
+	if deleted {
+		a.Delete()
+	}
+	if err := r.Location().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// saveChild saves a new stock location under parent.
+func saveChild(t *testing.T, r transaction.IRepositories, parent *domain.Aggregate) *domain.Aggregate {
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := domain.NewAggregate(id, name)
+	if err := a.MoveUnder(parent, nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Location().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// receive puts quantity of a new item on hand in the stock location.
+func receive(t *testing.T, r transaction.IRepositories, a *domain.Aggregate, quantity int64) {
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	record(t, r, itemId, a, movement.Receipt, quantity)
+}
+
+// record records a movement of quantity of the item in the stock location.
+func record(t *testing.T, r transaction.IRepositories, itemId item.Id, a *domain.Aggregate, kind movement.Kind, quantity int64) {
+	id, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := movement.NewQuantity(kind, quantity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := r.Movement().Save(context.Background(), movement.NewAggregate(id, itemId, a.Id, kind, q)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// テスト観点
+// ・削除済みのロケーションが完全に削除されること
+func TestPurge(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, true)
+
+	// When
+	if err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: a.Id.UUID()}, u); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if _, err := r.Location().Get(context.Background(), a.Id); !errors.Is(err, domain.ErrNotFound) {
+		t.Errorf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
+
+func TestPurgeFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, _ := newMemory(t)
+
+	// When
+	err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: uuid.Nil}, u)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestPurgeFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, _ := newMemory(t)
+
+	// When
+	err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: uuid.New()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
+	}
+}
+
+// テスト観点
+// ・削除されていないロケーションはErrNotDeletedとなり、残ること
+func TestPurgeFailNotDeleted(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, false)
+
+	// When
+	err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: a.Id.UUID()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrNotDeleted) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotDeleted)
+	}
+
+	if _, err := r.Location().Get(context.Background(), a.Id); err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
+
+// テスト観点
+// ・在庫が残っているロケーションはErrInUseとなり、残ること
+func TestPurgeFailInUse(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, true)
+	receive(t, r, a, 3)
+
+	// When
+	err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: a.Id.UUID()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrInUse) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrInUse)
+	}
+
+	if _, err := r.Location().Get(context.Background(), a.Id); err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
+
+// テスト観点
+// ・在庫がなくても入出庫の履歴やシリアルが残っているロケーションはErrHasHistoryとなり、残ること
+func TestPurgeFailHasHistory(t *testing.T) {
+	t.Parallel()
+
+	for name, given := range map[string]func(t *testing.T, r transaction.IRepositories, a *domain.Aggregate){
+		"movements netting to zero": func(t *testing.T, r transaction.IRepositories, a *domain.Aggregate) {
+			itemId, err := item.NewId(uuid.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			record(t, r, itemId, a, movement.Receipt, 3)
+			record(t, r, itemId, a, movement.Issue, 3)
+		},
+		"serial": func(t *testing.T, r transaction.IRepositories, a *domain.Aggregate) {
+			id, err := serial.NewId(uuid.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			itemId, err := item.NewId(uuid.New())
+			if err != nil {
+				t.Fatal(err)
+			}
+			number, err := serial.NewNumber("SN-0001")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			s := serial.NewAggregate(id, itemId, number)
+			if err := s.Receive(a.Id); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Serial().Save(context.Background(), s); err != nil {
+				t.Fatal(err)
+			}
+		},
+	} {
+		// Setup
+		u, r := newMemory(t)
+
+		// Given
+		a := saveLocation(t, r, true)
+		given(t, r, a)
+
+		// When
+		err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: a.Id.UUID()}, u)
+
+		// Then
+		if !errors.Is(err, domain.ErrHasHistory) {
+			t.Errorf("%s: %T = %v, want %v", name, err, err, domain.ErrHasHistory)
+		}
+
+		if _, err := r.Location().Get(context.Background(), a.Id); err != nil {
+			t.Errorf("%s: %T = %v, want nil", name, err, err)
+		}
+	}
+}
+
+// テスト観点
+// ・子ロケーションが残っているロケーションは削除済みの子でもErrHasChildrenとなり、残ること
+func TestPurgeFailHasChildren(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, false)
+	child := saveChild(t, r, a)
+	for _, d := range []*domain.Aggregate{child, a} {
+		d.Delete()
+		if err := r.Location().Save(context.Background(), d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	err := app.Purge(context.Background(), &app.PurgeRequestDto{Id: a.Id.UUID()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrHasChildren) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrHasChildren)
+	}
+
+	if _, err := r.Location().Get(context.Background(), a.Id); err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
+
+// テスト観点
+// ・期限より前に削除されたロケーションだけが完全に削除されること
+// ・在庫や入出庫の履歴が残っているロケーションは拒否として返され、残ること
+// ・ページをまたいでも全件処理されること
+func TestPurgeDeleted(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	purged := map[uuid.UUID]bool{}
+	for i := 0; i < 3; i++ {
+		purged[saveLocation(t, r, true).Id.UUID()] = true
+	}
+	inUse := saveLocation(t, r, true)
+	receive(t, r, inUse, 1)
+	history := saveLocation(t, r, true)
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	record(t, r, itemId, history, movement.Receipt, 2)
+	record(t, r, itemId, history, movement.Issue, 2)
+	active := saveLocation(t, r, false)
+
+	// When
+	resDto, err := app.PurgeDeleted(context.Background(), &app.PurgeDeletedRequestDto{
+		DeletedBefore: time.Now().Add(time.Hour),
+		Limit:         2,
+	}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(resDto.Purged) != len(purged) {
+		t.Errorf("%T = %v, want %v", resDto.Purged, resDto.Purged, purged)
+	}
+	for _, id := range resDto.Purged {
+		if !purged[id] {
+			t.Errorf("%v must not be purged", id)
+		}
+	}
+
+	refused := map[uuid.UUID]bool{inUse.Id.UUID(): true, history.Id.UUID(): true}
+	if len(resDto.Refused) != len(refused) {
+		t.Errorf("%T = %v, want %v", resDto.Refused, resDto.Refused, refused)
+	}
+	for _, id := range resDto.Refused {
+		if !refused[id] {
+			t.Errorf("%v must not be refused", id)
+		}
+	}
+
+	for _, a := range []*domain.Aggregate{inUse, history, active} {
+		if _, err := r.Location().Get(context.Background(), a.Id); err != nil {
+			t.Errorf("%T = %v, want nil", err, err)
+		}
+	}
+}
+
+// テスト観点
+// ・期限より後に削除されたロケーションは削除されないこと
+func TestPurgeDeletedNotExpired(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	a := saveLocation(t, r, true)
+
+	// When
+	resDto, err := app.PurgeDeleted(context.Background(), &app.PurgeDeletedRequestDto{
+		DeletedBefore: time.Now().Add(-time.Hour),
+		Limit:         10,
+	}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(resDto.Purged) != 0 || len(resDto.Refused) != 0 {
+		t.Errorf("%+v, want nothing", resDto)
+	}
+
+	if _, err := r.Location().Get(context.Background(), a.Id); err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
+
+func TestPurgeDeletedFailInvalidLimit(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, _ := newMemory(t)
+
+	// When
+	_, err := app.PurgeDeleted(context.Background(), &app.PurgeDeletedRequestDto{DeletedBefore: time.Now()}, u)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
@@ -0,0 +1,71 @@
+package location
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type RestoreRequestDto struct {
+	Id uuid.UUID
+	// Versions are the versions the caller expects the stock location to be at, nil to skip the check.
+	Versions []int64
+}
+
+type RestoreResponseDto struct {
+	Version int64
+}
+
+// Restore undoes the deletion of the stock location, unless another active location has its name
+// or its parent is deleted. Restoring a location that is not deleted succeeds without changing it.
+func Restore(ctx context.Context, req *RestoreRequestDto, u transaction.IUnitOfWork) (*RestoreResponseDto, error) {
+	// Precondition
+	id, err := location.NewId(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RestoreResponseDto{}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		a, err := r.Location().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := a.CheckVersion(req.Versions); err != nil {
+			return err
+		}
+
+		// Main
+		if a.IsDeleted() {
+			a.Restore()
+
+			// the parent must be restored first
+			if p := a.ParentId(); p != nil {
+				if err := moveUnder(ctx, r.Location(), a, *p); err != nil {
+					return err
+				}
+			}
+
+			// another location may have taken the name while this one was deleted
+			if err := location.CheckNameAvailable(ctx, r.Location(), a); err != nil {
+				return err
+			}
+
+			if err = r.Location().Save(ctx, a); err != nil {
+				return err
+			}
+		}
+
+		res.Version = a.Version()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
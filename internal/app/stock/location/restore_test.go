@@ -0,0 +1,276 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・削除済みのロケーションが復元され、バージョンが上がること
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, true, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), a).DoAndReturn(func(_ context.Context, a *domain.Aggregate) error {
+		if a.IsDeleted() {
+			t.Errorf("%T = %v, want %v", a.IsDeleted(), a.IsDeleted(), false)
+		}
+		*a = *domain.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+		return nil
+	})
+
+	// Given
+	reqDto := &app.RestoreRequestDto{
+		Id:       id.UUID(),
+		Versions: []int64{2},
+	}
+
+	// When
+	resDto, err := app.Restore(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Version != 3 {
+		t.Errorf("%T = %v, want %v", resDto.Version, resDto.Version, 3)
+	}
+}
+
+// テスト観点
+// ・削除されていないロケーションは保存されず、バージョンも変わらないこと
+func TestRestoreNotDeleted(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, false, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.RestoreRequestDto{
+		Id: id.UUID(),
+	}
+
+	// When
+	resDto, err := app.Restore(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Version != 2 {
+		t.Errorf("%T = %v, want %v", resDto.Version, resDto.Version, 2)
+	}
+}
+
+func TestRestoreFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	// Given
+	reqDto := &app.RestoreRequestDto{
+		Id: uuid.Nil,
+	}
+
+	// When
+	_, err := app.Restore(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestRestoreFailSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
+
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, true, false, 1)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
+
+	// Given
+	reqDto := &app.RestoreRequestDto{
+		Id: id.UUID(),
+	}
+
+	// When
+	_, err = app.Restore(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+// テスト観点
+// ・期待するバージョンと一致しない場合はErrVersionMismatchとなり、保存されないこと
+func TestRestoreFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, true, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.RestoreRequestDto{
+		Id:       id.UUID(),
+		Versions: []int64{1},
+	}
+
+	// When
+	_, err = app.Restore(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrVersionMismatch)
+	}
+}
+
+// テスト観点
+// ・削除中に他のロケーションが同じ名前を使っている場合はErrNameTakenとなり、保存されないこと
+func TestRestoreFailNameTaken(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherId, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository.EXPECT().Get(gomock.Any(), id).Return(domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, true, false, 2), nil)
+	repository.EXPECT().FindByName(gomock.Any(), name).Return(domain.RestoreAggregate(otherId, name, domain.Warehouse, domain.Capacity{}, nil, false, false, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.RestoreRequestDto{
+		Id: id.UUID(),
+	}
+
+	// When
+	_, err = app.Restore(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrNameTaken) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNameTaken)
+	}
+}
+
+// テスト観点
+// ・親ロケーションが削除されている場合はErrParentNotAvailableとなり、復元されないこと
+func TestRestoreFailParentDeleted(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	parent := saveLocation(t, r, false)
+	a := saveChild(t, r, parent)
+	for _, d := range []*domain.Aggregate{a, parent} {
+		d.Delete()
+		if err := r.Location().Save(context.Background(), d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	_, err := app.Restore(context.Background(), &app.RestoreRequestDto{Id: a.Id.UUID()}, u)
+
+	// Then
+	if !errors.Is(err, domain.ErrParentNotAvailable) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrParentNotAvailable)
+	}
+
+	got, err := r.Location().Get(context.Background(), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.IsDeleted() {
+		t.Errorf("%v must stay deleted", a.Id)
+	}
+}
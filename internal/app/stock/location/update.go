@@ -1,7 +1,10 @@
 package location
 
 import (
+	"context"
+
 	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
 
 	"github.com/google/uuid"
 )
@@ -9,31 +12,86 @@ import (
 type UpdateRequestDto struct {
 	Id   uuid.UUID
 	Name string
+	// Versions are the versions the caller expects the stock location to be at, nil to skip the check.
+	Versions []int64
+	// Type, Capacity and AllowNegativeStock keep the current setting when nil.
+	// A capacity lowered below the stock on hand refuses further receipts but leaves the stock where it is.
+	Type               *string
+	Capacity           *CapacityDto
+	AllowNegativeStock *bool
+}
+
+type UpdateResponseDto struct {
+	Version int64
 }
 
-func Update(req *UpdateRequestDto, r location.IRepository) error {
+func Update(ctx context.Context, req *UpdateRequestDto, u transaction.IUnitOfWork) (*UpdateResponseDto, error) {
 	// Precondition
 	id, err := location.NewId(req.Id)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	a, err := r.Get(id)
+	newName, err := location.NewName(req.Name)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	newName, err := location.NewName(req.Name)
-	if err != nil {
-		return err
+	var newType *location.Type
+	if req.Type != nil {
+		t, err := location.NewType(*req.Type)
+		if err != nil {
+			return nil, err
+		}
+		newType = &t
 	}
 
-	// Main
-	a.Name = newName
+	var capacity *location.Capacity
+	if req.Capacity != nil {
+		c, err := newCapacity(req.Capacity)
+		if err != nil {
+			return nil, err
+		}
+		capacity = &c
+	}
 
-	if err = r.Save(a); err != nil {
-		return err
+	res := &UpdateResponseDto{}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		a, err := r.Location().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if err := a.CheckVersion(req.Versions); err != nil {
+			return err
+		}
+
+		// Main
+		a.Name = newName
+		if newType != nil {
+			a.Type = *newType
+		}
+		if capacity != nil {
+			a.Capacity = *capacity
+		}
+		if req.AllowNegativeStock != nil {
+			a.AllowNegativeStock = *req.AllowNegativeStock
+		}
+
+		if err := location.CheckNameAvailable(ctx, r.Location(), a); err != nil {
+			return err
+		}
+
+		if err = r.Location().Save(ctx, a); err != nil {
+			return err
+		}
+
+		res.Version = a.Version()
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return res, nil
 }
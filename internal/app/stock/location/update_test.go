@@ -1,12 +1,15 @@
 package location_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	app "openapi/internal/app/stock/location"
 	domain "openapi/internal/domain/stock/location"
 	"openapi/internal/infra/database"
 	mock "openapi/internal/infra/mock/domain/stock/location"
 	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	transactionInfra "openapi/internal/infra/repository/sqlboiler/stock/transaction"
 	"testing"
 
 	"github.com/golang/mock/gomock"
@@ -32,13 +35,18 @@ func TestUpdate(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	// Given
 	beforeName := "TestName" + uuid.NewString()
 	reqCreateDto := &app.CreateRequestDto{
 		Name: beforeName,
 	}
 
-	resCreateDto, err := app.Create(reqCreateDto, repository, uuid.New())
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, unitOfWork, uuid.New())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -50,7 +58,7 @@ func TestUpdate(t *testing.T) {
 		Name: afterName,
 	}
 
-	err = app.Update(reqUpdateDto, repository)
+	_, err = app.Update(context.Background(), reqUpdateDto, unitOfWork)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -61,7 +69,7 @@ func TestUpdate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	a, err := repository.Get(id)
+	a, err := repository.Get(context.Background(), id)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -81,7 +89,7 @@ func TestUpdateFailInvalidName(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -92,7 +100,7 @@ func TestUpdateFailInvalidName(t *testing.T) {
 		Name: beforeName,
 	}
 
-	resCreateDto, err := app.Create(reqCreateDto, repository, uuid.New())
+	resCreateDto, err := app.Create(context.Background(), reqCreateDto, unitOfWork, uuid.New())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -103,7 +111,7 @@ func TestUpdateFailInvalidName(t *testing.T) {
 		Id:   resCreateDto.Id,
 		Name: afterName,
 	}
-	err = app.Update(reqUpdateDto, repository)
+	_, err = app.Update(context.Background(), reqUpdateDto, unitOfWork)
 
 	// Then
 	if err == nil {
@@ -121,7 +129,7 @@ func TestUpdateFailInvalidId(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -133,7 +141,7 @@ func TestUpdateFailInvalidId(t *testing.T) {
 	}
 
 	// When
-	err = app.Update(reqDto, repository)
+	_, err = app.Update(context.Background(), reqDto, unitOfWork)
 
 	// Then
 	if err == nil {
@@ -141,7 +149,7 @@ func TestUpdateFailInvalidId(t *testing.T) {
 	}
 }
 
-func TestUpdateFailFind(t *testing.T) {
+func TestUpdateFailNotFound(t *testing.T) {
 	t.Parallel()
 
 	// Setup
@@ -151,7 +159,7 @@ func TestUpdateFailFind(t *testing.T) {
 	}
 	defer db.Close()
 
-	repository, err := infra.NewRepository(db)
+	unitOfWork, err := transactionInfra.NewUnitOfWork(db)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -163,11 +171,11 @@ func TestUpdateFailFind(t *testing.T) {
 	}
 
 	// When
-	err = app.Update(reqDto, repository)
+	_, err = app.Update(context.Background(), reqDto, unitOfWork)
 
 	// Then
-	if err == nil {
-		t.Fatalf("error must not be nil")
+	if !errors.Is(err, domain.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNotFound)
 	}
 }
 
@@ -179,8 +187,9 @@ func TestUpdateFailSave(t *testing.T) {
 	defer ctrl.Finish()
 
 	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, domain.ErrNotFound)
 
-	repository.EXPECT().Save(gomock.Any()).Return(fmt.Errorf("fail save"))
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
 
 	name, err := domain.NewName("TestName" + uuid.NewString())
 	if err != nil {
@@ -191,7 +200,7 @@ func TestUpdateFailSave(t *testing.T) {
 		t.Fatal(err)
 	}
 	a := domain.NewAggregate(id, name)
-	repository.EXPECT().Get(gomock.Any()).Return(a, nil)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
 
 	// Given
 	reqDto := &app.UpdateRequestDto{
@@ -200,10 +209,207 @@ func TestUpdateFailSave(t *testing.T) {
 	}
 
 	// When
-	err = app.Update(reqDto, repository)
+	_, err = app.Update(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
 
 	// Then
 	if err == nil {
 		t.Fatalf("error must not be nil")
 	}
 }
+
+// テスト観点
+// ・AllowNegativeStockを指定しない場合は現在の設定が維持されること
+// ・AllowNegativeStockを指定した場合は設定が変更されること
+func TestUpdateAllowNegativeStock(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, false, true, 1)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil).Times(2)
+	// the location keeps its own name
+	repository.EXPECT().FindByName(gomock.Any(), name).Return(a, nil).Times(2)
+
+	unitOfWork := newUnitOfWork(ctrl, repository)
+
+	var saved []bool
+	repository.EXPECT().Save(gomock.Any(), a).DoAndReturn(func(_ context.Context, a *domain.Aggregate) error {
+		saved = append(saved, a.AllowNegativeStock)
+		return nil
+	}).Times(2)
+
+	// When
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String()}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	disallow := false
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), AllowNegativeStock: &disallow}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(saved) != 2 || saved[0] != true || saved[1] != false {
+		t.Errorf("%T = %v, want %v", saved, saved, []bool{true, false})
+	}
+}
+
+// テスト観点
+// ・種別と容量を指定しない場合は現在の設定が維持されること
+// ・種別と容量を指定した場合は設定が変更され、空の容量は無制限となること
+func TestUpdateTypeAndCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	units := int64(10)
+	capacity, err := domain.NewCapacity(&units, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Bin, capacity, nil, false, false, 1)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil).Times(2)
+	// the location keeps its own name
+	repository.EXPECT().FindByName(gomock.Any(), name).Return(a, nil).Times(2)
+
+	unitOfWork := newUnitOfWork(ctrl, repository)
+
+	var saved []domain.Aggregate
+	repository.EXPECT().Save(gomock.Any(), a).DoAndReturn(func(_ context.Context, a *domain.Aggregate) error {
+		saved = append(saved, *a)
+		return nil
+	}).Times(2)
+
+	// When
+	if _, err := app.Update(context.Background(), &app.UpdateRequestDto{Id: id.UUID(), Name: name.String()}, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	staging := "staging"
+	reqDto := &app.UpdateRequestDto{Id: id.UUID(), Name: name.String(), Type: &staging, Capacity: &app.CapacityDto{}}
+	if _, err := app.Update(context.Background(), reqDto, unitOfWork); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(saved) != 2 {
+		t.Fatalf("%T = %v, want 2 saves", saved, saved)
+	}
+
+	if saved[0].Type != domain.Bin || saved[0].Capacity != capacity {
+		t.Errorf("%T = %+v, want a bin of %+v", saved[0], saved[0], capacity)
+	}
+
+	if saved[1].Type != domain.Staging || !saved[1].Capacity.IsUnlimited() {
+		t.Errorf("%T = %+v, want an unlimited staging location", saved[1], saved[1])
+	}
+}
+
+// テスト観点
+// ・期待するバージョンと一致しない場合はErrVersionMismatchとなり、保存されないこと
+func TestUpdateFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	a := domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, false, false, 2)
+	repository.EXPECT().Get(gomock.Any(), id).Return(a, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.UpdateRequestDto{
+		Id:       id.UUID(),
+		Name:     "TestName" + uuid.NewString(),
+		Versions: []int64{1},
+	}
+
+	// When
+	_, err = app.Update(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrVersionMismatch) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrVersionMismatch)
+	}
+}
+
+// テスト観点
+// ・他の有効なロケーションと同じ名前に変更する場合はErrNameTakenとなり、保存されないこと
+func TestUpdateFailNameTaken(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	repository := mock.NewMockIRepository(ctrl)
+
+	name, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	taken, err := domain.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherId, err := domain.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	repository.EXPECT().Get(gomock.Any(), id).Return(domain.RestoreAggregate(id, name, domain.Warehouse, domain.Capacity{}, nil, false, false, 1), nil)
+	repository.EXPECT().FindByName(gomock.Any(), taken).Return(domain.RestoreAggregate(otherId, taken, domain.Warehouse, domain.Capacity{}, nil, false, false, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	// Given
+	reqDto := &app.UpdateRequestDto{
+		Id:   id.UUID(),
+		Name: taken.String(),
+	}
+
+	// When
+	_, err = app.Update(context.Background(), reqDto, newUnitOfWork(ctrl, repository))
+
+	// Then
+	if !errors.Is(err, domain.ErrNameTaken) {
+		t.Fatalf("%T = %v, want %v", err, err, domain.ErrNameTaken)
+	}
+}
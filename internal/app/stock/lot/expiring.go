@@ -0,0 +1,106 @@
+package lot
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type ListExpiringRequestDto struct {
+	Today time.Time
+	// Within is how many days after today a lot must expire by to be listed.
+	Within int
+}
+
+type ExpiringDto struct {
+	ItemId         uuid.UUID
+	Lot            string
+	ManufacturedOn *time.Time
+	ExpiresOn      time.Time
+	// Expired tells that the lot expired before today.
+	Expired    bool
+	LocationId uuid.UUID
+	Quantity   int64
+}
+
+type ListExpiringResponseDto struct {
+	Items []*ExpiringDto
+}
+
+// ListExpiring returns the stock on hand of the lots that expire within some days of today, expired lots included,
+// one entry per lot and location. The lots that expire first come first, and the locations of a lot are
+// ordered by id.
+func ListExpiring(ctx context.Context, req *ListExpiringRequestDto, u transaction.IUnitOfWork) (*ListExpiringResponseDto, error) {
+	// Precondition
+	if req.Within < 0 {
+		return nil, errors.New("invalid within")
+	}
+
+	today := lot.DateOf(req.Today)
+	by := today.AddDays(req.Within)
+
+	// Main
+	var ls []*lot.Aggregate
+	balances := map[item.Id][]movement.Balance{}
+	err := u.Do(ctx, func(r transaction.IRepositories) error {
+		var err error
+		ls, err = r.Lot().List(ctx, lot.ListQuery{ExpiresBy: &by})
+		if err != nil {
+			return err
+		}
+
+		for _, l := range ls {
+			if _, ok := balances[l.ItemId]; ok {
+				continue
+			}
+
+			itemId := l.ItemId
+			bs, err := r.Movement().ListBalances(ctx, movement.BalanceQuery{ItemId: &itemId})
+			if err != nil {
+				return err
+			}
+			balances[itemId] = bs
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListExpiringResponseDto{
+		Items: []*ExpiringDto{},
+	}
+	for _, l := range ls {
+		var items []*ExpiringDto
+		for _, b := range balances[l.ItemId] {
+			if b.Lot != l.Number || b.Quantity <= 0 {
+				continue
+			}
+
+			items = append(items, &ExpiringDto{
+				ItemId:         l.ItemId.UUID(),
+				Lot:            l.Number.String(),
+				ManufacturedOn: timeOf(l.ManufacturedOn),
+				ExpiresOn:      l.ExpiresOn.Time(),
+				Expired:        l.IsExpired(today),
+				LocationId:     b.LocationId.UUID(),
+				Quantity:       b.Quantity,
+			})
+		}
+
+		sort.Slice(items, func(i, j int) bool {
+			return items[i].LocationId.String() < items[j].LocationId.String()
+		})
+		res.Items = append(res.Items, items...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,51 @@
+package lot_test
+
+import (
+	"context"
+	"testing"
+
+	app "openapi/internal/app/stock/lot"
+)
+
+// テスト観点
+// ・期限切れを含め、期間内に期限を迎えるロットの在庫がロケーションごとに返ること
+// ・期限の遅いロット、期限のないロット、在庫のないロットは含まれないこと
+func TestListExpiring(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	i := saveItem(t, r, true)
+	l := saveLocation(t, r)
+
+	expired := saveLot(t, r, i, "EXPIRED", "2024-06-30")
+	receive(t, r, expired, l, 1)
+	soon := saveLot(t, r, i, "SOON", "2024-07-31")
+	receive(t, r, soon, l, 2)
+	receive(t, r, saveLot(t, r, i, "LATER", "2024-08-01"), l, 4)
+	receive(t, r, saveLot(t, r, i, "NEVER", ""), l, 8)
+	saveLot(t, r, i, "EMPTY", "2024-07-15")
+
+	reqDto := &app.ListExpiringRequestDto{Today: newDay(t, "2024-07-01"), Within: 30}
+
+	// When
+	resDto, err := app.ListExpiring(context.Background(), reqDto, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(resDto.Items) != 2 {
+		t.Fatalf("%+v, want 2 items", resDto.Items)
+	}
+
+	if got := resDto.Items[0]; got.Lot != "EXPIRED" || !got.Expired || got.Quantity != 1 || got.LocationId != l.Id.UUID() {
+		t.Errorf("%+v, want the expired lot", got)
+	}
+
+	if got := resDto.Items[1]; got.Lot != "SOON" || got.Expired || got.Quantity != 2 || !got.ExpiresOn.Equal(newDay(t, "2024-07-31")) {
+		t.Errorf("%+v, want the lot expiring soon", got)
+	}
+}
@@ -0,0 +1,127 @@
+package lot_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/repository/memory"
+	transactionInfra "openapi/internal/infra/repository/memory/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+func newMemory(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories) {
+	db := memory.Open()
+
+	u, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := transactionInfra.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return u, r
+}
+
+// saveItem saves a new stock item, lot tracked when lotTracked is true.
+func saveItem(t *testing.T, r transaction.IRepositories, lotTracked bool) *item.Aggregate {
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku, err := item.NewSku("SKU-" + id.UUID().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := item.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, sku, name, item.Each)
+	a.LotTracked = lotTracked
+	if err := r.Item().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func saveLocation(t *testing.T, r transaction.IRepositories) *location.Aggregate {
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := location.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.NewAggregate(id, name)
+	if err := r.Location().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// saveLot saves a lot of i that expires on expiresOn, or never when it is empty.
+func saveLot(t *testing.T, r transaction.IRepositories, i *item.Aggregate, number string, expiresOn string) *lot.Aggregate {
+	id, err := lot.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := lot.NewNumber(number)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var d lot.Date
+	if expiresOn != "" {
+		d, err = lot.ParseDate(expiresOn)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a, err := lot.NewAggregate(id, i.Id, n, lot.Date{}, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := r.Lot().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+// receive saves a receipt of quantity of the lot into l.
+func receive(t *testing.T, r transaction.IRepositories, a *lot.Aggregate, l *location.Aggregate, quantity int64) {
+	id, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	q, err := movement.NewQuantity(movement.Receipt, quantity)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := movement.NewAggregate(id, a.ItemId, l.Id, movement.Receipt, q)
+	m.Lot = a.Number
+	if err := r.Movement().Save(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func newDay(t *testing.T, v string) time.Time {
+	d, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
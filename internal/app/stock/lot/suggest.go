@@ -0,0 +1,112 @@
+package lot
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type SuggestRequestDto struct {
+	ItemId     uuid.UUID
+	LocationId uuid.UUID
+	Quantity   int64
+	// Today is the day the lots are issued on, the lots that expired before it are not suggested.
+	Today time.Time
+}
+
+type PickDto struct {
+	Lot string
+	// ExpiresOn is nil for the lots that do not expire.
+	ExpiresOn *time.Time
+	Quantity  int64
+}
+
+type SuggestResponseDto struct {
+	Items []*PickDto
+}
+
+// Suggest tells which lots to issue a quantity of a lot tracked item from in a location, first expired first out.
+// It only advises, the issues are still recorded per lot.
+func Suggest(ctx context.Context, req *SuggestRequestDto, u transaction.IUnitOfWork) (*SuggestResponseDto, error) {
+	// Precondition
+	itemId, err := item.NewId(req.ItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	locationId, err := location.NewId(req.LocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Quantity <= 0 {
+		return nil, errors.New("invalid quantity")
+	}
+
+	// Main
+	var picks []movement.Pick
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		i, err := r.Item().Get(ctx, itemId)
+		if err != nil {
+			return err
+		}
+
+		if !i.LotTracked {
+			return movement.ErrNotLotTracked
+		}
+
+		found, err := r.Location().Find(ctx, locationId)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return location.ErrNotFound
+		}
+
+		bs, err := r.Movement().ListBalances(ctx, movement.BalanceQuery{ItemId: &itemId, LocationId: &locationId})
+		if err != nil {
+			return err
+		}
+
+		ls, err := r.Lot().List(ctx, lot.ListQuery{ItemId: &itemId})
+		if err != nil {
+			return err
+		}
+
+		picks, err = movement.SuggestFefo(bs, ls, req.Quantity, lot.DateOf(req.Today))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SuggestResponseDto{
+		Items: make([]*PickDto, 0, len(picks)),
+	}
+	for _, p := range picks {
+		res.Items = append(res.Items, &PickDto{
+			Lot:       p.Lot.Number.String(),
+			ExpiresOn: timeOf(p.Lot.ExpiresOn),
+			Quantity:  p.Quantity,
+		})
+	}
+
+	return res, nil
+}
+
+// timeOf returns the start of d, or nil when d is zero.
+func timeOf(d lot.Date) *time.Time {
+	if d.IsZero() {
+		return nil
+	}
+	t := d.Time()
+	return &t
+}
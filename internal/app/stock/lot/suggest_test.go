@@ -0,0 +1,90 @@
+package lot_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	app "openapi/internal/app/stock/lot"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・期限の早いロットから順に提案されること
+// ・期限切れのロットと他のロケーションの在庫は提案されないこと
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	i := saveItem(t, r, true)
+	l := saveLocation(t, r)
+	other := saveLocation(t, r)
+
+	receive(t, r, saveLot(t, r, i, "EXPIRED", "2024-06-30"), l, 10)
+	receive(t, r, saveLot(t, r, i, "NEVER", ""), l, 10)
+	later := saveLot(t, r, i, "LATER", "2024-12-31")
+	receive(t, r, later, l, 2)
+	receive(t, r, later, other, 10)
+	receive(t, r, saveLot(t, r, i, "FIRST", "2024-07-01"), l, 3)
+
+	reqDto := &app.SuggestRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Quantity: 6, Today: newDay(t, "2024-07-01")}
+
+	// When
+	resDto, err := app.Suggest(context.Background(), reqDto, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	first, last := newDay(t, "2024-07-01"), newDay(t, "2024-12-31")
+	want := []*app.PickDto{
+		{Lot: "FIRST", ExpiresOn: &first, Quantity: 3},
+		{Lot: "LATER", ExpiresOn: &last, Quantity: 2},
+		{Lot: "NEVER", Quantity: 1},
+	}
+	if !reflect.DeepEqual(resDto.Items, want) {
+		t.Errorf("%+v, want %+v", resDto.Items, want)
+	}
+}
+
+// テスト観点
+// ・ロット管理していない品目、存在しないロケーション、在庫不足はエラーとなること
+func TestSuggestFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	i := saveItem(t, r, true)
+	untracked := saveItem(t, r, false)
+	l := saveLocation(t, r)
+	receive(t, r, saveLot(t, r, i, "L1", ""), l, 2)
+
+	today := newDay(t, "2024-07-01")
+	tests := []struct {
+		reqDto *app.SuggestRequestDto
+		err    error
+	}{
+		{&app.SuggestRequestDto{ItemId: untracked.Id.UUID(), LocationId: l.Id.UUID(), Quantity: 1, Today: today}, movement.ErrNotLotTracked},
+		{&app.SuggestRequestDto{ItemId: i.Id.UUID(), LocationId: uuid.New(), Quantity: 1, Today: today}, location.ErrNotFound},
+		{&app.SuggestRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Quantity: 3, Today: today}, movement.ErrInsufficientQuantity},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := app.Suggest(context.Background(), tt.reqDto, u)
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, err, err, tt.err)
+		}
+	}
+}
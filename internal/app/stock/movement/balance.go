@@ -0,0 +1,152 @@
+package movement
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+)
+
+const (
+	DefaultListLimit = 20
+	MaxListLimit     = 100
+)
+
+var ErrInvalidCursor = failure.Validation("invalid_cursor", "invalid cursor")
+
+type ListBalancesRequestDto struct {
+	Limit      int
+	After      string
+	ItemId     *uuid.UUID
+	LocationId *uuid.UUID
+}
+
+type BalanceDto struct {
+	ItemId     uuid.UUID
+	LocationId uuid.UUID
+	// Lot is empty for the items that are not lot tracked.
+	Lot      string
+	Quantity int64
+}
+
+type ListBalancesResponseDto struct {
+	Items      []*BalanceDto
+	NextCursor string
+}
+
+// ListBalances returns the non-zero quantities on hand page by page, optionally narrowed to an item and/or a location.
+// The stock of a lot tracked item has a balance per lot.
+func ListBalances(ctx context.Context, req *ListBalancesRequestDto, r movement.IRepository) (*ListBalancesResponseDto, error) {
+	// Precondition
+	limit := req.Limit
+	if limit == 0 {
+		limit = DefaultListLimit
+	}
+	if limit < 0 || limit > MaxListLimit {
+		return nil, errors.New("invalid limit")
+	}
+
+	// One extra balance is fetched to find out whether a next page exists.
+	q := movement.BalanceQuery{Limit: limit + 1}
+
+	if req.After != "" {
+		c, err := decodeCursor(req.After)
+		if err != nil {
+			return nil, err
+		}
+		q.After = c
+	}
+
+	if req.ItemId != nil {
+		id, err := item.NewId(*req.ItemId)
+		if err != nil {
+			return nil, err
+		}
+		q.ItemId = &id
+	}
+
+	if req.LocationId != nil {
+		id, err := location.NewId(*req.LocationId)
+		if err != nil {
+			return nil, err
+		}
+		q.LocationId = &id
+	}
+
+	// Main
+	bs, err := r.ListBalances(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListBalancesResponseDto{
+		Items: make([]*BalanceDto, 0, len(bs)),
+	}
+
+	if len(bs) > limit {
+		bs = bs[:limit]
+		res.NextCursor = encodeCursor(bs[len(bs)-1])
+	}
+
+	for _, b := range bs {
+		res.Items = append(res.Items, &BalanceDto{
+			ItemId:     b.ItemId.UUID(),
+			LocationId: b.LocationId.UUID(),
+			Lot:        b.Lot.String(),
+			Quantity:   b.Quantity,
+		})
+	}
+
+	return res, nil
+}
+
+type cursor struct {
+	ItemId     uuid.UUID `json:"i"`
+	LocationId uuid.UUID `json:"l"`
+	Lot        string    `json:"n,omitempty"`
+}
+
+func encodeCursor(b movement.Balance) string {
+	v, _ := json.Marshal(&cursor{ItemId: b.ItemId.UUID(), LocationId: b.LocationId.UUID(), Lot: b.Lot.String()})
+	return base64.RawURLEncoding.EncodeToString(v)
+}
+
+func decodeCursor(v string) (*movement.BalanceCursor, error) {
+	b, err := base64.RawURLEncoding.DecodeString(v)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	c := &cursor{}
+	if err := json.Unmarshal(b, c); err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	itemId, err := item.NewId(c.ItemId)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	locationId, err := location.NewId(c.LocationId)
+	if err != nil {
+		return nil, ErrInvalidCursor
+	}
+
+	var number lot.Number
+	if c.Lot != "" {
+		number, err = lot.NewNumber(c.Lot)
+		if err != nil {
+			return nil, ErrInvalidCursor
+		}
+	}
+
+	return &movement.BalanceCursor{ItemId: itemId, LocationId: locationId, Lot: number}, nil
+}
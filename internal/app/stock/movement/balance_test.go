@@ -0,0 +1,150 @@
+package movement_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/movement"
+	"openapi/internal/domain/stock/movement"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+// テスト観点
+// ・エラーが発生しないこと
+// ・指定した品目とロケーションで絞り込んで在庫数を取得すること
+func TestListBalances(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l := newLocation(t, false, false)
+	r.movement.EXPECT().ListBalances(gomock.Any(), movement.BalanceQuery{ItemId: &i.Id, LocationId: &l.Id, Limit: app.DefaultListLimit + 1}).Return([]movement.Balance{
+		{ItemId: i.Id, LocationId: l.Id, Quantity: 7},
+	}, nil)
+
+	itemId, locationId := i.Id.UUID(), l.Id.UUID()
+	reqDto := &app.ListBalancesRequestDto{
+		ItemId:     &itemId,
+		LocationId: &locationId,
+	}
+
+	// When
+	resDto, err := app.ListBalances(context.Background(), reqDto, r.movement)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(resDto.Items) != 1 {
+		t.Fatalf("%T = %v, want %v", len(resDto.Items), len(resDto.Items), 1)
+	}
+
+	if *resDto.Items[0] != (app.BalanceDto{ItemId: itemId, LocationId: locationId, Quantity: 7}) {
+		t.Errorf("%T = %+v", resDto.Items[0], resDto.Items[0])
+	}
+}
+
+// テスト観点
+// ・上限を超える在庫数があるとき次のページのカーソルを返すこと
+// ・カーソルで指定した在庫数の次から取得すること
+func TestListBalancesPage(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l1, l2 := newLocation(t, false, false), newLocation(t, false, false)
+	r.movement.EXPECT().ListBalances(gomock.Any(), movement.BalanceQuery{Limit: 2}).Return([]movement.Balance{
+		{ItemId: i.Id, LocationId: l1.Id, Quantity: 7},
+		{ItemId: i.Id, LocationId: l2.Id, Quantity: 3},
+	}, nil)
+
+	// When
+	first, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{Limit: 1}, r.movement)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.movement.EXPECT().ListBalances(gomock.Any(), movement.BalanceQuery{
+		After: &movement.BalanceCursor{ItemId: i.Id, LocationId: l1.Id},
+		Limit: 2,
+	}).Return([]movement.Balance{
+		{ItemId: i.Id, LocationId: l2.Id, Quantity: 3},
+	}, nil)
+
+	second, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{Limit: 1, After: first.NextCursor}, r.movement)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(first.Items) != 1 || first.NextCursor == "" {
+		t.Errorf("%T = %+v, want 1 item and a cursor", first, first)
+	}
+
+	if len(second.Items) != 1 || second.NextCursor != "" {
+		t.Errorf("%T = %+v, want 1 item and no cursor", second, second)
+	}
+}
+
+func TestListBalancesFailInvalidCursor(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	for _, after := range []string{"!", "bm90IGpzb24", "eyJpIjoiMDAwMDAwMDAtMDAwMC0wMDAwLTAwMDAtMDAwMDAwMDAwMDAwIn0"} {
+		// When
+		_, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{After: after}, r.movement)
+
+		// Then
+		if !errors.Is(err, app.ErrInvalidCursor) {
+			t.Errorf("%s: %T = %v, want %v", after, err, err, app.ErrInvalidCursor)
+		}
+	}
+}
+
+func TestListBalancesFailInvalidId(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	itemId := uuid.Nil
+	reqDto := &app.ListBalancesRequestDto{
+		ItemId: &itemId,
+	}
+
+	// When
+	_, err := app.ListBalances(context.Background(), reqDto, r.movement)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestListBalancesFailRepository(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+	r.movement.EXPECT().ListBalances(gomock.Any(), gomock.Any()).Return(nil, fmt.Errorf("fail list"))
+
+	// When
+	_, err := app.ListBalances(context.Background(), &app.ListBalancesRequestDto{}, r.movement)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
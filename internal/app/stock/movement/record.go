@@ -0,0 +1,311 @@
+package movement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+)
+
+var (
+	ErrInvalidMovement      = failure.Validation("invalid_stock_movement", "invalid stock movement")
+	ErrItemNotAvailable     = failure.Validation("stock_item_not_available", "stock item not found or deleted")
+	ErrLocationNotAvailable = failure.Validation("stock_location_not_available", "stock location not found or deleted")
+	ErrLotNotAvailable      = failure.Validation("stock_lot_not_available", "stock lot not found for the stock item")
+	ErrSerialNotAvailable   = failure.Validation("stock_serial_not_available", "stock serial not found for the stock item")
+)
+
+type RecordRequestDto struct {
+	ItemId     uuid.UUID
+	LocationId uuid.UUID
+	Kind       string
+	Quantity   int64
+	// Unit is the unit of Quantity, the base unit of the item or one of its pack units.
+	// The base unit applies when empty.
+	Unit string
+	// Lot is the lot of a lot tracked item, empty for the other items.
+	Lot string
+	// ManufacturedOn and ExpiresOn are the dates of the lot, only given with a receipt.
+	// The receipt that records a new lot sets them, and the later ones must agree with them.
+	ManufacturedOn *time.Time
+	ExpiresOn      *time.Time
+	// Serials are the units of a serialized item, one per unit of the quantity, and empty for the other items.
+	Serials []string
+}
+
+type RecordResponseDto struct {
+	Id       uuid.UUID
+	Quantity int64
+}
+
+// Record appends a movement to the ledger in the base unit of the item after checking that the resulting balance is allowed in the location
+// and that a receipt fits in its capacity. The movements of a lot tracked item name their lot, which the first
+// receipt of its number records. Those of a serialized item name their serials, which the first receipt
+// of their number registers and which are in one location at a time.
+func Record(ctx context.Context, req *RecordRequestDto, u transaction.IUnitOfWork, newId func() uuid.UUID) (*RecordResponseDto, error) {
+	// Precondition
+	kind, err := movement.NewKind(req.Kind)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMovement.With("kind", req.Kind), err)
+	}
+
+	if kind.IsTransfer() {
+		return nil, fmt.Errorf("%w: only recorded by a transfer", ErrInvalidMovement.With("kind", req.Kind))
+	}
+
+	quantity, err := movement.NewQuantity(kind, req.Quantity)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMovement.With("kind", req.Kind).With("quantity", req.Quantity), err)
+	}
+
+	itemId, err := item.NewId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMovement.With("item_id", req.ItemId), err)
+	}
+
+	locationId, err := location.NewId(req.LocationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidMovement.With("location_id", req.LocationId), err)
+	}
+
+	var unit *item.Unit
+	if req.Unit != "" {
+		v, err := item.NewUnit(req.Unit)
+		if err != nil {
+			return nil, err
+		}
+		unit = &v
+	}
+
+	var lotNumber lot.Number
+	if req.Lot != "" {
+		lotNumber, err = lot.NewNumber(req.Lot)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var manufacturedOn, expiresOn lot.Date
+	if req.ManufacturedOn != nil || req.ExpiresOn != nil {
+		if kind != movement.Receipt || lotNumber.IsZero() {
+			return nil, ErrInvalidMovement.With("reason", "the dates of a lot are only given with a receipt of it")
+		}
+		if req.ManufacturedOn != nil {
+			manufacturedOn = lot.DateOf(*req.ManufacturedOn)
+		}
+		if req.ExpiresOn != nil {
+			expiresOn = lot.DateOf(*req.ExpiresOn)
+		}
+	}
+
+	serials, err := serial.NewNumbers(req.Serials)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := movement.NewId(newId())
+	if err != nil {
+		return nil, err
+	}
+
+	a := movement.NewAggregate(id, itemId, locationId, kind, quantity)
+	a.Lot = lotNumber
+	a.Serials = serials
+
+	res := &RecordResponseDto{Id: a.Id.UUID()}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		i, err := getItem(ctx, itemId, r.Item())
+		if err != nil {
+			return err
+		}
+
+		if i.IsDeleted() {
+			return ErrItemNotAvailable
+		}
+
+		l, err := getLocation(ctx, locationId, r.Location())
+		if err != nil {
+			return err
+		}
+
+		if l.IsDeleted() {
+			return ErrLocationNotAvailable
+		}
+
+		if unit != nil {
+			if err := toBase(i, a, *unit, req.Quantity); err != nil {
+				return err
+			}
+		}
+
+		if err := movement.CheckLot(i, a); err != nil {
+			return err
+		}
+
+		if err := movement.CheckSerials(i, a); err != nil {
+			return err
+		}
+
+		// Main
+		if !a.Lot.IsZero() {
+			if err := recordLot(ctx, r.Lot(), a, manufacturedOn, expiresOn, newId); err != nil {
+				return err
+			}
+		}
+
+		if len(a.Serials) > 0 {
+			if err := recordSerials(ctx, r.Serial(), a, newId); err != nil {
+				return err
+			}
+		}
+
+		before, err := r.Movement().GetBalance(ctx, itemId, locationId, a.Lot)
+		if err != nil {
+			return err
+		}
+
+		after, err := before.Apply(a, l)
+		if err != nil {
+			return err
+		}
+
+		if err := movement.CheckCapacity(ctx, r.Movement(), r.Item(), l, a); err != nil {
+			return err
+		}
+
+		if err := r.Movement().Save(ctx, a); err != nil {
+			return err
+		}
+
+		res.Quantity = after.Quantity
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// toBase sets the quantity of m to the requested quantity, which is counted in unit, in the base unit of i.
+func toBase(i *item.Aggregate, m *movement.Aggregate, unit item.Unit, quantity int64) error {
+	v, err := i.ToBase(unit, quantity)
+	if errors.Is(err, item.ErrUnknownUnit) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMovement.With("quantity", quantity).With("unit", unit.String()), err)
+	}
+
+	q, err := movement.NewQuantity(m.Kind, v)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidMovement.With("quantity", v), err)
+	}
+
+	m.Quantity = q
+	return nil
+}
+
+// recordLot records the lot of a receipt the first time its number is received, and otherwise checks
+// that the lot of m exists and has the dates given with it.
+func recordLot(ctx context.Context, r lot.IRepository, m *movement.Aggregate, manufacturedOn lot.Date, expiresOn lot.Date, newId func() uuid.UUID) error {
+	found, err := r.Get(ctx, m.ItemId, m.Lot)
+	if errors.Is(err, lot.ErrNotFound) {
+		if m.Kind != movement.Receipt {
+			return ErrLotNotAvailable
+		}
+
+		id, err := lot.NewId(newId())
+		if err != nil {
+			return err
+		}
+
+		a, err := lot.NewAggregate(id, m.ItemId, m.Lot, manufacturedOn, expiresOn)
+		if err != nil {
+			return err
+		}
+
+		return r.Save(ctx, a)
+	}
+	if err != nil {
+		return err
+	}
+
+	return found.CheckDates(manufacturedOn, expiresOn)
+}
+
+// recordSerials moves the serials of m the way m does. A serial that is not registered yet is registered
+// when m brings it in, and is not available to a movement taking it out.
+func recordSerials(ctx context.Context, r serial.IRepository, m *movement.Aggregate, newId func() uuid.UUID) error {
+	for _, n := range m.Serials {
+		a, err := getSerial(ctx, r, m, n, newId)
+		if err != nil {
+			return err
+		}
+
+		if err := movement.ApplySerial(a, m); err != nil {
+			return err
+		}
+
+		if err := r.Save(ctx, a); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getSerial(ctx context.Context, r serial.IRepository, m *movement.Aggregate, n serial.Number, newId func() uuid.UUID) (*serial.Aggregate, error) {
+	a, err := r.Get(ctx, m.ItemId, n)
+	if errors.Is(err, serial.ErrNotFound) {
+		if m.Quantity.Int64() < 0 {
+			return nil, ErrSerialNotAvailable.With("serial", n.String())
+		}
+
+		id, err := serial.NewId(newId())
+		if err != nil {
+			return nil, err
+		}
+
+		return serial.NewAggregate(id, m.ItemId, n), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func getItem(ctx context.Context, id item.Id, r item.IRepository) (*item.Aggregate, error) {
+	a, err := r.Get(ctx, id)
+	if errors.Is(err, item.ErrNotFound) {
+		return nil, ErrItemNotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
+
+func getLocation(ctx context.Context, id location.Id, r location.IRepository) (*location.Aggregate, error) {
+	a, err := r.Get(ctx, id)
+	if errors.Is(err, location.ErrNotFound) {
+		return nil, ErrLocationNotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return a, nil
+}
@@ -0,0 +1,747 @@
+package movement_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/movement"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/measure"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	mock_item "openapi/internal/infra/mock/domain/stock/item"
+	mock_location "openapi/internal/infra/mock/domain/stock/location"
+	mock_movement "openapi/internal/infra/mock/domain/stock/movement"
+	mock_transaction "openapi/internal/infra/mock/domain/stock/transaction"
+	"openapi/internal/infra/repository/memory"
+	memoryInfra "openapi/internal/infra/repository/memory/stock/transaction"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+type repositories struct {
+	item       *mock_item.MockIRepository
+	location   *mock_location.MockIRepository
+	movement   *mock_movement.MockIRepository
+	unitOfWork *mock_transaction.MockIUnitOfWork
+}
+
+func setup(t *testing.T) *repositories {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := &repositories{
+		item:       mock_item.NewMockIRepository(ctrl),
+		location:   mock_location.NewMockIRepository(ctrl),
+		movement:   mock_movement.NewMockIRepository(ctrl),
+		unitOfWork: mock_transaction.NewMockIUnitOfWork(ctrl),
+	}
+
+	tx := mock_transaction.NewMockIRepositories(ctrl)
+	tx.EXPECT().Item().Return(r.item).AnyTimes()
+	tx.EXPECT().Location().Return(r.location).AnyTimes()
+	tx.EXPECT().Movement().Return(r.movement).AnyTimes()
+
+	r.unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(r transaction.IRepositories) error) error {
+			return fn(tx)
+		},
+	).AnyTimes()
+
+	return r
+}
+
+func newItem(t *testing.T, deleted bool) *item.Aggregate {
+	t.Helper()
+
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item.RestoreAggregate(id, item.Sku{}, name, item.Each, nil, nil, measure.Volume{}, measure.Weight{}, false, false, deleted, 1)
+}
+
+func newLocation(t *testing.T, deleted bool, allowNegativeStock bool) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.RestoreAggregate(id, name, location.Warehouse, location.Capacity{}, nil, deleted, allowNegativeStock, 1)
+}
+
+// newMemory returns a unit of work on an empty database kept in memory, and the repositories that read it,
+// holding a lot tracked item and a location.
+func newMemory(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories, *item.Aggregate, *location.Aggregate) {
+	t.Helper()
+
+	db := memory.Open()
+	u, err := memoryInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := memoryInfra.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	at := newLocation(t, false, false)
+	l := location.NewAggregate(at.Id, at.Name)
+	if err := r.Location().Save(context.Background(), l); err != nil {
+		t.Fatal(err)
+	}
+
+	return u, r, saveItem(t, r, true), l
+}
+
+// saveItem saves a new stock item with a SKU of its own into r.
+func saveItem(t *testing.T, r transaction.IRepositories, lotTracked bool) *item.Aggregate {
+	t.Helper()
+
+	given := newItem(t, false)
+	sku, err := item.NewSku("SKU-" + given.Id.UUID().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := item.NewAggregate(given.Id, sku, given.Name, given.BaseUnit())
+	i.LotTracked = lotTracked
+	if err := r.Item().Save(context.Background(), i); err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
+
+// saveSerializedItem saves a new serialized stock item with a SKU of its own into r.
+func saveSerializedItem(t *testing.T, r transaction.IRepositories) *item.Aggregate {
+	t.Helper()
+
+	i := saveItem(t, r, false)
+	i.Serialized = true
+	if err := r.Item().Save(context.Background(), i); err != nil {
+		t.Fatal(err)
+	}
+	return i
+}
+
+func newDay(t *testing.T, v string) *time.Time {
+	t.Helper()
+
+	d, err := time.Parse(time.DateOnly, v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &d
+}
+
+func (r *repositories) expectFound(i *item.Aggregate, l *location.Aggregate) {
+	r.item.EXPECT().Get(gomock.Any(), i.Id).Return(i, nil)
+	r.location.EXPECT().Get(gomock.Any(), l.Id).Return(l, nil)
+}
+
+// テスト観点
+// ・エラーが発生しないこと
+// ・出庫した数量を差し引いた在庫数が返ること
+// ・符号付きの数量で移動が保存されること
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l := newLocation(t, false, false)
+	r.expectFound(i, l)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, l.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: l.Id, Quantity: 5}, nil)
+
+	var saved *movement.Aggregate
+	r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *movement.Aggregate) error {
+		saved = a
+		return nil
+	})
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "issue",
+		Quantity:   2,
+	}
+
+	// When
+	resDto, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Quantity != 3 {
+		t.Errorf("%T = %v, want %v", resDto.Quantity, resDto.Quantity, 3)
+	}
+
+	if saved == nil || saved.Id.UUID() != resDto.Id {
+		t.Fatalf("%T = %v, want id %v", saved, saved, resDto.Id)
+	}
+
+	if saved.Quantity.Int64() != -2 {
+		t.Errorf("%T = %v, want %v", saved.Quantity.Int64(), saved.Quantity.Int64(), -2)
+	}
+}
+
+// newPackedItem returns an item counted in each that is also packed in cases of 12.
+func newPackedItem(t *testing.T) *item.Aggregate {
+	t.Helper()
+
+	given := newItem(t, false)
+
+	unit, err := item.NewUnit("case")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := item.NewPackSize(unit, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item.RestoreAggregate(given.Id, item.Sku{}, given.Name, item.Each, nil, []item.PackSize{p}, measure.Volume{}, measure.Weight{}, false, false, false, 1)
+}
+
+// テスト観点
+// ・パックの単位で指定した数量が基本単位に換算されて保存されること
+// ・基本単位を指定した数量はそのまま保存されること
+func TestRecordUnit(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kind     string
+		quantity int64
+		unit     string
+		want     int64
+	}{
+		{"receipt", 2, "case", 24},
+		{"adjustment", -1, "CASE", -12},
+		{"receipt", 5, "each", 5},
+		{"issue", 3, "case", -36},
+	}
+
+	for _, tt := range tests {
+		// Setup
+		r := setup(t)
+
+		// Given
+		i := newPackedItem(t)
+		l := newLocation(t, false, true)
+		r.expectFound(i, l)
+		r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, l.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: l.Id}, nil)
+
+		var saved *movement.Aggregate
+		r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *movement.Aggregate) error {
+			saved = a
+			return nil
+		})
+
+		reqDto := &app.RecordRequestDto{
+			ItemId:     i.Id.UUID(),
+			LocationId: l.Id.UUID(),
+			Kind:       tt.kind,
+			Quantity:   tt.quantity,
+			Unit:       tt.unit,
+		}
+
+		// When
+		resDto, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if saved == nil || saved.Quantity.Int64() != tt.want {
+			t.Errorf("%+v %T = %v, want %v", tt, saved, saved, tt.want)
+		}
+
+		if resDto.Quantity != tt.want {
+			t.Errorf("%+v %T = %v, want %v", tt, resDto.Quantity, resDto.Quantity, tt.want)
+		}
+	}
+}
+
+// テスト観点
+// ・品目にない単位を指定した移動はエラーとなり、保存されないこと
+func TestRecordFailUnknownUnit(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newPackedItem(t)
+	l := newLocation(t, false, false)
+	r.expectFound(i, l)
+	r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "receipt",
+		Quantity:   1,
+		Unit:       "pallet",
+	}
+
+	// When
+	_, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, item.ErrUnknownUnit) {
+		t.Errorf("%T = %v, want %v", err, err, item.ErrUnknownUnit)
+	}
+}
+
+// テスト観点
+// ・在庫数がマイナスになる出庫はエラーとなり、保存されないこと
+func TestRecordFailInsufficientQuantity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l := newLocation(t, false, false)
+	r.expectFound(i, l)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, l.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: l.Id, Quantity: 1}, nil)
+	r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "issue",
+		Quantity:   2,
+	}
+
+	// When
+	_, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, movement.ErrInsufficientQuantity) {
+		t.Fatalf("%T = %v, want %v", err, err, movement.ErrInsufficientQuantity)
+	}
+}
+
+// テスト観点
+// ・マイナス在庫を許可したロケーションでは在庫数がマイナスになる出庫ができること
+func TestRecordAllowNegativeStock(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l := newLocation(t, false, true)
+	r.expectFound(i, l)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, l.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: l.Id, Quantity: 1}, nil)
+	r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil)
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "issue",
+		Quantity:   2,
+	}
+
+	// When
+	resDto, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Quantity != -1 {
+		t.Errorf("%T = %v, want %v", resDto.Quantity, resDto.Quantity, -1)
+	}
+}
+
+// テスト観点
+// ・容量を超える入庫はエラーとなり、保存されないこと
+// ・ロケーションの全品目の在庫数で容量が判定されること
+func TestRecordFailOverCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	other := newItem(t, false)
+	l := newLocation(t, false, false)
+
+	units := int64(10)
+	capacity, err := location.NewCapacity(&units, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Capacity = capacity
+
+	r.expectFound(i, l)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, l.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: l.Id, Quantity: 2}, nil)
+	r.movement.EXPECT().ListBalances(gomock.Any(), movement.BalanceQuery{LocationId: &l.Id}).Return([]movement.Balance{
+		{ItemId: i.Id, LocationId: l.Id, Quantity: 2},
+		{ItemId: other.Id, LocationId: l.Id, Quantity: 6},
+	}, nil)
+	r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "receipt",
+		Quantity:   3,
+	}
+
+	// When
+	_, err = app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, location.ErrOverCapacity) {
+		t.Fatalf("%T = %v, want %v", err, err, location.ErrOverCapacity)
+	}
+}
+
+func TestRecordFailInvalidMovement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kind     string
+		quantity int64
+	}{
+		{"transfer", 1},
+		{"transfer_out", 1},
+		{"transfer_in", 1},
+		{"receipt", 0},
+		{"issue", -1},
+		{"adjustment", 0},
+	}
+
+	for _, tt := range tests {
+		// Setup
+		r := setup(t)
+
+		// Given
+		reqDto := &app.RecordRequestDto{
+			ItemId:     uuid.New(),
+			LocationId: uuid.New(),
+			Kind:       tt.kind,
+			Quantity:   tt.quantity,
+		}
+
+		// When
+		_, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+		// Then
+		if !errors.Is(err, app.ErrInvalidMovement) {
+			t.Errorf("%+v %T = %v, want %v", tt, err, err, app.ErrInvalidMovement)
+		}
+	}
+}
+
+func TestRecordFailItemNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+	r.item.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, item.ErrNotFound)
+
+	// Given
+	reqDto := &app.RecordRequestDto{
+		ItemId:     uuid.New(),
+		LocationId: uuid.New(),
+		Kind:       "receipt",
+		Quantity:   1,
+	}
+
+	// When
+	_, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, app.ErrItemNotAvailable) {
+		t.Fatalf("%T = %v, want %v", err, err, app.ErrItemNotAvailable)
+	}
+}
+
+func TestRecordFailDeletedLocation(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l := newLocation(t, true, false)
+	r.expectFound(i, l)
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "receipt",
+		Quantity:   1,
+	}
+
+	// When
+	_, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, app.ErrLocationNotAvailable) {
+		t.Fatalf("%T = %v, want %v", err, err, app.ErrLocationNotAvailable)
+	}
+}
+
+func TestRecordFailSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, false)
+	l := newLocation(t, false, false)
+	r.expectFound(i, l)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, l.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: l.Id}, nil)
+	r.movement.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
+
+	reqDto := &app.RecordRequestDto{
+		ItemId:     i.Id.UUID(),
+		LocationId: l.Id.UUID(),
+		Kind:       "receipt",
+		Quantity:   1,
+	}
+
+	// When
+	_, err := app.Record(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+// テスト観点
+// ・ロット管理品目の入庫でロットが日付とともに登録されること
+// ・同じロットの入庫では日付を省略できること
+// ・在庫数がロットごとに返ること
+func TestRecordLot(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r, i, l := newMemory(t)
+
+	// Given
+	receipts := []*app.RecordRequestDto{
+		{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 5, Lot: "L1",
+			ManufacturedOn: newDay(t, "2024-07-01"), ExpiresOn: newDay(t, "2025-06-30")},
+		{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 3, Lot: "L1"},
+		{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 4, Lot: "L2"},
+	}
+	for _, reqDto := range receipts {
+		if _, err := app.Record(context.Background(), reqDto, u, uuid.New); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reqDto := &app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "issue", Quantity: 6, Lot: "L1"}
+
+	// When
+	resDto, err := app.Record(context.Background(), reqDto, u, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Quantity != 2 {
+		t.Errorf("%T = %v, want %v", resDto.Quantity, resDto.Quantity, 2)
+	}
+
+	number, err := lot.NewNumber("L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := r.Lot().Get(context.Background(), i.Id, number)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.ManufacturedOn.String() != "2024-07-01" || a.ExpiresOn.String() != "2025-06-30" {
+		t.Errorf("%T = %+v, want the dates of the first receipt", a, a)
+	}
+}
+
+// テスト観点
+// ・ロットの指定や日付の誤りがエラーとなること
+func TestRecordFailLot(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r, i, l := newMemory(t)
+	untracked := saveItem(t, r, false)
+
+	// Given
+	received := &app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 5, Lot: "L1",
+		ExpiresOn: newDay(t, "2025-06-30")}
+	if _, err := app.Record(context.Background(), received, u, uuid.New); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		reqDto *app.RecordRequestDto
+		err    error
+	}{
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1}, movement.ErrLotRequired},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Lot: "\t"}, lot.ErrInvalidNumber},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "issue", Quantity: 1, Lot: "L2"}, app.ErrLotNotAvailable},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "issue", Quantity: 6, Lot: "L1"}, movement.ErrInsufficientQuantity},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Lot: "L1",
+			ExpiresOn: newDay(t, "2025-07-01")}, lot.ErrDatesMismatch},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Lot: "L3",
+			ManufacturedOn: newDay(t, "2025-07-01"), ExpiresOn: newDay(t, "2025-06-30")}, lot.ErrInvalidDates},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "issue", Quantity: 1, Lot: "L1",
+			ExpiresOn: newDay(t, "2025-06-30")}, app.ErrInvalidMovement},
+		{&app.RecordRequestDto{ItemId: untracked.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Lot: "L1"}, movement.ErrNotLotTracked},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := app.Record(context.Background(), tt.reqDto, u, uuid.New)
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, err, err, tt.err)
+		}
+	}
+}
+
+// テスト観点
+// ・シリアル番号が初回の入庫で登録され、移動に合わせて保管場所が変わること
+// ・出庫したシリアル番号は再び入庫できること
+func TestRecordSerial(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r, _, l := newMemory(t)
+	i := saveSerializedItem(t, r)
+
+	// Given
+	requests := []*app.RecordRequestDto{
+		{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 3, Serials: []string{"SN-1", "SN-2", "SN-3"}},
+		{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "issue", Quantity: 2, Serials: []string{"SN-1", "SN-3"}},
+	}
+	for _, reqDto := range requests {
+		if _, err := app.Record(context.Background(), reqDto, u, uuid.New); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	reqDto := &app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Serials: []string{"SN-1"}}
+
+	// When
+	resDto, err := app.Record(context.Background(), reqDto, u, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.Quantity != 2 {
+		t.Errorf("%T = %v, want %v", resDto.Quantity, resDto.Quantity, 2)
+	}
+
+	for number, want := range map[string]bool{"SN-1": true, "SN-2": true, "SN-3": false} {
+		n, err := serial.NewNumber(number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a, err := r.Serial().Get(context.Background(), i.Id, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got, ok := a.LocationId(); ok != want || (ok && got != l.Id) {
+			t.Errorf("%s: %T = %+v %v, want in stock %v", number, got, got, ok, want)
+		}
+	}
+}
+
+// テスト観点
+// ・シリアル番号の数と数量の不一致、重複、在庫にないシリアル番号がエラーとなり、記録されないこと
+func TestRecordFailSerial(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r, _, l := newMemory(t)
+	i := saveSerializedItem(t, r)
+	plain := saveItem(t, r, false)
+
+	other := newLocation(t, false, false)
+	if err := r.Location().Save(context.Background(), location.NewAggregate(other.Id, other.Name)); err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	received := &app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 2, Serials: []string{"SN-1", "SN-2"}}
+	if _, err := app.Record(context.Background(), received, u, uuid.New); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		reqDto *app.RecordRequestDto
+		err    error
+	}{
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 2, Serials: []string{"SN-3"}}, movement.ErrSerialCount},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 2, Serials: []string{"SN-3", " SN-3"}}, serial.ErrDuplicateNumber},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Serials: []string{"SN\n1"}}, serial.ErrInvalidNumber},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: other.Id.UUID(), Kind: "receipt", Quantity: 1, Serials: []string{"SN-1"}}, serial.ErrInStock},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: other.Id.UUID(), Kind: "issue", Quantity: 1, Serials: []string{"SN-2"}}, serial.ErrNotInLocation},
+		{&app.RecordRequestDto{ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: "issue", Quantity: 1, Serials: []string{"SN-9"}}, app.ErrSerialNotAvailable},
+		{&app.RecordRequestDto{ItemId: plain.Id.UUID(), LocationId: l.Id.UUID(), Kind: "receipt", Quantity: 1, Serials: []string{"SN-1"}}, movement.ErrNotSerialized},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := app.Record(context.Background(), tt.reqDto, u, uuid.New)
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, err, err, tt.err)
+		}
+	}
+
+	b, err := r.Movement().GetBalance(context.Background(), i.Id, l.Id, lot.Number{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if b.Quantity != 2 {
+		t.Errorf("%T = %v, want %v", b.Quantity, b.Quantity, 2)
+	}
+}
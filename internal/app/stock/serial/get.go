@@ -0,0 +1,104 @@
+package serial
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+type GetRequestDto struct {
+	Number string
+	// ItemId limits the serials to the ones of the item, nil for the serials of every item with the number.
+	ItemId *uuid.UUID
+}
+
+type GetResponseDto struct {
+	Items []*SerialDto
+}
+
+type SerialDto struct {
+	ItemId uuid.UUID
+	Number string
+	// LocationId is where the unit is, nil while it is not in stock.
+	LocationId *uuid.UUID
+	// Movements are the movements of the unit in the order they were recorded.
+	Movements []*MovementDto
+}
+
+type MovementDto struct {
+	Id         uuid.UUID
+	Kind       string
+	LocationId uuid.UUID
+	Quantity   int64
+}
+
+// Get returns the serials with a number, one per item, together with their movement history.
+// Serial numbers are only unique per item, so more than one item may have the number.
+// It fails with serial.ErrNotFound when no item has a serial with the number.
+func Get(ctx context.Context, req *GetRequestDto, u transaction.IUnitOfWork) (*GetResponseDto, error) {
+	// Precondition
+	number, err := serial.NewNumber(req.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	q := serial.ListQuery{Number: &number}
+	if req.ItemId != nil {
+		itemId, err := item.NewId(*req.ItemId)
+		if err != nil {
+			return nil, serial.ErrNotFound
+		}
+		q.ItemId = &itemId
+	}
+
+	// Main
+	res := &GetResponseDto{
+		Items: []*SerialDto{},
+	}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		as, err := r.Serial().List(ctx, q)
+		if err != nil {
+			return err
+		}
+
+		for _, a := range as {
+			ms, err := r.Movement().ListBySerial(ctx, a.ItemId, a.Number)
+			if err != nil {
+				return err
+			}
+
+			dto := &SerialDto{
+				ItemId:    a.ItemId.UUID(),
+				Number:    a.Number.String(),
+				Movements: make([]*MovementDto, 0, len(ms)),
+			}
+			if l, ok := a.LocationId(); ok {
+				v := l.UUID()
+				dto.LocationId = &v
+			}
+			for _, m := range ms {
+				dto.Movements = append(dto.Movements, &MovementDto{
+					Id:         m.Id.UUID(),
+					Kind:       m.Kind.String(),
+					LocationId: m.LocationId.UUID(),
+					Quantity:   m.Quantity.Int64(),
+				})
+			}
+			res.Items = append(res.Items, dto)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res.Items) == 0 {
+		return nil, serial.ErrNotFound
+	}
+
+	return res, nil
+}
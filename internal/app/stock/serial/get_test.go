@@ -0,0 +1,217 @@
+package serial_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	appMovement "openapi/internal/app/stock/movement"
+	app "openapi/internal/app/stock/serial"
+	appTransfer "openapi/internal/app/stock/transfer"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/repository/memory"
+	transactionInfra "openapi/internal/infra/repository/memory/stock/transaction"
+
+	"github.com/google/uuid"
+)
+
+func newMemory(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories) {
+	db := memory.Open()
+
+	u, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := transactionInfra.NewRepositories(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return u, r
+}
+
+func saveItem(t *testing.T, r transaction.IRepositories) *item.Aggregate {
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	sku, err := item.NewSku("SKU-" + id.UUID().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := item.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, sku, name, item.Each)
+	a.Serialized = true
+	if err := r.Item().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func saveLocation(t *testing.T, r transaction.IRepositories) *location.Aggregate {
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	name, err := location.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.NewAggregate(id, name)
+	if err := r.Location().Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func record(t *testing.T, u transaction.IUnitOfWork, i *item.Aggregate, l *location.Aggregate, kind string, serials ...string) uuid.UUID {
+	res, err := appMovement.Record(context.Background(), &appMovement.RecordRequestDto{
+		ItemId: i.Id.UUID(), LocationId: l.Id.UUID(), Kind: kind, Quantity: int64(len(serials)), Serials: serials,
+	}, u, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.Id
+}
+
+// テスト観点
+// ・シリアル番号の現在の保管場所と、記録順の移動履歴が返ること
+// ・同じシリアル番号を持つ品目が複数ある場合は品目ごとに返り、品目IDで絞り込めること
+func TestGet(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	i := saveItem(t, r)
+	other := saveItem(t, r)
+	from := saveLocation(t, r)
+	to := saveLocation(t, r)
+
+	receiptId := record(t, u, i, from, "receipt", "SN-1", "SN-2")
+	record(t, u, other, from, "receipt", "SN-1")
+	record(t, u, i, from, "issue", "SN-2")
+
+	ids := []uuid.UUID{}
+	newId := func() uuid.UUID {
+		id := uuid.New()
+		ids = append(ids, id)
+		return id
+	}
+	if _, err := appTransfer.Create(context.Background(), &appTransfer.CreateRequestDto{
+		ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 1, Serials: []string{"SN-1"},
+	}, u, newId); err != nil {
+		t.Fatal(err)
+	}
+
+	itemId := i.Id.UUID()
+
+	// When
+	resDto, err := app.Get(context.Background(), &app.GetRequestDto{Number: " SN-1 ", ItemId: &itemId}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := app.Get(context.Background(), &app.GetRequestDto{Number: "SN-1"}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	toId := to.Id.UUID()
+	want := &app.GetResponseDto{Items: []*app.SerialDto{{
+		ItemId:     i.Id.UUID(),
+		Number:     "SN-1",
+		LocationId: &toId,
+		Movements: []*app.MovementDto{
+			{Id: receiptId, Kind: "receipt", LocationId: from.Id.UUID(), Quantity: 2},
+			{Id: ids[1], Kind: "transfer_out", LocationId: from.Id.UUID(), Quantity: -1},
+			{Id: ids[2], Kind: "transfer_in", LocationId: to.Id.UUID(), Quantity: 1},
+		},
+	}}}
+	if !reflect.DeepEqual(resDto, want) {
+		t.Errorf("%T = %+v, want %+v", resDto, resDto, want)
+	}
+
+	if len(all.Items) != 2 {
+		t.Fatalf("%T = %+v, want the serials of both items", all.Items, all.Items)
+	}
+
+	for _, s := range all.Items {
+		if s.ItemId != i.Id.UUID() && s.ItemId != other.Id.UUID() {
+			t.Errorf("%T = %+v, want a serial of %v or %v", s, s, i.Id, other.Id)
+		}
+	}
+}
+
+// テスト観点
+// ・出庫済みのシリアル番号は保管場所がないこと
+func TestGetIssued(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	i := saveItem(t, r)
+	l := saveLocation(t, r)
+	record(t, u, i, l, "receipt", "SN-1")
+	record(t, u, i, l, "issue", "SN-1")
+
+	// When
+	resDto, err := app.Get(context.Background(), &app.GetRequestDto{Number: "SN-1"}, u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(resDto.Items) != 1 || resDto.Items[0].LocationId != nil || len(resDto.Items[0].Movements) != 2 {
+		t.Errorf("%T = %+v, want one issued serial with two movements", resDto.Items, resDto.Items)
+	}
+}
+
+// テスト観点
+// ・存在しないシリアル番号や他の品目のシリアル番号はErrNotFoundとなること
+// ・不正なシリアル番号はErrInvalidNumberとなること
+func TestGetFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	u, r := newMemory(t)
+
+	// Given
+	i := saveItem(t, r)
+	l := saveLocation(t, r)
+	record(t, u, i, l, "receipt", "SN-1")
+	otherItemId := uuid.New()
+
+	tests := []struct {
+		reqDto *app.GetRequestDto
+		err    error
+	}{
+		{&app.GetRequestDto{Number: "SN-2"}, serial.ErrNotFound},
+		{&app.GetRequestDto{Number: "SN-1", ItemId: &otherItemId}, serial.ErrNotFound},
+		{&app.GetRequestDto{Number: " "}, serial.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := app.Get(context.Background(), tt.reqDto, u)
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T = %v, want %v", tt.reqDto, err, err, tt.err)
+		}
+	}
+}
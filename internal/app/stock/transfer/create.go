@@ -0,0 +1,232 @@
+package transfer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/domain/stock/transfer"
+)
+
+var (
+	ErrInvalidTransfer      = failure.Validation("invalid_stock_transfer", "invalid stock transfer")
+	ErrItemNotAvailable     = failure.Validation("stock_item_not_available", "stock item not found or deleted")
+	ErrLocationNotAvailable = failure.Validation("stock_location_not_available", "stock location not found or deleted")
+	ErrLotNotAvailable      = failure.Validation("stock_lot_not_available", "stock lot not found for the stock item")
+	ErrSerialNotAvailable   = failure.Validation("stock_serial_not_available", "stock serial not found for the stock item")
+)
+
+type CreateRequestDto struct {
+	ItemId         uuid.UUID
+	FromLocationId uuid.UUID
+	ToLocationId   uuid.UUID
+	Quantity       int64
+	// Lot is the lot of a lot tracked item, empty for the other items.
+	Lot string
+	// Serials are the units of a serialized item, one per unit of the quantity, and empty for the other items.
+	Serials []string
+}
+
+type CreateResponseDto struct {
+	Id           uuid.UUID
+	FromQuantity int64
+	ToQuantity   int64
+}
+
+// Create moves a quantity of an item between two locations. Both sides of the transfer are recorded
+// in one transaction together with the balance check, so the source never gives away more than it holds
+// and the destination never takes in more than its capacity. A lot tracked item moves out of one of its lots,
+// and a serialized item moves the serials that are in the source.
+func Create(ctx context.Context, req *CreateRequestDto, u transaction.IUnitOfWork, newId func() uuid.UUID) (*CreateResponseDto, error) {
+	// Precondition
+	itemId, err := item.NewId(req.ItemId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransfer.With("item_id", req.ItemId), err)
+	}
+
+	from, err := location.NewId(req.FromLocationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransfer.With("from_location_id", req.FromLocationId), err)
+	}
+
+	to, err := location.NewId(req.ToLocationId)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransfer.With("to_location_id", req.ToLocationId), err)
+	}
+
+	id, err := transfer.NewId(newId())
+	if err != nil {
+		return nil, err
+	}
+
+	outId, err := movement.NewId(newId())
+	if err != nil {
+		return nil, err
+	}
+
+	inId, err := movement.NewId(newId())
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := transfer.NewAggregate(id, itemId, from, to, req.Quantity, outId, inId)
+	if errors.Is(err, transfer.ErrSameLocation) {
+		return nil, ErrInvalidTransfer.With("reason", err.Error())
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTransfer.With("quantity", req.Quantity), err)
+	}
+
+	if req.Lot != "" {
+		number, err := lot.NewNumber(req.Lot)
+		if err != nil {
+			return nil, err
+		}
+		a.SetLot(number)
+	}
+
+	serials, err := serial.NewNumbers(req.Serials)
+	if err != nil {
+		return nil, err
+	}
+	a.SetSerials(serials)
+
+	// Main
+	res := &CreateResponseDto{Id: a.Id.UUID()}
+	err = u.Do(ctx, func(r transaction.IRepositories) error {
+		i, err := r.Item().Get(ctx, itemId)
+		if errors.Is(err, item.ErrNotFound) {
+			return ErrItemNotAvailable
+		}
+		if err != nil {
+			return err
+		}
+
+		if i.IsDeleted() {
+			return ErrItemNotAvailable
+		}
+
+		if err := movement.CheckLot(i, a.Out); err != nil {
+			return err
+		}
+
+		if err := movement.CheckSerials(i, a.Out); err != nil {
+			return err
+		}
+
+		if !a.Lot().IsZero() {
+			_, err := r.Lot().Get(ctx, itemId, a.Lot())
+			if errors.Is(err, lot.ErrNotFound) {
+				return ErrLotNotAvailable
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		fromLocation, err := getLocation(ctx, from, r.Location())
+		if err != nil {
+			return err
+		}
+
+		toLocation, err := getLocation(ctx, to, r.Location())
+		if err != nil {
+			return err
+		}
+
+		fromBalance, err := r.Movement().GetBalance(ctx, itemId, from, a.Lot())
+		if err != nil {
+			return err
+		}
+
+		fromBalance, err = fromBalance.Apply(a.Out, fromLocation)
+		if err != nil {
+			return err
+		}
+
+		toBalance, err := r.Movement().GetBalance(ctx, itemId, to, a.Lot())
+		if err != nil {
+			return err
+		}
+
+		toBalance, err = toBalance.Apply(a.In, toLocation)
+		if err != nil {
+			return err
+		}
+
+		if err := movement.CheckCapacity(ctx, r.Movement(), r.Item(), toLocation, a.In); err != nil {
+			return err
+		}
+
+		if len(a.Serials()) > 0 {
+			if err := moveSerials(ctx, r.Serial(), a); err != nil {
+				return err
+			}
+		}
+
+		if err := r.Transfer().Save(ctx, a); err != nil {
+			return err
+		}
+
+		res.FromQuantity = fromBalance.Quantity
+		res.ToQuantity = toBalance.Quantity
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// moveSerials takes the serials of a out of its source and brings them into its destination.
+func moveSerials(ctx context.Context, r serial.IRepository, a *transfer.Aggregate) error {
+	for _, n := range a.Serials() {
+		s, err := r.Get(ctx, a.ItemId, n)
+		if errors.Is(err, serial.ErrNotFound) {
+			return ErrSerialNotAvailable.With("serial", n.String())
+		}
+		if err != nil {
+			return err
+		}
+
+		if err := movement.ApplySerial(s, a.Out); err != nil {
+			return err
+		}
+
+		if err := movement.ApplySerial(s, a.In); err != nil {
+			return err
+		}
+
+		if err := r.Save(ctx, s); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func getLocation(ctx context.Context, id location.Id, r location.IRepository) (*location.Aggregate, error) {
+	a, err := r.Get(ctx, id)
+	if errors.Is(err, location.ErrNotFound) {
+		return nil, ErrLocationNotAvailable
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if a.IsDeleted() {
+		return nil, ErrLocationNotAvailable
+	}
+
+	return a, nil
+}
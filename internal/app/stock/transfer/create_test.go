@@ -0,0 +1,502 @@
+package transfer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	app "openapi/internal/app/stock/transfer"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/domain/stock/transfer"
+	mock_item "openapi/internal/infra/mock/domain/stock/item"
+	mock_location "openapi/internal/infra/mock/domain/stock/location"
+	mock_lot "openapi/internal/infra/mock/domain/stock/lot"
+	mock_movement "openapi/internal/infra/mock/domain/stock/movement"
+	mock_serial "openapi/internal/infra/mock/domain/stock/serial"
+	mock_transaction "openapi/internal/infra/mock/domain/stock/transaction"
+	mock_transfer "openapi/internal/infra/mock/domain/stock/transfer"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+type repositories struct {
+	item       *mock_item.MockIRepository
+	location   *mock_location.MockIRepository
+	lot        *mock_lot.MockIRepository
+	movement   *mock_movement.MockIRepository
+	serial     *mock_serial.MockIRepository
+	transfer   *mock_transfer.MockIRepository
+	unitOfWork *mock_transaction.MockIUnitOfWork
+}
+
+func setup(t *testing.T) *repositories {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	r := &repositories{
+		item:       mock_item.NewMockIRepository(ctrl),
+		location:   mock_location.NewMockIRepository(ctrl),
+		lot:        mock_lot.NewMockIRepository(ctrl),
+		movement:   mock_movement.NewMockIRepository(ctrl),
+		serial:     mock_serial.NewMockIRepository(ctrl),
+		transfer:   mock_transfer.NewMockIRepository(ctrl),
+		unitOfWork: mock_transaction.NewMockIUnitOfWork(ctrl),
+	}
+
+	tx := mock_transaction.NewMockIRepositories(ctrl)
+	tx.EXPECT().Item().Return(r.item).AnyTimes()
+	tx.EXPECT().Location().Return(r.location).AnyTimes()
+	tx.EXPECT().Lot().Return(r.lot).AnyTimes()
+	tx.EXPECT().Movement().Return(r.movement).AnyTimes()
+	tx.EXPECT().Serial().Return(r.serial).AnyTimes()
+	tx.EXPECT().Transfer().Return(r.transfer).AnyTimes()
+
+	r.unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(r transaction.IRepositories) error) error {
+			return fn(tx)
+		},
+	).AnyTimes()
+
+	return r
+}
+
+func newItem(t *testing.T, r *repositories) *item.Aggregate {
+	t.Helper()
+
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, item.Sku{}, name, item.Each)
+	r.item.EXPECT().Get(gomock.Any(), id).Return(a, nil).AnyTimes()
+
+	return a
+}
+
+func newLocation(t *testing.T, r *repositories, deleted bool) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("TestName" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.RestoreAggregate(id, name, location.Warehouse, location.Capacity{}, nil, deleted, false, 1)
+	r.location.EXPECT().Get(gomock.Any(), id).Return(a, nil).AnyTimes()
+
+	return a
+}
+
+// テスト観点
+// ・エラーが発生しないこと
+// ・移動元と移動先の移動後の在庫数が返ること
+// ・移動がトランザクション内で保存されること
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	from := newLocation(t, r, false)
+	to := newLocation(t, r, false)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, from.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: from.Id, Quantity: 5}, nil)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, to.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: to.Id, Quantity: 1}, nil)
+
+	var saved *transfer.Aggregate
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *transfer.Aggregate) error {
+		saved = a
+		return nil
+	})
+
+	reqDto := &app.CreateRequestDto{
+		ItemId:         i.Id.UUID(),
+		FromLocationId: from.Id.UUID(),
+		ToLocationId:   to.Id.UUID(),
+		Quantity:       5,
+	}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if resDto.FromQuantity != 0 {
+		t.Errorf("%T = %v, want %v", resDto.FromQuantity, resDto.FromQuantity, 0)
+	}
+
+	if resDto.ToQuantity != 6 {
+		t.Errorf("%T = %v, want %v", resDto.ToQuantity, resDto.ToQuantity, 6)
+	}
+
+	if saved == nil || saved.Id.UUID() != resDto.Id {
+		t.Fatalf("%T = %v, want id %v", saved, saved, resDto.Id)
+	}
+}
+
+// テスト観点
+// ・ロット管理品目はロットの在庫数で移動され、両側の移動にロットが付くこと
+// ・ロットの指定がない、または登録されていないロットの場合はエラーとなること
+func TestCreateLot(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	i.LotTracked = true
+	from := newLocation(t, r, false)
+	to := newLocation(t, r, false)
+
+	number, err := lot.NewNumber("L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	unknown, err := lot.NewNumber("L2")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.lot.EXPECT().Get(gomock.Any(), i.Id, number).Return(&lot.Aggregate{ItemId: i.Id, Number: number}, nil)
+	r.lot.EXPECT().Get(gomock.Any(), i.Id, unknown).Return(nil, lot.ErrNotFound)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, from.Id, number).Return(movement.Balance{ItemId: i.Id, LocationId: from.Id, Lot: number, Quantity: 5}, nil)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, to.Id, number).Return(movement.Balance{ItemId: i.Id, LocationId: to.Id, Lot: number}, nil)
+
+	var saved *transfer.Aggregate
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *transfer.Aggregate) error {
+		saved = a
+		return nil
+	})
+
+	reqDto := &app.CreateRequestDto{ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 2, Lot: "L1"}
+
+	// When
+	resDto, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, unknownErr := app.Create(context.Background(), &app.CreateRequestDto{
+		ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 2, Lot: "L2",
+	}, r.unitOfWork, uuid.New)
+
+	_, requiredErr := app.Create(context.Background(), &app.CreateRequestDto{
+		ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 2,
+	}, r.unitOfWork, uuid.New)
+
+	// Then
+	if resDto.FromQuantity != 3 || resDto.ToQuantity != 2 {
+		t.Errorf("%T = %+v, want %v and %v", resDto, resDto, 3, 2)
+	}
+
+	if saved == nil || saved.Out.Lot != number || saved.In.Lot != number {
+		t.Errorf("%T = %+v, want lot %v", saved, saved, number)
+	}
+
+	if !errors.Is(unknownErr, app.ErrLotNotAvailable) {
+		t.Errorf("%T = %v, want %v", unknownErr, unknownErr, app.ErrLotNotAvailable)
+	}
+
+	if !errors.Is(requiredErr, movement.ErrLotRequired) {
+		t.Errorf("%T = %v, want %v", requiredErr, requiredErr, movement.ErrLotRequired)
+	}
+}
+
+// テスト観点
+// ・シリアル管理の品目は指定したシリアル番号が移動先に移ること
+// ・移動元にないシリアル番号や未登録のシリアル番号はエラーとなること
+func TestCreateSerial(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	i.Serialized = true
+	from := newLocation(t, r, false)
+	to := newLocation(t, r, false)
+
+	numbers, err := serial.NewNumbers([]string{"S1", "S2", "S3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serials := map[string]*serial.Aggregate{}
+	for _, n := range numbers {
+		id, err := serial.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+		serials[n.String()] = serial.RestoreAggregate(id, i.Id, n, &from.Id)
+		r.serial.EXPECT().Get(gomock.Any(), i.Id, n).Return(serials[n.String()], nil).AnyTimes()
+	}
+	if err := serials["S3"].Issue(from.Id); err != nil {
+		t.Fatal(err)
+	}
+
+	unknown, err := serial.NewNumber("S9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.serial.EXPECT().Get(gomock.Any(), i.Id, unknown).Return(nil, serial.ErrNotFound)
+	r.serial.EXPECT().Save(gomock.Any(), gomock.Any()).Return(nil).Times(2)
+
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, from.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: from.Id, Quantity: 5}, nil).AnyTimes()
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, to.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: to.Id}, nil).AnyTimes()
+
+	var saved *transfer.Aggregate
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, a *transfer.Aggregate) error {
+		saved = a
+		return nil
+	})
+
+	reqDto := &app.CreateRequestDto{ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 2, Serials: []string{"S1", "S2"}}
+
+	// When
+	_, err = app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, issuedErr := app.Create(context.Background(), &app.CreateRequestDto{
+		ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 1, Serials: []string{"S3"},
+	}, r.unitOfWork, uuid.New)
+
+	_, unknownErr := app.Create(context.Background(), &app.CreateRequestDto{
+		ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 1, Serials: []string{"S9"},
+	}, r.unitOfWork, uuid.New)
+
+	_, countErr := app.Create(context.Background(), &app.CreateRequestDto{
+		ItemId: i.Id.UUID(), FromLocationId: from.Id.UUID(), ToLocationId: to.Id.UUID(), Quantity: 2, Serials: []string{"S1"},
+	}, r.unitOfWork, uuid.New)
+
+	// Then
+	if saved == nil || len(saved.Serials()) != 2 || len(saved.Out.Serials) != 2 {
+		t.Errorf("%T = %+v, want serials %v", saved, saved, reqDto.Serials)
+	}
+
+	for _, number := range []string{"S1", "S2"} {
+		if got, ok := serials[number].LocationId(); !ok || got != to.Id {
+			t.Errorf("%s: %T = %+v, want %v", number, got, got, to.Id)
+		}
+	}
+
+	if !errors.Is(issuedErr, serial.ErrNotInLocation) {
+		t.Errorf("%T = %v, want %v", issuedErr, issuedErr, serial.ErrNotInLocation)
+	}
+
+	if !errors.Is(unknownErr, app.ErrSerialNotAvailable) {
+		t.Errorf("%T = %v, want %v", unknownErr, unknownErr, app.ErrSerialNotAvailable)
+	}
+
+	if !errors.Is(countErr, movement.ErrSerialCount) {
+		t.Errorf("%T = %v, want %v", countErr, countErr, movement.ErrSerialCount)
+	}
+}
+
+// テスト観点
+// ・移動元の在庫数が不足している場合はエラーとなり、保存されないこと
+func TestCreateFailInsufficientQuantity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	from := newLocation(t, r, false)
+	to := newLocation(t, r, false)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, from.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: from.Id, Quantity: 4}, nil)
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	reqDto := &app.CreateRequestDto{
+		ItemId:         i.Id.UUID(),
+		FromLocationId: from.Id.UUID(),
+		ToLocationId:   to.Id.UUID(),
+		Quantity:       5,
+	}
+
+	// When
+	_, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, movement.ErrInsufficientQuantity) {
+		t.Fatalf("%T = %v, want %v", err, err, movement.ErrInsufficientQuantity)
+	}
+}
+
+// テスト観点
+// ・移動先の容量を超える移動はエラーとなり、保存されないこと
+func TestCreateFailOverCapacity(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	from := newLocation(t, r, false)
+	to := newLocation(t, r, false)
+
+	units := int64(5)
+	capacity, err := location.NewCapacity(&units, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	to.Capacity = capacity
+
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, from.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: from.Id, Quantity: 5}, nil)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, to.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: to.Id, Quantity: 1}, nil)
+	r.movement.EXPECT().ListBalances(gomock.Any(), movement.BalanceQuery{LocationId: &to.Id}).Return([]movement.Balance{
+		{ItemId: i.Id, LocationId: to.Id, Quantity: 1},
+	}, nil)
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	reqDto := &app.CreateRequestDto{
+		ItemId:         i.Id.UUID(),
+		FromLocationId: from.Id.UUID(),
+		ToLocationId:   to.Id.UUID(),
+		Quantity:       5,
+	}
+
+	// When
+	_, err = app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, location.ErrOverCapacity) {
+		t.Fatalf("%T = %v, want %v", err, err, location.ErrOverCapacity)
+	}
+}
+
+// テスト観点
+// ・移動元または移動先が削除済みの場合はエラーとなり、保存されないこと
+func TestCreateFailDeletedLocation(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	active := newLocation(t, r, false)
+	deleted := newLocation(t, r, true)
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	tests := []*app.CreateRequestDto{
+		{ItemId: i.Id.UUID(), FromLocationId: deleted.Id.UUID(), ToLocationId: active.Id.UUID(), Quantity: 1},
+		{ItemId: i.Id.UUID(), FromLocationId: active.Id.UUID(), ToLocationId: deleted.Id.UUID(), Quantity: 1},
+	}
+
+	for _, reqDto := range tests {
+		// When
+		_, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+		// Then
+		if !errors.Is(err, app.ErrLocationNotAvailable) {
+			t.Errorf("%T = %v, want %v", err, err, app.ErrLocationNotAvailable)
+		}
+	}
+}
+
+func TestCreateFailInvalidTransfer(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	from := uuid.New()
+	tests := []*app.CreateRequestDto{
+		{ItemId: uuid.New(), FromLocationId: from, ToLocationId: from, Quantity: 1},
+		{ItemId: uuid.New(), FromLocationId: from, ToLocationId: uuid.New(), Quantity: 0},
+		{ItemId: uuid.Nil, FromLocationId: from, ToLocationId: uuid.New(), Quantity: 1},
+	}
+
+	for _, reqDto := range tests {
+		// When
+		_, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+		// Then
+		if !errors.Is(err, app.ErrInvalidTransfer) {
+			t.Errorf("%+v %T = %v, want %v", reqDto, err, err, app.ErrInvalidTransfer)
+		}
+	}
+}
+
+func TestCreateFailItemNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+	r.item.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, item.ErrNotFound)
+
+	// Given
+	reqDto := &app.CreateRequestDto{
+		ItemId:         uuid.New(),
+		FromLocationId: uuid.New(),
+		ToLocationId:   uuid.New(),
+		Quantity:       1,
+	}
+
+	// When
+	_, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if !errors.Is(err, app.ErrItemNotAvailable) {
+		t.Fatalf("%T = %v, want %v", err, err, app.ErrItemNotAvailable)
+	}
+}
+
+func TestCreateFailSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	r := setup(t)
+
+	// Given
+	i := newItem(t, r)
+	from := newLocation(t, r, false)
+	to := newLocation(t, r, false)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, from.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: from.Id, Quantity: 5}, nil)
+	r.movement.EXPECT().GetBalance(gomock.Any(), i.Id, to.Id, lot.Number{}).Return(movement.Balance{ItemId: i.Id, LocationId: to.Id}, nil)
+	r.transfer.EXPECT().Save(gomock.Any(), gomock.Any()).Return(fmt.Errorf("fail save"))
+
+	reqDto := &app.CreateRequestDto{
+		ItemId:         i.Id.UUID(),
+		FromLocationId: from.Id.UUID(),
+		ToLocationId:   to.Id.UUID(),
+		Quantity:       1,
+	}
+
+	// When
+	_, err := app.Create(context.Background(), reqDto, r.unitOfWork, uuid.New)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
@@ -0,0 +1,118 @@
+package failure
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kind classifies an error by what the caller can do about it.
+type Kind int
+
+const (
+	// KindValidation means the input breaks a rule and has to be corrected before retrying.
+	KindValidation Kind = iota + 1
+	// KindNotFound means the addressed resource does not exist.
+	KindNotFound
+	// KindConflict means the request clashes with the current state of a resource.
+	KindConflict
+	// KindPreconditionFailed means the resource is no longer in the state the caller expected.
+	KindPreconditionFailed
+)
+
+// Field names an input that broke a rule.
+type Field struct {
+	Name   string
+	Reason string
+}
+
+// Extension is a piece of context of one occurrence of an error, such as the value that broke the rule.
+type Extension struct {
+	Name  string
+	Value any
+}
+
+// Error is an error the caller can act on, as opposed to a failure of the infrastructure.
+// Code identifies the problem and stays the same between releases, unlike Message.
+type Error struct {
+	Kind       Kind
+	Code       string
+	Message    string
+	Fields     []Field
+	Extensions []Extension
+	declared   *Error
+}
+
+func (e *Error) Error() string {
+	if len(e.Extensions) == 0 {
+		return e.Message
+	}
+
+	s := e.Message
+	for _, x := range e.Extensions {
+		s += fmt.Sprintf(" %s=%+v", x.Name, x.Value)
+	}
+	return s
+}
+
+// With returns a copy of e that carries value as the extension name.
+// The copy still matches e with errors.Is, so the declared errors are compared as before.
+func (e *Error) With(name string, value any) *Error {
+	c := *e
+	c.Extensions = append(append([]Extension(nil), e.Extensions...), Extension{Name: name, Value: value})
+	if e.declared != nil {
+		c.declared = e.declared
+	} else {
+		c.declared = e
+	}
+	return &c
+}
+
+func (e *Error) Unwrap() error {
+	if e.declared == nil {
+		return nil
+	}
+	return e.declared
+}
+
+func Validation(code, message string, fields ...Field) *Error {
+	return &Error{Kind: KindValidation, Code: code, Message: message, Fields: fields}
+}
+
+func NotFound(code, message string) *Error {
+	return &Error{Kind: KindNotFound, Code: code, Message: message}
+}
+
+func Conflict(code, message string) *Error {
+	return &Error{Kind: KindConflict, Code: code, Message: message}
+}
+
+func PreconditionFailed(code, message string) *Error {
+	return &Error{Kind: KindPreconditionFailed, Code: code, Message: message}
+}
+
+// Internal marks err as a fault of the server rather than of the request, such as a stored row that no longer
+// satisfies the rules of the domain, so that a failure in its chain is not reported as the caller's to act on.
+func Internal(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &internalError{err}
+}
+
+// IsInternal reports whether err has been marked by Internal.
+func IsInternal(err error) bool {
+	var i *internalError
+	return errors.As(err, &i)
+}
+
+type internalError struct {
+	err error
+}
+
+func (e *internalError) Error() string {
+	return e.err.Error()
+}
+
+func (e *internalError) Unwrap() error {
+	return e.err
+}
@@ -0,0 +1,59 @@
+package failure_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"openapi/internal/domain/failure"
+)
+
+func TestWith(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	declared := failure.Conflict("stock_serial_in_stock", "stock serial is already in a stock location")
+
+	// When
+	err := declared.With("serial", "S1").With("quantity", 2)
+
+	// Then
+	if !errors.Is(err, declared) {
+		t.Errorf("%T %+v want to match %+v", err, err, declared)
+	}
+
+	if len(declared.Extensions) != 0 {
+		t.Errorf("%T %+v want the declared error untouched", declared.Extensions, declared.Extensions)
+	}
+
+	if len(err.Extensions) != 2 || err.Extensions[0].Name != "serial" || err.Extensions[1].Value != 2 {
+		t.Errorf("%T %+v want serial and quantity", err.Extensions, err.Extensions)
+	}
+
+	if err.Error() != "stock serial is already in a stock location serial=S1 quantity=2" {
+		t.Errorf("%T %+v want the extensions after the message", err.Error(), err.Error())
+	}
+}
+
+func TestInternal(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	declared := failure.Validation("invalid_stock_location_name", "invalid stock location name")
+
+	// When
+	err := failure.Internal(fmt.Errorf("NewName: %w", declared))
+
+	// Then
+	if !failure.IsInternal(err) || failure.IsInternal(declared) {
+		t.Errorf("%T %+v want only the marked error internal", err, err)
+	}
+
+	if !errors.Is(err, declared) {
+		t.Errorf("%T %+v want to match %+v", err, err, declared)
+	}
+
+	if failure.Internal(nil) != nil {
+		t.Error("want nil for nil")
+	}
+}
@@ -0,0 +1,168 @@
+package item
+
+import (
+	"fmt"
+	"math"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/measure"
+)
+
+var (
+	ErrVersionMismatch  = failure.PreconditionFailed("stock_item_version_mismatch", "stock item has been modified by someone else")
+	ErrDuplicateBarcode = failure.Validation("duplicate_stock_item_barcode", "stock item has the barcode more than once")
+	ErrDuplicateUnit    = failure.Validation("duplicate_stock_item_unit", "stock item has the unit more than once")
+	ErrUnknownUnit      = failure.Validation("unknown_stock_item_unit", "stock item has no such unit")
+	ErrHasStock         = failure.Conflict("stock_item_has_stock", "stock item still has stock on hand")
+)
+
+type Aggregate struct {
+	Id   Id
+	Sku  Sku
+	Name Name
+	// baseUnit is what the quantities of the item are counted in. It never changes, so that
+	// the quantities already recorded keep their meaning.
+	baseUnit  Unit
+	barcodes  []Barcode
+	packSizes []PackSize
+	// UnitVolume and UnitWeight are the measures of one unit, which count towards the capacity
+	// of the locations the item is in. They are zero when the item does not declare them.
+	UnitVolume measure.Volume
+	UnitWeight measure.Weight
+	// LotTracked items have their stock counted per lot, and every movement of them names its lot.
+	LotTracked bool
+	// Serialized items have every unit registered by its serial number, and every movement of them
+	// names the serials it moves.
+	Serialized bool
+	deleted    bool
+	// version counts the saves of the aggregate, 0 until it is saved for the first time.
+	version int64
+}
+
+func NewAggregate(id Id, sku Sku, name Name, baseUnit Unit) *Aggregate {
+	return &Aggregate{
+		Id:       id,
+		Sku:      sku,
+		Name:     name,
+		baseUnit: baseUnit,
+		deleted:  false,
+		version:  0,
+	}
+}
+
+func RestoreAggregate(id Id, sku Sku, name Name, baseUnit Unit, barcodes []Barcode, packSizes []PackSize, unitVolume measure.Volume, unitWeight measure.Weight, lotTracked bool, serialized bool, deleted bool, version int64) *Aggregate {
+	return &Aggregate{
+		Id:         id,
+		Sku:        sku,
+		Name:       name,
+		baseUnit:   baseUnit,
+		barcodes:   nilIfEmpty(barcodes),
+		packSizes:  nilIfEmpty(packSizes),
+		UnitVolume: unitVolume,
+		UnitWeight: unitWeight,
+		LotTracked: lotTracked,
+		Serialized: serialized,
+		deleted:    deleted,
+		version:    version,
+	}
+}
+
+func (a Aggregate) BaseUnit() Unit {
+	return a.baseUnit
+}
+
+// Barcodes returns the barcodes of the item, nil when it has none.
+func (a Aggregate) Barcodes() []Barcode {
+	return nilIfEmpty(append([]Barcode(nil), a.barcodes...))
+}
+
+// SetBarcodes replaces the barcodes of the item, and fails with ErrDuplicateBarcode when bs has one twice.
+func (a *Aggregate) SetBarcodes(bs []Barcode) error {
+	seen := make(map[Barcode]bool, len(bs))
+	for _, b := range bs {
+		if seen[b] {
+			return fmt.Errorf("SetBarcodes: %w", ErrDuplicateBarcode.With("barcode", b.String()))
+		}
+		seen[b] = true
+	}
+
+	a.barcodes = nilIfEmpty(append([]Barcode(nil), bs...))
+	return nil
+}
+
+// PackSizes returns the pack units of the item, nil when it has none.
+func (a Aggregate) PackSizes() []PackSize {
+	return nilIfEmpty(append([]PackSize(nil), a.packSizes...))
+}
+
+// SetPackSizes replaces the pack units of the item, and fails with ErrDuplicateUnit when ps has a unit twice
+// or has the base unit.
+func (a *Aggregate) SetPackSizes(ps []PackSize) error {
+	seen := map[Unit]bool{a.baseUnit: true}
+	for _, p := range ps {
+		if seen[p.Unit()] {
+			return fmt.Errorf("SetPackSizes: %w", ErrDuplicateUnit.With("unit", p.Unit().String()))
+		}
+		seen[p.Unit()] = true
+	}
+
+	a.packSizes = nilIfEmpty(append([]PackSize(nil), ps...))
+	return nil
+}
+
+// ToBase converts a quantity in the base unit or one of the pack units of the item to the base unit.
+// It fails with ErrUnknownUnit when the item has no such unit.
+func (a Aggregate) ToBase(unit Unit, quantity int64) (int64, error) {
+	if unit == a.baseUnit {
+		return quantity, nil
+	}
+
+	for _, p := range a.packSizes {
+		if p.Unit() != unit {
+			continue
+		}
+
+		if quantity > math.MaxInt64/p.Quantity() || quantity < math.MinInt64/p.Quantity() {
+			return 0, fmt.Errorf("ToBase: %+v %+v overflows", quantity, unit)
+		}
+		return quantity * p.Quantity(), nil
+	}
+
+	return 0, fmt.Errorf("ToBase: %w", ErrUnknownUnit.With("unit", unit.String()))
+}
+
+func (a Aggregate) IsDeleted() bool {
+	return a.deleted
+}
+
+func (a *Aggregate) Delete() {
+	a.deleted = true
+}
+
+func (a Aggregate) Version() int64 {
+	return a.version
+}
+
+// CheckVersion fails unless the aggregate is at one of the expected versions.
+// A nil slice expects nothing, while an empty one never matches.
+func (a Aggregate) CheckVersion(expected []int64) error {
+	if expected == nil {
+		return nil
+	}
+
+	for _, v := range expected {
+		if v == a.version {
+			return nil
+		}
+	}
+
+	return ErrVersionMismatch
+}
+
+// nilIfEmpty keeps the aggregates that have no barcodes or pack sizes equal however they are built.
+func nilIfEmpty[T any](s []T) []T {
+	if len(s) == 0 {
+		return nil
+	}
+	return s
+}
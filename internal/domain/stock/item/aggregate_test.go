@@ -0,0 +1,333 @@
+package item_test
+
+import (
+	"errors"
+	"math"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestNewAggregate(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sku, err := item.NewSku("TEST-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	a := item.NewAggregate(id, sku, name, item.Each)
+
+	// Then
+	if a.Id != id {
+		t.Errorf("%T %+v want %+v", a.Id, a.Id, id)
+	}
+
+	if a.Sku != sku {
+		t.Errorf("%T %+v want %+v", a.Sku, a.Sku, sku)
+	}
+
+	if a.BaseUnit() != item.Each {
+		t.Errorf("%T %+v want %+v", a.BaseUnit(), a.BaseUnit(), item.Each)
+	}
+
+	if a.Barcodes() != nil || a.PackSizes() != nil {
+		t.Errorf("%+v %+v want none", a.Barcodes(), a.PackSizes())
+	}
+
+	if a.Name != name {
+		t.Errorf("%T %+v want %+v", a.Name, a.Name, name)
+	}
+
+	if a.IsDeleted() != false {
+		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), false)
+	}
+}
+
+func TestRestoreAggregate(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	barcode, err := item.NewBarcode("4006381333931")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	packSize := newPackSize(t, "case", 12)
+
+	// When
+	a := item.RestoreAggregate(id, newSku(t), name, newUnit(t, "kg"), []item.Barcode{barcode}, []item.PackSize{packSize}, measure.Volume{}, measure.Weight{}, true, true, false, 3)
+
+	// Then
+	if a.Id != id {
+		t.Errorf("%T %+v want %+v", a.Id, a.Id, id)
+	}
+
+	if a.BaseUnit() != newUnit(t, "kg") {
+		t.Errorf("%T %+v want %+v", a.BaseUnit(), a.BaseUnit(), "kg")
+	}
+
+	if bs := a.Barcodes(); len(bs) != 1 || bs[0] != barcode {
+		t.Errorf("%T %+v want %+v", bs, bs, barcode)
+	}
+
+	if ps := a.PackSizes(); len(ps) != 1 || ps[0] != packSize {
+		t.Errorf("%T %+v want %+v", ps, ps, packSize)
+	}
+
+	if a.Name != name {
+		t.Errorf("%T %+v want %+v", a.Name, a.Name, name)
+	}
+
+	if a.IsDeleted() != false {
+		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), false)
+	}
+
+	if a.Version() != 3 {
+		t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 3)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	// When
+	a.Delete()
+
+	// Then
+	if a.IsDeleted() != true {
+		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), true)
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.RestoreAggregate(id, newSku(t), name, item.Each, nil, nil, measure.Volume{}, measure.Weight{}, false, false, false, 3)
+
+	tests := []struct {
+		expected []int64
+		err      error
+	}{
+		{nil, nil},
+		{[]int64{3}, nil},
+		{[]int64{1, 3}, nil},
+		{[]int64{2}, item.ErrVersionMismatch},
+		{[]int64{}, item.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		// When
+		err := a.CheckVersion(tt.expected)
+
+		// Then
+		if err != tt.err {
+			t.Errorf("%+v %T %+v want %+v", tt.expected, err, err, tt.err)
+		}
+	}
+}
+
+func newSku(t *testing.T) item.Sku {
+	t.Helper()
+
+	sku, err := item.NewSku(uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sku
+}
+
+func newUnit(t *testing.T, v string) item.Unit {
+	t.Helper()
+
+	unit, err := item.NewUnit(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return unit
+}
+
+func newPackSize(t *testing.T, unit string, quantity int64) item.PackSize {
+	t.Helper()
+
+	packSize, err := item.NewPackSize(newUnit(t, unit), quantity)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return packSize
+}
+
+func newTestAggregate(t *testing.T) *item.Aggregate {
+	t.Helper()
+
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item.NewAggregate(id, newSku(t), name, item.Each)
+}
+
+func TestSetBarcodes(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a := newTestAggregate(t)
+
+	ean13, err := item.NewBarcode("4006381333931")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ean8, err := item.NewBarcode("96385074")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	err = a.SetBarcodes([]item.Barcode{ean13, ean8})
+	duplicateErr := a.SetBarcodes([]item.Barcode{ean8, ean8})
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !errors.Is(duplicateErr, item.ErrDuplicateBarcode) {
+		t.Errorf("%T %+v want %+v", duplicateErr, duplicateErr, item.ErrDuplicateBarcode)
+	}
+
+	if bs := a.Barcodes(); len(bs) != 2 || bs[0] != ean13 || bs[1] != ean8 {
+		t.Errorf("%T %+v want %+v", bs, bs, []item.Barcode{ean13, ean8})
+	}
+
+	if err := a.SetBarcodes([]item.Barcode{}); err != nil || a.Barcodes() != nil {
+		t.Errorf("%T %+v %v want none", a.Barcodes(), a.Barcodes(), err)
+	}
+}
+
+func TestSetPackSizes(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a := newTestAggregate(t)
+	inner, box := newPackSize(t, "inner", 6), newPackSize(t, "case", 12)
+
+	tests := []struct {
+		packSizes []item.PackSize
+		err       error
+	}{
+		{[]item.PackSize{inner, box}, nil},
+		{[]item.PackSize{box, newPackSize(t, "case", 24)}, item.ErrDuplicateUnit},
+		{[]item.PackSize{newPackSize(t, "each", 2)}, item.ErrDuplicateUnit},
+		{nil, nil},
+	}
+
+	for _, tt := range tests {
+		// When
+		before := a.PackSizes()
+		err := a.SetPackSizes(tt.packSizes)
+
+		// Then
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%+v %T %+v want %+v", tt.packSizes, err, err, tt.err)
+		}
+
+		want := tt.packSizes
+		if err != nil {
+			want = before
+		}
+		if !reflect.DeepEqual(a.PackSizes(), want) {
+			t.Errorf("%T %+v want %+v", a.PackSizes(), a.PackSizes(), want)
+		}
+	}
+}
+
+func TestToBase(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a := newTestAggregate(t)
+	if err := a.SetPackSizes([]item.PackSize{newPackSize(t, "case", 12)}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		unit     item.Unit
+		quantity int64
+		want     int64
+		err      error
+	}{
+		{item.Each, 5, 5, nil},
+		{newUnit(t, "case"), 2, 24, nil},
+		{newUnit(t, "case"), -1, -12, nil},
+		{newUnit(t, "pallet"), 1, 0, item.ErrUnknownUnit},
+	}
+
+	for _, tt := range tests {
+		// When
+		got, err := a.ToBase(tt.unit, tt.quantity)
+
+		// Then
+		if !errors.Is(err, tt.err) || got != tt.want {
+			t.Errorf("%+v %T %+v %v want %+v %v", tt, got, got, err, tt.want, tt.err)
+		}
+	}
+
+	if _, err := a.ToBase(newUnit(t, "case"), math.MaxInt64/2); err == nil {
+		t.Errorf("expected error but returned nil")
+	}
+}
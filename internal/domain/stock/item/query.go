@@ -0,0 +1,12 @@
+package item
+
+// ListQuery selects the active stock items, ordered by SKU.
+type ListQuery struct {
+	// Sku limits the list to the item with the SKU.
+	Sku *Sku
+	// Barcode limits the list to the items with the barcode.
+	Barcode *Barcode
+	// After skips the items up to and including the SKU.
+	After *Sku
+	Limit int
+}
@@ -0,0 +1,23 @@
+package item
+
+import (
+	"context"
+
+	"openapi/internal/domain/failure"
+)
+
+var (
+	ErrNotFound = failure.NotFound("stock_item_not_found", "stock item not found")
+	ErrSkuTaken = failure.Conflict("stock_item_sku_taken", "another stock item has the sku")
+)
+
+type IRepository interface {
+	// Save stores the aggregate unless it has been saved by someone else since it was read,
+	// in which case ErrVersionMismatch is returned. On success the aggregate carries its new version.
+	// ErrSkuTaken is returned when another active stock item has the SKU of an active aggregate.
+	Save(ctx context.Context, a *Aggregate) error
+	// Get returns ErrNotFound when no stock item has the id, deleted or not.
+	Get(ctx context.Context, id Id) (*Aggregate, error)
+	Find(ctx context.Context, id Id) (bool, error)
+	List(ctx context.Context, q ListQuery) ([]*Aggregate, error)
+}
@@ -0,0 +1,148 @@
+package item
+
+import (
+	"fmt"
+	"strings"
+
+	"openapi/internal/domain/failure"
+)
+
+type Name struct {
+	string
+}
+
+var ErrInvalidName = failure.Validation("invalid_stock_item_name", "invalid stock item name")
+
+func NewName(v string) (Name, error) {
+	if v == "" {
+		return Name{}, fmt.Errorf("NewName: %w", ErrInvalidName.With("name", v))
+	}
+	return Name{v}, nil
+}
+
+func (v Name) String() string {
+	return v.string
+}
+
+// SkuMaxLength is the most characters a SKU may have.
+const SkuMaxLength = 64
+
+// Sku is the stock keeping unit that identifies an item in the catalog.
+type Sku struct {
+	string
+}
+
+var ErrInvalidSku = failure.Validation("invalid_stock_item_sku", "invalid stock item sku")
+
+// NewSku trims the surrounding white space of v and upper-cases it, so that SKUs which differ
+// only in case are equal. It fails unless the SKU is made of 1 to SkuMaxLength ASCII letters,
+// digits and the characters "-", "_", "." and "/".
+func NewSku(v string) (Sku, error) {
+	s := strings.ToUpper(strings.TrimSpace(v))
+	if s == "" || len(s) > SkuMaxLength || strings.IndexFunc(s, func(r rune) bool {
+		return !('A' <= r && r <= 'Z' || '0' <= r && r <= '9' || strings.ContainsRune("-_./", r))
+	}) >= 0 {
+		return Sku{}, fmt.Errorf("NewSku: %w", ErrInvalidSku.With("sku", v))
+	}
+	return Sku{s}, nil
+}
+
+func (v Sku) String() string {
+	return v.string
+}
+
+// Barcode is a GTIN printed on the item: an EAN-8, a UPC-A, an EAN-13 or a GTIN-14.
+type Barcode struct {
+	string
+}
+
+var ErrInvalidBarcode = failure.Validation("invalid_stock_item_barcode", "invalid stock item barcode")
+
+// NewBarcode fails unless v is 8, 12, 13 or 14 digits whose last one is the GS1 check digit of the others.
+func NewBarcode(v string) (Barcode, error) {
+	switch len(v) {
+	case 8, 12, 13, 14:
+	default:
+		return Barcode{}, fmt.Errorf("NewBarcode: %w", ErrInvalidBarcode.With("barcode", v))
+	}
+
+	// the digits are weighted 3 and 1 alternately from the right, the check digit excluded,
+	// and the check digit rounds the sum up to a multiple of 10
+	sum := 0
+	for i := len(v) - 1; i >= 0; i-- {
+		d := int(v[i] - '0')
+		if d < 0 || d > 9 {
+			return Barcode{}, fmt.Errorf("NewBarcode: %w", ErrInvalidBarcode.With("barcode", v))
+		}
+		if (len(v)-1-i)%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	if sum%10 != 0 {
+		return Barcode{}, fmt.Errorf("NewBarcode: %w", ErrInvalidBarcode.With("barcode", v))
+	}
+
+	return Barcode{v}, nil
+}
+
+func (v Barcode) String() string {
+	return v.string
+}
+
+// UnitMaxLength is the most characters a unit of measure may have.
+const UnitMaxLength = 20
+
+// Unit is a unit of measure, such as each, case or kg.
+type Unit struct {
+	string
+}
+
+// Each counts single pieces, the default base unit.
+var Each = Unit{"each"}
+
+var ErrInvalidUnit = failure.Validation("invalid_stock_item_unit", "invalid stock item unit")
+
+// NewUnit trims the surrounding white space of v and lower-cases it. It fails unless the unit is made of
+// 1 to UnitMaxLength ASCII letters and digits.
+func NewUnit(v string) (Unit, error) {
+	u := strings.ToLower(strings.TrimSpace(v))
+	if u == "" || len(u) > UnitMaxLength || strings.IndexFunc(u, func(r rune) bool {
+		return !('a' <= r && r <= 'z' || '0' <= r && r <= '9')
+	}) >= 0 {
+		return Unit{}, fmt.Errorf("NewUnit: %w", ErrInvalidUnit.With("unit", v))
+	}
+	return Unit{u}, nil
+}
+
+func (v Unit) String() string {
+	return v.string
+}
+
+// PackSizeMaxQuantity is the most base units a pack may hold.
+const PackSizeMaxQuantity = 1_000_000
+
+// PackSize converts a pack unit to the base unit of an item, such as 1 case = 12 each.
+type PackSize struct {
+	unit     Unit
+	quantity int64
+}
+
+var ErrInvalidPackSize = failure.Validation("invalid_stock_item_pack_size", "invalid stock item pack size")
+
+// NewPackSize fails unless one unit holds 2 to PackSizeMaxQuantity base units.
+func NewPackSize(unit Unit, quantity int64) (PackSize, error) {
+	if unit == (Unit{}) || quantity < 2 || quantity > PackSizeMaxQuantity {
+		return PackSize{}, fmt.Errorf("NewPackSize: %w", ErrInvalidPackSize.With("unit", unit.String()).With("quantity", quantity))
+	}
+	return PackSize{unit: unit, quantity: quantity}, nil
+}
+
+func (v PackSize) Unit() Unit {
+	return v.unit
+}
+
+// Quantity is how many base units one unit holds.
+func (v PackSize) Quantity() int64 {
+	return v.quantity
+}
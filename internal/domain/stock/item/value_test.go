@@ -0,0 +1,175 @@
+package item_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+)
+
+func TestNewName(t *testing.T) {
+	t.Parallel()
+
+	// When
+	value := "test"
+	name, err := item.NewName(value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if name.String() != value {
+		t.Errorf("%T %+v want %+v", name, name, value)
+	}
+}
+
+func TestNewNameFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	value := ""
+	name, err := item.NewName(value)
+	if err == nil {
+		t.Fatal("expected error but returned nil")
+	}
+
+	// Then
+	if name.String() != value {
+		t.Errorf("%T %+v want %+v", name, name, value)
+	}
+}
+
+func TestNewSku(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"ab-123", "AB-123"},
+		{" Tee/Blue.M_2 ", "TEE/BLUE.M_2"},
+		{strings.Repeat("a", item.SkuMaxLength), strings.Repeat("A", item.SkuMaxLength)},
+	}
+
+	for _, tt := range tests {
+		// When
+		sku, err := item.NewSku(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if sku.String() != tt.want {
+			t.Errorf("%T %+v want %+v", sku, sku, tt.want)
+		}
+	}
+}
+
+func TestNewSkuFail(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{"", "  ", "AB 123", "AB#1", "ÄB-1", strings.Repeat("A", item.SkuMaxLength+1)}
+
+	for _, value := range tests {
+		// When
+		_, err := item.NewSku(value)
+
+		// Then
+		if !errors.Is(err, item.ErrInvalidSku) {
+			t.Errorf("%q %T %+v want %+v", value, err, err, item.ErrInvalidSku)
+		}
+	}
+}
+
+func TestNewBarcode(t *testing.T) {
+	t.Parallel()
+
+	// EAN-8, UPC-A, EAN-13 and GTIN-14
+	tests := []string{"96385074", "036000291452", "4006381333931", "10614141000415"}
+
+	for _, value := range tests {
+		// When
+		barcode, err := item.NewBarcode(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if barcode.String() != value {
+			t.Errorf("%T %+v want %+v", barcode, barcode, value)
+		}
+	}
+}
+
+func TestNewBarcodeFail(t *testing.T) {
+	t.Parallel()
+
+	tests := []string{"", "4006381333932", "400638133393", "40063813339311", "4006381333a31", " 4006381333931", "1234567"}
+
+	for _, value := range tests {
+		// When
+		_, err := item.NewBarcode(value)
+
+		// Then
+		if !errors.Is(err, item.ErrInvalidBarcode) {
+			t.Errorf("%q %T %+v want %+v", value, err, err, item.ErrInvalidBarcode)
+		}
+	}
+}
+
+func TestNewUnit(t *testing.T) {
+	t.Parallel()
+
+	// When
+	unit, err := item.NewUnit(" KG ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if unit.String() != "kg" {
+		t.Errorf("%T %+v want %+v", unit, unit, "kg")
+	}
+
+	for _, value := range []string{"", "cubic metre", "m³", strings.Repeat("x", item.UnitMaxLength+1)} {
+		if _, err := item.NewUnit(value); !errors.Is(err, item.ErrInvalidUnit) {
+			t.Errorf("%q %T %+v want %+v", value, err, err, item.ErrInvalidUnit)
+		}
+	}
+}
+
+func TestNewPackSize(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	unit, err := item.NewUnit("case")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	packSize, err := item.NewPackSize(unit, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if packSize.Unit() != unit || packSize.Quantity() != 12 {
+		t.Errorf("%T %+v want %+v 12", packSize, packSize, unit)
+	}
+
+	for _, tt := range []struct {
+		unit     item.Unit
+		quantity int64
+	}{
+		{unit, 1},
+		{unit, 0},
+		{unit, item.PackSizeMaxQuantity + 1},
+		{item.Unit{}, 12},
+	} {
+		if _, err := item.NewPackSize(tt.unit, tt.quantity); !errors.Is(err, item.ErrInvalidPackSize) {
+			t.Errorf("%+v %T %+v want %+v", tt, err, err, item.ErrInvalidPackSize)
+		}
+	}
+}
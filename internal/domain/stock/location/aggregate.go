@@ -1,25 +1,94 @@
 package location
 
+import "openapi/internal/domain/failure"
+
+var (
+	ErrVersionMismatch = failure.PreconditionFailed("stock_location_version_mismatch", "stock location has been modified by someone else")
+	ErrNotDeleted      = failure.Conflict("stock_location_not_deleted", "stock location must be deleted before it is purged")
+	ErrInUse           = failure.Conflict("stock_location_in_use", "stock location still has stock on hand")
+	ErrParentCycle     = failure.Validation("stock_location_parent_cycle", "stock location cannot be placed under itself or one of its descendants")
+	// ErrParentNotAvailable is returned when the parent does not exist or has been deleted.
+	ErrParentNotAvailable = failure.Validation("stock_location_parent_not_available", "parent stock location does not exist or has been deleted")
+	ErrHasChildren        = failure.Conflict("stock_location_has_children", "stock location still has child locations")
+	ErrHasHistory         = failure.Conflict("stock_location_has_history", "stock location is still referenced by stock movements, transfers or serials")
+	ErrOverCapacity       = failure.Conflict("stock_location_over_capacity", "stock location does not have room for the quantity")
+)
+
 type Aggregate struct {
-	Id      Id
-	Name    Name
-	deleted bool
+	Id   Id
+	Name Name
+	Type Type
+	// Capacity limits what the location holds. Stock already over a lowered capacity stays,
+	// but nothing more is brought in.
+	Capacity Capacity
+	// AllowNegativeStock lets the on-hand balance of an item in this location go below zero.
+	AllowNegativeStock bool
+	// parentId is the location this one is part of, nil for a root location.
+	parentId *Id
+	deleted  bool
+	// version counts the saves of the aggregate, 0 until it is saved for the first time.
+	version int64
 }
 
 func NewAggregate(id Id, name Name) *Aggregate {
 	return &Aggregate{
 		Id:      id,
 		Name:    name,
+		Type:    Warehouse,
 		deleted: false,
+		version: 0,
 	}
 }
 
-func RestoreAggregate(id Id, name Name, deleted bool) *Aggregate {
+func RestoreAggregate(id Id, name Name, typ Type, capacity Capacity, parentId *Id, deleted bool, allowNegativeStock bool, version int64) *Aggregate {
 	return &Aggregate{
-		Id:      id,
-		Name:    name,
-		deleted: deleted,
+		Id:                 id,
+		Name:               name,
+		Type:               typ,
+		Capacity:           capacity,
+		AllowNegativeStock: allowNegativeStock,
+		parentId:           copyId(parentId),
+		deleted:            deleted,
+		version:            version,
+	}
+}
+
+// ParentId returns the id of the parent location, or nil for a root location.
+func (a Aggregate) ParentId() *Id {
+	return copyId(a.parentId)
+}
+
+// MoveUnder makes parent the parent of the aggregate. path holds the ancestors of parent, root first,
+// and is checked so that a location never ends up under itself or one of its descendants.
+func (a *Aggregate) MoveUnder(parent *Aggregate, path []*Aggregate) error {
+	if parent.Id == a.Id {
+		return ErrParentCycle
+	}
+	for _, p := range path {
+		if p.Id == a.Id {
+			return ErrParentCycle
+		}
+	}
+
+	if parent.IsDeleted() {
+		return ErrParentNotAvailable
 	}
+
+	a.parentId = copyId(&parent.Id)
+	return nil
+}
+
+// MoveToRoot detaches the aggregate from its parent.
+func (a *Aggregate) MoveToRoot() {
+	a.parentId = nil
+}
+
+func copyId(id *Id) *Id {
+	if id == nil {
+		return nil
+	}
+	v := *id
+	return &v
 }
 
 func (a Aggregate) IsDeleted() bool {
@@ -29,3 +98,37 @@ func (a Aggregate) IsDeleted() bool {
 func (a *Aggregate) Delete() {
 	a.deleted = true
 }
+
+// Restore undoes Delete.
+func (a *Aggregate) Restore() {
+	a.deleted = false
+}
+
+// CheckPurgeable fails unless the aggregate has been deleted, since only deleted locations
+// may be removed for good.
+func (a Aggregate) CheckPurgeable() error {
+	if !a.deleted {
+		return ErrNotDeleted
+	}
+	return nil
+}
+
+func (a Aggregate) Version() int64 {
+	return a.version
+}
+
+// CheckVersion fails unless the aggregate is at one of the expected versions.
+// A nil slice expects nothing, while an empty one never matches.
+func (a Aggregate) CheckVersion(expected []int64) error {
+	if expected == nil {
+		return nil
+	}
+
+	for _, v := range expected {
+		if v == a.version {
+			return nil
+		}
+	}
+
+	return ErrVersionMismatch
+}
@@ -33,9 +33,25 @@ func TestNewAggregate(t *testing.T) {
 		t.Errorf("%T %+v want %+v", a.Name, a.Name, name)
 	}
 
+	if a.Type != location.Warehouse {
+		t.Errorf("%T %+v want %+v", a.Type, a.Type, location.Warehouse)
+	}
+
+	if !a.Capacity.IsUnlimited() {
+		t.Errorf("%T %+v want unlimited", a.Capacity, a.Capacity)
+	}
+
 	if a.IsDeleted() != false {
 		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), false)
 	}
+
+	if a.AllowNegativeStock != false {
+		t.Errorf("%T %+v want %+v", a.AllowNegativeStock, a.AllowNegativeStock, false)
+	}
+
+	if a.Version() != 0 {
+		t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 0)
+	}
 }
 
 func TestRestoreAggregate(t *testing.T) {
@@ -52,8 +68,19 @@ func TestRestoreAggregate(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	parentId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	units := int64(10)
+	capacity, err := location.NewCapacity(&units, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
 	// When
-	a := location.RestoreAggregate(id, name, false)
+	a := location.RestoreAggregate(id, name, location.Bin, capacity, &parentId, false, true, 3)
 
 	// Then
 	if a.Id != id {
@@ -64,9 +91,29 @@ func TestRestoreAggregate(t *testing.T) {
 		t.Errorf("%T %+v want %+v", a.Name, a.Name, name)
 	}
 
+	if a.Type != location.Bin {
+		t.Errorf("%T %+v want %+v", a.Type, a.Type, location.Bin)
+	}
+
+	if a.Capacity != capacity {
+		t.Errorf("%T %+v want %+v", a.Capacity, a.Capacity, capacity)
+	}
+
+	if a.ParentId() == nil || *a.ParentId() != parentId {
+		t.Errorf("%T %+v want %+v", a.ParentId(), a.ParentId(), parentId)
+	}
+
 	if a.IsDeleted() != false {
 		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), false)
 	}
+
+	if a.AllowNegativeStock != true {
+		t.Errorf("%T %+v want %+v", a.AllowNegativeStock, a.AllowNegativeStock, true)
+	}
+
+	if a.Version() != 3 {
+		t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 3)
+	}
 }
 
 func TestDelete(t *testing.T) {
@@ -93,3 +140,223 @@ func TestDelete(t *testing.T) {
 		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), true)
 	}
 }
+
+func TestRestore(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.RestoreAggregate(id, name, location.Warehouse, location.Capacity{}, nil, true, false, 2)
+
+	// When
+	a.Restore()
+
+	// Then
+	if a.IsDeleted() != false {
+		t.Errorf("%T %+v want %+v", a.IsDeleted(), a.IsDeleted(), false)
+	}
+
+	if a.Version() != 2 {
+		t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 2)
+	}
+}
+
+func TestCheckPurgeable(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.NewAggregate(id, name)
+
+	// When
+	active := a.CheckPurgeable()
+	a.Delete()
+	deleted := a.CheckPurgeable()
+
+	// Then
+	if active != location.ErrNotDeleted {
+		t.Errorf("%T %+v want %+v", active, active, location.ErrNotDeleted)
+	}
+
+	if deleted != nil {
+		t.Errorf("%T %+v want %+v", deleted, deleted, nil)
+	}
+}
+
+func TestCheckVersion(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.RestoreAggregate(id, name, location.Warehouse, location.Capacity{}, nil, false, false, 3)
+
+	tests := []struct {
+		expected []int64
+		err      error
+	}{
+		{nil, nil},
+		{[]int64{3}, nil},
+		{[]int64{1, 3}, nil},
+		{[]int64{2}, location.ErrVersionMismatch},
+		{[]int64{}, location.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		// When
+		err := a.CheckVersion(tt.expected)
+
+		// Then
+		if err != tt.err {
+			t.Errorf("%+v %T %+v want %+v", tt.expected, err, err, tt.err)
+		}
+	}
+}
+
+func newTestAggregate(t *testing.T) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.NewAggregate(id, name)
+}
+
+func TestMoveUnder(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	root := newTestAggregate(t)
+	parent := newTestAggregate(t)
+	if err := parent.MoveUnder(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	a := newTestAggregate(t)
+
+	// When
+	err := a.MoveUnder(parent, []*location.Aggregate{root})
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if a.ParentId() == nil || *a.ParentId() != parent.Id {
+		t.Errorf("%T %+v want %+v", a.ParentId(), a.ParentId(), parent.Id)
+	}
+
+	if root.ParentId() != nil {
+		t.Errorf("%T %+v want %+v", root.ParentId(), root.ParentId(), nil)
+	}
+}
+
+func TestMoveUnderFailCycle(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	root := newTestAggregate(t)
+	child := newTestAggregate(t)
+	if err := child.MoveUnder(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	grandchild := newTestAggregate(t)
+	if err := grandchild.MoveUnder(child, []*location.Aggregate{root}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		parent *location.Aggregate
+		path   []*location.Aggregate
+	}{
+		{root, nil},
+		{child, []*location.Aggregate{root}},
+		{grandchild, []*location.Aggregate{root, child}},
+	}
+
+	for _, tt := range tests {
+		// When
+		err := root.MoveUnder(tt.parent, tt.path)
+
+		// Then
+		if err != location.ErrParentCycle {
+			t.Errorf("%+v %T %+v want %+v", tt.parent.Id, err, err, location.ErrParentCycle)
+		}
+	}
+
+	if root.ParentId() != nil {
+		t.Errorf("%T %+v want %+v", root.ParentId(), root.ParentId(), nil)
+	}
+}
+
+func TestMoveUnderFailDeletedParent(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	parent := newTestAggregate(t)
+	parent.Delete()
+	a := newTestAggregate(t)
+
+	// When
+	err := a.MoveUnder(parent, nil)
+
+	// Then
+	if err != location.ErrParentNotAvailable {
+		t.Errorf("%T %+v want %+v", err, err, location.ErrParentNotAvailable)
+	}
+
+	if a.ParentId() != nil {
+		t.Errorf("%T %+v want %+v", a.ParentId(), a.ParentId(), nil)
+	}
+}
+
+func TestMoveToRoot(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	parent := newTestAggregate(t)
+	a := newTestAggregate(t)
+	if err := a.MoveUnder(parent, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	a.MoveToRoot()
+
+	// Then
+	if a.ParentId() != nil {
+		t.Errorf("%T %+v want %+v", a.ParentId(), a.ParentId(), nil)
+	}
+}
@@ -0,0 +1,41 @@
+package location
+
+import (
+	"fmt"
+	"time"
+)
+
+type Order string
+
+const (
+	OrderAsc  Order = "asc"
+	OrderDesc Order = "desc"
+)
+
+func NewOrder(v string) (Order, error) {
+	switch Order(v) {
+	case OrderAsc, OrderDesc:
+		return Order(v), nil
+	}
+	return "", fmt.Errorf("NewOrder: invalid order %+v", v)
+}
+
+// Cursor points at the last location of a page. Locations are ordered by name and then by id.
+type Cursor struct {
+	Name Name
+	Id   Id
+}
+
+type ListQuery struct {
+	NamePrefix string
+	// ParentId limits the list to the direct children of the location.
+	ParentId *Id
+	// Type limits the list to the locations of the type.
+	Type           *Type
+	IncludeDeleted bool
+	// DeletedBefore limits the list to the locations deleted before the time, regardless of IncludeDeleted.
+	DeletedBefore *time.Time
+	Order         Order
+	After         *Cursor
+	Limit         int
+}
@@ -0,0 +1,36 @@
+package location_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+)
+
+func TestNewOrder(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"asc", "desc"} {
+		// When
+		order, err := location.NewOrder(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if string(order) != value {
+			t.Errorf("%T %+v want %+v", order, order, value)
+		}
+	}
+}
+
+func TestNewOrderFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := location.NewOrder("random")
+
+	// Then
+	if err == nil {
+		t.Fatal("expected error but returned nil")
+	}
+}
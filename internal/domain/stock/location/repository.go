@@ -1,7 +1,54 @@
 package location
 
+import (
+	"context"
+	"errors"
+
+	"openapi/internal/domain/failure"
+)
+
+var (
+	ErrNotFound  = failure.NotFound("stock_location_not_found", "stock location not found")
+	ErrNameTaken = failure.Conflict("stock_location_name_taken", "another stock location has the name")
+)
+
 type IRepository interface {
-	Save(a *Aggregate) error
-	Get(id Id) (*Aggregate, error)
-	Find(id Id) (bool, error)
+	// Save stores the aggregate unless it has been saved by someone else since it was read,
+	// in which case ErrVersionMismatch is returned. On success the aggregate carries its new version.
+	// The time the aggregate is deleted is kept until it is restored.
+	// ErrNameTaken is returned when another active stock location has the name of an active aggregate.
+	Save(ctx context.Context, a *Aggregate) error
+	// Get returns ErrNotFound when no stock location has the id, deleted or not.
+	Get(ctx context.Context, id Id) (*Aggregate, error)
+	Find(ctx context.Context, id Id) (bool, error)
+	// FindByName returns the active stock location with the name, or ErrNotFound when there is none.
+	FindByName(ctx context.Context, name Name) (*Aggregate, error)
+	List(ctx context.Context, q ListQuery) ([]*Aggregate, error)
+	// Ancestors returns the locations above the stock location, root first and without the location itself.
+	Ancestors(ctx context.Context, id Id) ([]*Aggregate, error)
+	// Descendants returns every location below the stock location, deleted or not, in no particular order.
+	Descendants(ctx context.Context, id Id) ([]*Aggregate, error)
+	// Purge removes the stock location for good, and returns ErrNotFound when no stock location has the id.
+	Purge(ctx context.Context, id Id) error
+}
+
+// CheckNameAvailable fails with ErrNameTaken when an active stock location other than a has the name of a.
+// Deleted locations give up their names, so a deleted a never conflicts.
+func CheckNameAvailable(ctx context.Context, r IRepository, a *Aggregate) error {
+	if a.IsDeleted() {
+		return nil
+	}
+
+	other, err := r.FindByName(ctx, a.Name)
+	if errors.Is(err, ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if other.Id != a.Id {
+		return ErrNameTaken
+	}
+	return nil
 }
@@ -0,0 +1,76 @@
+package location_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/location"
+)
+
+// namedRepository holds the active stock locations by name.
+type namedRepository struct {
+	location.IRepository
+	active map[location.Name]*location.Aggregate
+}
+
+func (r namedRepository) FindByName(_ context.Context, name location.Name) (*location.Aggregate, error) {
+	a, ok := r.active[name]
+	if !ok {
+		return nil, location.ErrNotFound
+	}
+	return a, nil
+}
+
+func newNamedAggregate(t *testing.T, name string) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := location.NewName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return location.NewAggregate(id, n)
+}
+
+func TestCheckNameAvailable(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	taken := newNamedAggregate(t, "Warehouse A")
+	r := namedRepository{active: map[location.Name]*location.Aggregate{taken.Name: taken}}
+
+	deleted := newNamedAggregate(t, "Warehouse A")
+	deleted.Delete()
+
+	for _, a := range []*location.Aggregate{newNamedAggregate(t, "Warehouse B"), taken, deleted} {
+		// When
+		err := location.CheckNameAvailable(context.Background(), r, a)
+
+		// Then
+		if err != nil {
+			t.Errorf("%+v: %T = %v, want nil", a, err, err)
+		}
+	}
+}
+
+func TestCheckNameAvailableFail(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	taken := newNamedAggregate(t, "Warehouse A")
+	r := namedRepository{active: map[location.Name]*location.Aggregate{taken.Name: taken}}
+
+	// When
+	err := location.CheckNameAvailable(context.Background(), r, newNamedAggregate(t, " Warehouse A "))
+
+	// Then
+	if !errors.Is(err, location.ErrNameTaken) {
+		t.Errorf("%T = %v, want %v", err, err, location.ErrNameTaken)
+	}
+}
@@ -1,18 +1,170 @@
 package location
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/unicode/norm"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/measure"
+)
+
+// NameMaxLength is the most characters a name may have.
+const NameMaxLength = 100
 
 type Name struct {
 	string
 }
 
+var ErrInvalidName = failure.Validation("invalid_stock_location_name", "invalid stock location name")
+
+// NewName trims the surrounding white space of v and normalizes it to Unicode NFC, so that names
+// which look the same are equal. It fails when the name is empty, is longer than NameMaxLength
+// characters or contains control characters.
 func NewName(v string) (Name, error) {
-	if v == "" {
-		return Name{}, fmt.Errorf("NewName: invalid name %+v", v)
+	n := norm.NFC.String(strings.TrimSpace(v))
+	if n == "" || utf8.RuneCountInString(n) > NameMaxLength || strings.IndexFunc(n, unicode.IsControl) >= 0 {
+		return Name{}, fmt.Errorf("NewName: %w", ErrInvalidName.With("name", v))
 	}
-	return Name{v}, nil
+	return Name{n}, nil
 }
 
 func (v Name) String() string {
 	return v.string
 }
+
+// Type tells what a location is for.
+type Type struct {
+	string
+}
+
+var (
+	// Warehouse is a building, the default type.
+	Warehouse = Type{"warehouse"}
+	// Zone is an area of a warehouse.
+	Zone = Type{"zone"}
+	// Bin is a shelf or slot that holds stock.
+	Bin = Type{"bin"}
+	// Staging holds stock that has been received or picked and waits to be put away or shipped.
+	Staging = Type{"staging"}
+	// Quarantine holds stock that must not be used until it is inspected.
+	Quarantine = Type{"quarantine"}
+	// Virtual holds stock that is not in any physical place, such as stock in transit.
+	Virtual = Type{"virtual"}
+)
+
+var ErrInvalidType = failure.Validation("invalid_stock_location_type", "invalid stock location type")
+
+func NewType(v string) (Type, error) {
+	for _, t := range []Type{Warehouse, Zone, Bin, Staging, Quarantine, Virtual} {
+		if t.string == v {
+			return t, nil
+		}
+	}
+	return Type{}, fmt.Errorf("NewType: %w", ErrInvalidType.With("type", v))
+}
+
+func (v Type) String() string {
+	return v.string
+}
+
+// Capacity limits what a location holds by the number of units, their volume and their weight.
+// A limit of zero does not apply, so the zero Capacity is unlimited.
+type Capacity struct {
+	units  int64
+	volume measure.Volume
+	weight measure.Weight
+}
+
+var ErrInvalidCapacity = failure.Validation("invalid_stock_location_capacity", "invalid stock location capacity")
+
+// NewCapacity fails unless every limit that is not nil is above zero. A nil limit does not apply.
+func NewCapacity(units, volume, weight *int64) (Capacity, error) {
+	c := Capacity{}
+
+	if units != nil {
+		if *units <= 0 {
+			return Capacity{}, fmt.Errorf("NewCapacity: %w", ErrInvalidCapacity.With("max_units", *units))
+		}
+		c.units = *units
+	}
+
+	if volume != nil {
+		v, err := measure.NewVolume(*volume)
+		if err != nil || *volume == 0 {
+			return Capacity{}, fmt.Errorf("NewCapacity: %w", ErrInvalidCapacity.With("max_volume", *volume))
+		}
+		c.volume = v
+	}
+
+	if weight != nil {
+		v, err := measure.NewWeight(*weight)
+		if err != nil || *weight == 0 {
+			return Capacity{}, fmt.Errorf("NewCapacity: %w", ErrInvalidCapacity.With("max_weight", *weight))
+		}
+		c.weight = v
+	}
+
+	return c, nil
+}
+
+// Units returns the most units the location holds, nil when unlimited.
+func (v Capacity) Units() *int64 {
+	if v.units == 0 {
+		return nil
+	}
+	u := v.units
+	return &u
+}
+
+// Volume returns the most volume the location holds, nil when unlimited.
+func (v Capacity) Volume() *measure.Volume {
+	if v.volume.Int64() == 0 {
+		return nil
+	}
+	u := v.volume
+	return &u
+}
+
+// Weight returns the most weight the location holds, nil when unlimited.
+func (v Capacity) Weight() *measure.Weight {
+	if v.weight.Int64() == 0 {
+		return nil
+	}
+	u := v.weight
+	return &u
+}
+
+// IsUnlimited reports whether no limit applies.
+func (v Capacity) IsUnlimited() bool {
+	return v == Capacity{}
+}
+
+// LimitsMeasures reports whether the volume or the weight is limited, which needs the measures of the items.
+func (v Capacity) LimitsMeasures() bool {
+	return v.volume.Int64() != 0 || v.weight.Int64() != 0
+}
+
+// Check fails with ErrOverCapacity when the load goes over any of the limits.
+func (v Capacity) Check(l Load) error {
+	if v.units != 0 && l.Units > v.units {
+		return ErrOverCapacity
+	}
+	if v.volume.Int64() != 0 && l.Volume.Int64() > v.volume.Int64() {
+		return ErrOverCapacity
+	}
+	if v.weight.Int64() != 0 && l.Weight.Int64() > v.weight.Int64() {
+		return ErrOverCapacity
+	}
+	return nil
+}
+
+// Load is what a location holds: the units of every item, and their volume and weight.
+type Load struct {
+	Units  int64
+	Volume measure.Volume
+	Weight measure.Weight
+}
@@ -1,9 +1,12 @@
 package location_test
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
 	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/measure"
 )
 
 func TestNewName(t *testing.T) {
@@ -37,3 +40,186 @@ func TestNewNameFail(t *testing.T) {
 		t.Errorf("%T %+v want %+v", name, name, value)
 	}
 }
+
+func TestNewNameNormalize(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		value string
+		want  string
+	}{
+		{"  Warehouse A\t\n", "Warehouse A"},
+		{"Cafe\u0301", "Caf\u00e9"},
+		{strings.Repeat("e\u0301", location.NameMaxLength), strings.Repeat("\u00e9", location.NameMaxLength)},
+	} {
+		// When
+		name, err := location.NewName(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if name.String() != tt.want {
+			t.Errorf("%T %q want %q", name, name.String(), tt.want)
+		}
+	}
+}
+
+func TestNewNameFailInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{
+		" \t\n",
+		strings.Repeat("a", location.NameMaxLength+1),
+		"Warehouse\x00A",
+		"Warehouse\u0085A",
+	} {
+		// When
+		_, err := location.NewName(value)
+
+		// Then
+		if !errors.Is(err, location.ErrInvalidName) {
+			t.Errorf("%q: %T = %v, want %v", value, err, err, location.ErrInvalidName)
+		}
+	}
+}
+
+func TestNewType(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"warehouse", "zone", "bin", "staging", "quarantine", "virtual"} {
+		// When
+		typ, err := location.NewType(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if typ.String() != value {
+			t.Errorf("%T %+v want %+v", typ, typ, value)
+		}
+	}
+}
+
+func TestNewTypeFail(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"", "Warehouse", "shelf"} {
+		// When
+		_, err := location.NewType(value)
+
+		// Then
+		if !errors.Is(err, location.ErrInvalidType) {
+			t.Errorf("%q: %T = %v, want %v", value, err, err, location.ErrInvalidType)
+		}
+	}
+}
+
+func TestNewCapacity(t *testing.T) {
+	t.Parallel()
+
+	// When
+	units, volume, weight := int64(10), int64(2000), int64(5000)
+	c, err := location.NewCapacity(&units, &volume, &weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if c.Units() == nil || *c.Units() != units {
+		t.Errorf("%T %+v want %+v", c.Units(), c.Units(), units)
+	}
+
+	if c.Volume() == nil || c.Volume().Int64() != volume {
+		t.Errorf("%T %+v want %+v", c.Volume(), c.Volume(), volume)
+	}
+
+	if c.Weight() == nil || c.Weight().Int64() != weight {
+		t.Errorf("%T %+v want %+v", c.Weight(), c.Weight(), weight)
+	}
+
+	if c.IsUnlimited() {
+		t.Errorf("%T %+v want limited", c, c)
+	}
+}
+
+func TestNewCapacityUnlimited(t *testing.T) {
+	t.Parallel()
+
+	// When
+	c, err := location.NewCapacity(nil, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if c.Units() != nil || c.Volume() != nil || c.Weight() != nil || !c.IsUnlimited() {
+		t.Errorf("%T %+v want unlimited", c, c)
+	}
+}
+
+func TestNewCapacityFailInvalid(t *testing.T) {
+	t.Parallel()
+
+	zero, negative := int64(0), int64(-1)
+	for _, tt := range []struct {
+		name                  string
+		units, volume, weight *int64
+	}{
+		{"zero units", &zero, nil, nil},
+		{"negative units", &negative, nil, nil},
+		{"zero volume", nil, &zero, nil},
+		{"negative volume", nil, &negative, nil},
+		{"zero weight", nil, nil, &zero},
+		{"negative weight", nil, nil, &negative},
+	} {
+		// When
+		_, err := location.NewCapacity(tt.units, tt.volume, tt.weight)
+
+		// Then
+		if !errors.Is(err, location.ErrInvalidCapacity) {
+			t.Errorf("%s: %T = %v, want %v", tt.name, err, err, location.ErrInvalidCapacity)
+		}
+	}
+}
+
+func TestCapacityCheck(t *testing.T) {
+	t.Parallel()
+
+	units, volume, weight := int64(10), int64(2000), int64(5000)
+	c, err := location.NewCapacity(&units, &volume, &weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	load := func(units, volume, weight int64) location.Load {
+		v, _ := measure.NewVolume(volume)
+		w, _ := measure.NewWeight(weight)
+		return location.Load{Units: units, Volume: v, Weight: w}
+	}
+
+	for _, tt := range []struct {
+		name string
+		load location.Load
+		want error
+	}{
+		{"empty", load(0, 0, 0), nil},
+		{"full", load(10, 2000, 5000), nil},
+		{"over units", load(11, 0, 0), location.ErrOverCapacity},
+		{"over volume", load(1, 2001, 0), location.ErrOverCapacity},
+		{"over weight", load(1, 0, 5001), location.ErrOverCapacity},
+	} {
+		// When
+		err := c.Check(tt.load)
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: %T = %v, want %v", tt.name, err, err, tt.want)
+		}
+	}
+
+	// an unlimited capacity takes any load
+	if err := (location.Capacity{}).Check(load(1<<40, 1<<40, 1<<40)); err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
@@ -0,0 +1,80 @@
+package lot
+
+import (
+	"fmt"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+)
+
+var (
+	ErrInvalidDates  = failure.Validation("invalid_stock_lot_dates", "stock lot expires before it is manufactured")
+	ErrDatesMismatch = failure.Conflict("stock_lot_dates_mismatch", "stock lot has other manufacture or expiry dates")
+)
+
+// Aggregate is a lot of an item: units made together, which share their manufacture and expiry dates.
+// A lot is recorded by the first receipt of its number and never changes afterwards.
+type Aggregate struct {
+	Id     Id
+	ItemId item.Id
+	Number Number
+	// ManufacturedOn and ExpiresOn are zero when they are unknown. A lot without expiry date never expires.
+	ManufacturedOn Date
+	ExpiresOn      Date
+}
+
+// NewAggregate fails with ErrInvalidDates when the lot would expire before it is manufactured.
+func NewAggregate(id Id, itemId item.Id, number Number, manufacturedOn Date, expiresOn Date) (*Aggregate, error) {
+	if !manufacturedOn.IsZero() && !expiresOn.IsZero() && expiresOn.Before(manufacturedOn) {
+		return nil, fmt.Errorf("NewAggregate: %w", ErrInvalidDates.With("manufactured_on", manufacturedOn.String()).With("expires_on", expiresOn.String()))
+	}
+
+	return &Aggregate{
+		Id:             id,
+		ItemId:         itemId,
+		Number:         number,
+		ManufacturedOn: manufacturedOn,
+		ExpiresOn:      expiresOn,
+	}, nil
+}
+
+func RestoreAggregate(id Id, itemId item.Id, number Number, manufacturedOn Date, expiresOn Date) *Aggregate {
+	return &Aggregate{
+		Id:             id,
+		ItemId:         itemId,
+		Number:         number,
+		ManufacturedOn: manufacturedOn,
+		ExpiresOn:      expiresOn,
+	}
+}
+
+// CheckDates fails with ErrDatesMismatch when a date another receipt gives for the lot is not the one it has.
+// Zero dates are not checked, so a receipt may leave them out.
+func (a Aggregate) CheckDates(manufacturedOn Date, expiresOn Date) error {
+	if !manufacturedOn.IsZero() && manufacturedOn != a.ManufacturedOn {
+		return fmt.Errorf("CheckDates: %w", ErrDatesMismatch.With("manufactured_on", a.ManufacturedOn.String()))
+	}
+
+	if !expiresOn.IsZero() && expiresOn != a.ExpiresOn {
+		return fmt.Errorf("CheckDates: %w", ErrDatesMismatch.With("expires_on", a.ExpiresOn.String()))
+	}
+
+	return nil
+}
+
+// IsExpired reports whether the lot expired before the day. A lot can still be used on its expiry date.
+func (a Aggregate) IsExpired(day Date) bool {
+	return !a.ExpiresOn.IsZero() && a.ExpiresOn.Before(day)
+}
+
+// ExpiresFirst orders lots first expired first out: by expiry date, the lots without one last,
+// and by number between lots that expire on the same day.
+func ExpiresFirst(a *Aggregate, b *Aggregate) bool {
+	if a.ExpiresOn != b.ExpiresOn {
+		if a.ExpiresOn.IsZero() || b.ExpiresOn.IsZero() {
+			return b.ExpiresOn.IsZero()
+		}
+		return a.ExpiresOn.Before(b.ExpiresOn)
+	}
+	return a.Number.String() < b.Number.String()
+}
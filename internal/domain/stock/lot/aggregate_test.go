@@ -0,0 +1,195 @@
+package lot_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+)
+
+func newDate(t *testing.T, v string) lot.Date {
+	t.Helper()
+
+	if v == "" {
+		return lot.Date{}
+	}
+
+	d, err := lot.ParseDate(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func newLot(t *testing.T, number string, manufacturedOn string, expiresOn string) *lot.Aggregate {
+	t.Helper()
+
+	id, err := lot.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := lot.NewNumber(number)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := lot.NewAggregate(id, itemId, n, newDate(t, manufacturedOn), newDate(t, expiresOn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestNewAggregate(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := lot.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	number, err := lot.NewNumber("L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	manufacturedOn := newDate(t, "2024-07-01")
+	expiresOn := newDate(t, "2024-07-01")
+
+	// When
+	a, err := lot.NewAggregate(id, itemId, number, manufacturedOn, expiresOn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if a.Id != id || a.ItemId != itemId || a.Number != number {
+		t.Errorf("%T %+v want %+v %+v %+v", a, a, id, itemId, number)
+	}
+
+	if a.ManufacturedOn != manufacturedOn || a.ExpiresOn != expiresOn {
+		t.Errorf("%T %+v want %+v %+v", a, a, manufacturedOn, expiresOn)
+	}
+}
+
+func TestNewAggregateFailInvalidDates(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := lot.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	number, err := lot.NewNumber("L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = lot.NewAggregate(id, itemId, number, newDate(t, "2024-07-02"), newDate(t, "2024-07-01"))
+
+	// Then
+	if !errors.Is(err, lot.ErrInvalidDates) {
+		t.Errorf("%T %+v want %+v", err, err, lot.ErrInvalidDates)
+	}
+}
+
+func TestCheckDates(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a := newLot(t, "L1", "2024-01-01", "2025-01-01")
+
+	tests := []struct {
+		manufacturedOn string
+		expiresOn      string
+		want           error
+	}{
+		{"", "", nil},
+		{"2024-01-01", "2025-01-01", nil},
+		{"", "2025-01-01", nil},
+		{"2024-01-02", "", lot.ErrDatesMismatch},
+		{"", "2025-01-02", lot.ErrDatesMismatch},
+	}
+
+	for _, tt := range tests {
+		// When
+		err := a.CheckDates(newDate(t, tt.manufacturedOn), newDate(t, tt.expiresOn))
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%+v: %T %+v want %+v", tt, err, err, tt.want)
+		}
+	}
+}
+
+func TestIsExpired(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a := newLot(t, "L1", "", "2024-07-01")
+	never := newLot(t, "L2", "", "")
+
+	// Then
+	if a.IsExpired(newDate(t, "2024-07-01")) {
+		t.Error("a lot must not be expired on its expiry date")
+	}
+
+	if !a.IsExpired(newDate(t, "2024-07-02")) {
+		t.Error("a lot must be expired after its expiry date")
+	}
+
+	if never.IsExpired(lot.DateOf(time.Now().AddDate(100, 0, 0))) {
+		t.Error("a lot without expiry date must never expire")
+	}
+}
+
+func TestExpiresFirst(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	lots := []*lot.Aggregate{
+		newLot(t, "A", "", ""),
+		newLot(t, "B", "", "2024-08-01"),
+		newLot(t, "D", "", "2024-07-01"),
+		newLot(t, "C", "", "2024-07-01"),
+	}
+
+	// When
+	sort.SliceStable(lots, func(i, j int) bool {
+		return lot.ExpiresFirst(lots[i], lots[j])
+	})
+
+	// Then
+	got := ""
+	for _, a := range lots {
+		got += a.Number.String()
+	}
+
+	if got != "CDBA" {
+		t.Errorf("%T %+v want %+v", got, got, "CDBA")
+	}
+}
@@ -0,0 +1,11 @@
+package lot
+
+import "openapi/internal/domain/stock/item"
+
+// ListQuery selects lots in the order of ExpiresFirst, and then by item.
+type ListQuery struct {
+	// ItemId limits the list to the lots of the item.
+	ItemId *item.Id
+	// ExpiresBy limits the list to the lots that expire on the day or before.
+	ExpiresBy *Date
+}
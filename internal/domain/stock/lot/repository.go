@@ -0,0 +1,22 @@
+package lot
+
+import (
+	"context"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+)
+
+var (
+	ErrNotFound    = failure.NotFound("stock_lot_not_found", "stock lot not found")
+	ErrNumberTaken = failure.Conflict("stock_lot_number_taken", "the stock item already has a lot with the number")
+)
+
+type IRepository interface {
+	// Save records a new lot. Lots are never changed, and ErrNumberTaken is returned when
+	// the item already has a lot with the number.
+	Save(ctx context.Context, a *Aggregate) error
+	// Get returns ErrNotFound when the item has no lot with the number.
+	Get(ctx context.Context, itemId item.Id, number Number) (*Aggregate, error)
+	List(ctx context.Context, q ListQuery) ([]*Aggregate, error)
+}
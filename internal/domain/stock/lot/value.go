@@ -0,0 +1,94 @@
+package lot
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"openapi/internal/domain/failure"
+)
+
+// NumberMaxLength is the most characters a lot number may have.
+const NumberMaxLength = 64
+
+// Number identifies a lot among the lots of an item, as printed on its labels.
+// The zero Number stands for no lot.
+type Number struct {
+	string
+}
+
+var ErrInvalidNumber = failure.Validation("invalid_stock_lot_number", "invalid stock lot number")
+
+// NewNumber trims the surrounding white space of v, and fails unless the rest is 1 to NumberMaxLength
+// characters without control characters. Lot numbers are kept in the case they are printed in.
+func NewNumber(v string) (Number, error) {
+	s := strings.TrimSpace(v)
+	if s == "" || utf8.RuneCountInString(s) > NumberMaxLength || strings.IndexFunc(s, unicode.IsControl) >= 0 {
+		return Number{}, fmt.Errorf("NewNumber: %w", ErrInvalidNumber.With("lot", v))
+	}
+	return Number{s}, nil
+}
+
+func (v Number) IsZero() bool {
+	return v.string == ""
+}
+
+func (v Number) String() string {
+	return v.string
+}
+
+// DateLayout is how a Date is written.
+const DateLayout = time.DateOnly
+
+// Date is a calendar day. The zero Date stands for an unknown day.
+type Date struct {
+	t time.Time
+}
+
+// DateOf returns the day t falls on where t is.
+func DateOf(t time.Time) Date {
+	if t.IsZero() {
+		return Date{}
+	}
+	return Date{time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
+}
+
+// ParseDate reads a Date written in DateLayout.
+func ParseDate(v string) (Date, error) {
+	t, err := time.Parse(DateLayout, v)
+	if err != nil {
+		return Date{}, fmt.Errorf("ParseDate: %w", err)
+	}
+	return DateOf(t), nil
+}
+
+func (v Date) IsZero() bool {
+	return v.t.IsZero()
+}
+
+// Time returns the start of the day in UTC.
+func (v Date) Time() time.Time {
+	return v.t
+}
+
+func (v Date) Before(o Date) bool {
+	return v.t.Before(o.t)
+}
+
+// AddDays returns the day n days after v, or before it when n is negative.
+func (v Date) AddDays(n int) Date {
+	if v.IsZero() {
+		return v
+	}
+	return Date{v.t.AddDate(0, 0, n)}
+}
+
+// String returns the day in DateLayout, or "" when it is unknown.
+func (v Date) String() string {
+	if v.IsZero() {
+		return ""
+	}
+	return v.t.Format(DateLayout)
+}
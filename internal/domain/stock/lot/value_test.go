@@ -0,0 +1,106 @@
+package lot_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"openapi/internal/domain/stock/lot"
+)
+
+func TestNewNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"L-2024/07", "L-2024/07"},
+		{" lot 7a ", "lot 7a"},
+		{strings.Repeat("ä", lot.NumberMaxLength), strings.Repeat("ä", lot.NumberMaxLength)},
+	}
+
+	for _, tt := range tests {
+		// When
+		number, err := lot.NewNumber(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if number.String() != tt.want {
+			t.Errorf("%T %+v want %+v", number, number, tt.want)
+		}
+
+		if number.IsZero() {
+			t.Errorf("%+v must not be zero", number)
+		}
+	}
+}
+
+func TestNewNumberFail(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"", "  ", "L\n1", strings.Repeat("a", lot.NumberMaxLength+1)} {
+		// When
+		number, err := lot.NewNumber(value)
+
+		// Then
+		if !errors.Is(err, lot.ErrInvalidNumber) {
+			t.Errorf("%q: %T %+v want %+v", value, err, err, lot.ErrInvalidNumber)
+		}
+
+		if !number.IsZero() {
+			t.Errorf("%T %+v want zero", number, number)
+		}
+	}
+}
+
+func TestDateOf(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	tokyo := time.FixedZone("JST", 9*60*60)
+
+	// When
+	d := lot.DateOf(time.Date(2024, 7, 1, 3, 0, 0, 0, tokyo))
+
+	// Then
+	if d.String() != "2024-07-01" {
+		t.Errorf("%T %+v want %+v", d, d, "2024-07-01")
+	}
+
+	if !d.Time().Equal(time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("%T %+v want the start of the day in UTC", d.Time(), d.Time())
+	}
+
+	if d.AddDays(31).String() != "2024-08-01" {
+		t.Errorf("%T %+v want %+v", d.AddDays(31), d.AddDays(31), "2024-08-01")
+	}
+
+	if !lot.DateOf(time.Time{}).IsZero() || lot.DateOf(time.Time{}).String() != "" {
+		t.Errorf("the zero time must be the zero date")
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	// When
+	d, err := lot.ParseDate("2024-02-29")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, invalidErr := lot.ParseDate("2023-02-29")
+
+	// Then
+	if d != lot.DateOf(time.Date(2024, 2, 29, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("%T %+v want %+v", d, d, "2024-02-29")
+	}
+
+	if invalidErr == nil {
+		t.Error("expected error but returned nil")
+	}
+}
@@ -0,0 +1,64 @@
+// Package measure holds the physical quantities that stock items and locations have in common.
+// They are whole numbers of a small unit, so that adding them up never loses precision.
+package measure
+
+import (
+	"fmt"
+
+	"openapi/internal/domain/failure"
+)
+
+var (
+	ErrInvalidVolume = failure.Validation("invalid_volume", "invalid volume")
+	ErrInvalidWeight = failure.Validation("invalid_weight", "invalid weight")
+)
+
+// Volume is in cubic centimetres.
+type Volume struct {
+	int64
+}
+
+func NewVolume(v int64) (Volume, error) {
+	if v < 0 {
+		return Volume{}, fmt.Errorf("NewVolume: %w", ErrInvalidVolume.With("volume", v))
+	}
+	return Volume{v}, nil
+}
+
+func (v Volume) Int64() int64 {
+	return v.int64
+}
+
+func (v Volume) Add(o Volume) Volume {
+	return Volume{v.int64 + o.int64}
+}
+
+// Times returns the volume of n of v.
+func (v Volume) Times(n int64) Volume {
+	return Volume{v.int64 * n}
+}
+
+// Weight is in grams.
+type Weight struct {
+	int64
+}
+
+func NewWeight(v int64) (Weight, error) {
+	if v < 0 {
+		return Weight{}, fmt.Errorf("NewWeight: %w", ErrInvalidWeight.With("weight", v))
+	}
+	return Weight{v}, nil
+}
+
+func (v Weight) Int64() int64 {
+	return v.int64
+}
+
+func (v Weight) Add(o Weight) Weight {
+	return Weight{v.int64 + o.int64}
+}
+
+// Times returns the weight of n of v.
+func (v Weight) Times(n int64) Weight {
+	return Weight{v.int64 * n}
+}
@@ -0,0 +1,98 @@
+package measure_test
+
+import (
+	"errors"
+	"testing"
+
+	"openapi/internal/domain/stock/measure"
+)
+
+func TestNewVolume(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []int64{0, 1, 1000} {
+		// When
+		v, err := measure.NewVolume(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if v.Int64() != value {
+			t.Errorf("%T %+v want %+v", v, v, value)
+		}
+	}
+}
+
+func TestNewVolumeFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := measure.NewVolume(-1)
+
+	// Then
+	if !errors.Is(err, measure.ErrInvalidVolume) {
+		t.Errorf("%T = %v, want %v", err, err, measure.ErrInvalidVolume)
+	}
+}
+
+func TestVolumeAddTimes(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a, _ := measure.NewVolume(3)
+	b, _ := measure.NewVolume(10)
+
+	// When
+	got := a.Times(4).Add(b)
+
+	// Then
+	if got.Int64() != 22 {
+		t.Errorf("%T %+v want %+v", got, got.Int64(), 22)
+	}
+}
+
+func TestNewWeight(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []int64{0, 1, 1000} {
+		// When
+		v, err := measure.NewWeight(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if v.Int64() != value {
+			t.Errorf("%T %+v want %+v", v, v, value)
+		}
+	}
+}
+
+func TestNewWeightFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := measure.NewWeight(-1)
+
+	// Then
+	if !errors.Is(err, measure.ErrInvalidWeight) {
+		t.Errorf("%T = %v, want %v", err, err, measure.ErrInvalidWeight)
+	}
+}
+
+func TestWeightAddTimes(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a, _ := measure.NewWeight(250)
+	b, _ := measure.NewWeight(1)
+
+	// When
+	got := a.Times(2).Add(b)
+
+	// Then
+	if got.Int64() != 501 {
+		t.Errorf("%T %+v want %+v", got, got.Int64(), 501)
+	}
+}
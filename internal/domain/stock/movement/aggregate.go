@@ -0,0 +1,43 @@
+package movement
+
+import (
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/serial"
+)
+
+// Aggregate is an entry of the stock ledger. Entries are never changed once they are recorded.
+type Aggregate struct {
+	Id         Id
+	ItemId     item.Id
+	LocationId location.Id
+	Kind       Kind
+	Quantity   Quantity
+	// Lot is the lot of the item the quantity belongs to, zero when the item is not lot tracked.
+	Lot lot.Number
+	// Serials are the units of the item the quantity is made of, empty when the item is not serialized.
+	Serials []serial.Number
+}
+
+func NewAggregate(id Id, itemId item.Id, locationId location.Id, kind Kind, quantity Quantity) *Aggregate {
+	return &Aggregate{
+		Id:         id,
+		ItemId:     itemId,
+		LocationId: locationId,
+		Kind:       kind,
+		Quantity:   quantity,
+	}
+}
+
+func RestoreAggregate(id Id, itemId item.Id, locationId location.Id, kind Kind, quantity Quantity, lotNumber lot.Number, serials []serial.Number) *Aggregate {
+	return &Aggregate{
+		Id:         id,
+		ItemId:     itemId,
+		LocationId: locationId,
+		Kind:       kind,
+		Quantity:   quantity,
+		Lot:        lotNumber,
+		Serials:    serials,
+	}
+}
@@ -0,0 +1,60 @@
+package movement_test
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+)
+
+func TestNewAggregate(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	id, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quantity, err := movement.NewQuantity(movement.Issue, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	a := movement.NewAggregate(id, itemId, locationId, movement.Issue, quantity)
+
+	// Then
+	if a.Id != id {
+		t.Errorf("%T %+v want %+v", a.Id, a.Id, id)
+	}
+
+	if a.ItemId != itemId {
+		t.Errorf("%T %+v want %+v", a.ItemId, a.ItemId, itemId)
+	}
+
+	if a.LocationId != locationId {
+		t.Errorf("%T %+v want %+v", a.LocationId, a.LocationId, locationId)
+	}
+
+	if a.Kind != movement.Issue {
+		t.Errorf("%T %+v want %+v", a.Kind, a.Kind, movement.Issue)
+	}
+
+	if a.Quantity != quantity {
+		t.Errorf("%T %+v want %+v", a.Quantity, a.Quantity, quantity)
+	}
+}
@@ -0,0 +1,61 @@
+package movement
+
+import (
+	"errors"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+)
+
+var ErrInsufficientQuantity = failure.Conflict("insufficient_quantity", "insufficient quantity on hand")
+
+// Balance is the quantity on hand of an item in a location, derived from the ledger.
+// The stock of a lot tracked item is balanced per lot.
+type Balance struct {
+	ItemId     item.Id
+	LocationId location.Id
+	Lot        lot.Number
+	Quantity   int64
+}
+
+// Apply returns the balance after the movement. The balance may only go below zero
+// when the location allows negative stock.
+func (b Balance) Apply(m *Aggregate, l *location.Aggregate) (Balance, error) {
+	if m.ItemId != b.ItemId || m.LocationId != b.LocationId || m.Lot != b.Lot || l.Id != b.LocationId {
+		return b, errors.New("Apply: movement does not belong to the balance")
+	}
+
+	after := Balance{
+		ItemId:     b.ItemId,
+		LocationId: b.LocationId,
+		Lot:        b.Lot,
+		Quantity:   b.Quantity + m.Quantity.Int64(),
+	}
+
+	if after.Quantity < 0 && m.Quantity.Int64() < 0 && !l.AllowNegativeStock {
+		return b, ErrInsufficientQuantity
+	}
+
+	return after, nil
+}
+
+// LoadOf returns what the balances put in their location. The volume and weight of a balance come from
+// its item in items, and a balance whose item is missing there counts by its units alone.
+// A balance at or below zero holds nothing.
+func LoadOf(balances []Balance, items map[item.Id]*item.Aggregate) location.Load {
+	l := location.Load{}
+	for _, b := range balances {
+		if b.Quantity <= 0 {
+			continue
+		}
+
+		l.Units += b.Quantity
+		if i, ok := items[b.ItemId]; ok {
+			l.Volume = l.Volume.Add(i.UnitVolume.Times(b.Quantity))
+			l.Weight = l.Weight.Add(i.UnitWeight.Times(b.Quantity))
+		}
+	}
+	return l
+}
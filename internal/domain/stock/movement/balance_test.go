@@ -0,0 +1,192 @@
+package movement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+)
+
+func newMovement(t *testing.T, itemId item.Id, locationId location.Id, kind movement.Kind, value int64) *movement.Aggregate {
+	t.Helper()
+
+	id, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quantity, err := movement.NewQuantity(kind, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return movement.NewAggregate(id, itemId, locationId, kind, quantity)
+}
+
+func newLocation(t *testing.T, allowNegativeStock bool) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.RestoreAggregate(id, name, location.Warehouse, location.Capacity{}, nil, false, allowNegativeStock, 1)
+}
+
+func TestBalanceApply(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := newLocation(t, false)
+	b := movement.Balance{ItemId: itemId, LocationId: l.Id, Quantity: 5}
+
+	// When
+	received, err := b.Apply(newMovement(t, itemId, l.Id, movement.Receipt, 3), l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issued, err := received.Apply(newMovement(t, itemId, l.Id, movement.Issue, 8), l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if received.Quantity != 8 {
+		t.Errorf("%T %+v want %+v", received.Quantity, received.Quantity, 8)
+	}
+
+	if issued.Quantity != 0 {
+		t.Errorf("%T %+v want %+v", issued.Quantity, issued.Quantity, 0)
+	}
+}
+
+func TestBalanceApplyFailInsufficientQuantity(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := newLocation(t, false)
+	b := movement.Balance{ItemId: itemId, LocationId: l.Id, Quantity: 5}
+
+	// When
+	after, err := b.Apply(newMovement(t, itemId, l.Id, movement.Adjustment, -6), l)
+
+	// Then
+	if !errors.Is(err, movement.ErrInsufficientQuantity) {
+		t.Fatalf("%T %+v want %+v", err, err, movement.ErrInsufficientQuantity)
+	}
+
+	if after != b {
+		t.Errorf("%T %+v want %+v", after, after, b)
+	}
+}
+
+func TestBalanceApplyAllowNegativeStock(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := newLocation(t, true)
+	b := movement.Balance{ItemId: itemId, LocationId: l.Id, Quantity: 0}
+
+	// When
+	after, err := b.Apply(newMovement(t, itemId, l.Id, movement.Issue, 2), l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if after.Quantity != -2 {
+		t.Errorf("%T %+v want %+v", after.Quantity, after.Quantity, -2)
+	}
+}
+
+func TestBalanceApplyFailOtherLocation(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := newLocation(t, false)
+	other := newLocation(t, false)
+	b := movement.Balance{ItemId: itemId, LocationId: l.Id, Quantity: 0}
+
+	// When
+	_, err = b.Apply(newMovement(t, itemId, other.Id, movement.Receipt, 2), l)
+
+	// Then
+	if err == nil {
+		t.Fatal("expected error but returned nil")
+	}
+}
+
+func TestLoadOf(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	l := newLocation(t, true)
+	measured := newItem(t, 100, 20)
+	unmeasured := newItem(t, 0, 0)
+	negative := newItem(t, 1000, 1000)
+
+	balances := []movement.Balance{
+		{ItemId: measured.Id, LocationId: l.Id, Quantity: 3},
+		{ItemId: unmeasured.Id, LocationId: l.Id, Quantity: 5},
+		{ItemId: negative.Id, LocationId: l.Id, Quantity: -2},
+	}
+	items := map[item.Id]*item.Aggregate{measured.Id: measured, negative.Id: negative}
+
+	// When
+	got := movement.LoadOf(balances, items)
+
+	// Then
+	if got.Units != 8 || got.Volume.Int64() != 300 || got.Weight.Int64() != 60 {
+		t.Errorf("%T %+v want units 8, volume 300 and weight 60", got, got)
+	}
+}
+
+func TestBalanceApplyFailOtherLot(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := newLocation(t, false)
+	b := movement.Balance{ItemId: itemId, LocationId: l.Id, Lot: newLotNumber(t, "L1"), Quantity: 5}
+
+	m := newMovement(t, itemId, l.Id, movement.Issue, 2)
+	m.Lot = newLotNumber(t, "L2")
+
+	// When
+	_, err = b.Apply(m, l)
+
+	// Then
+	if err == nil {
+		t.Fatal("expected error but returned nil")
+	}
+}
@@ -0,0 +1,81 @@
+package movement
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+)
+
+var (
+	ErrLotRequired   = failure.Validation("stock_lot_required", "stock item is lot tracked and needs a lot")
+	ErrNotLotTracked = failure.Validation("stock_item_not_lot_tracked", "stock item is not lot tracked and takes no lot")
+)
+
+// CheckLot fails with ErrLotRequired when m has no lot although its item is lot tracked,
+// and with ErrNotLotTracked when m has one although its item is not.
+func CheckLot(i *item.Aggregate, m *Aggregate) error {
+	if m.ItemId != i.Id {
+		return errors.New("CheckLot: movement does not belong to the item")
+	}
+
+	if i.LotTracked && m.Lot.IsZero() {
+		return ErrLotRequired
+	}
+
+	if !i.LotTracked && !m.Lot.IsZero() {
+		return fmt.Errorf("CheckLot: %w", ErrNotLotTracked.With("lot", m.Lot.String()))
+	}
+
+	return nil
+}
+
+// Pick is a quantity to take out of a lot.
+type Pick struct {
+	Lot      *lot.Aggregate
+	Quantity int64
+}
+
+// SuggestFefo picks quantity out of the balances of a lot tracked item in a location first expired first out,
+// in the order of lot.ExpiresFirst. The lots expired before today are left out, as they are not to be issued,
+// and so are the balances without a lot in lots. It fails with ErrInsufficientQuantity when the other lots
+// hold less than quantity.
+func SuggestFefo(balances []Balance, lots []*lot.Aggregate, quantity int64, today lot.Date) ([]Pick, error) {
+	if quantity <= 0 {
+		return nil, fmt.Errorf("SuggestFefo: invalid quantity %+v", quantity)
+	}
+
+	onHand := map[lot.Number]int64{}
+	for _, b := range balances {
+		onHand[b.Lot] += b.Quantity
+	}
+
+	sorted := append([]*lot.Aggregate(nil), lots...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return lot.ExpiresFirst(sorted[i], sorted[j])
+	})
+
+	picks := []Pick{}
+	left := quantity
+	for _, l := range sorted {
+		if left == 0 {
+			break
+		}
+		if l.IsExpired(today) || onHand[l.Number] <= 0 {
+			continue
+		}
+
+		p := Pick{Lot: l, Quantity: min(left, onHand[l.Number])}
+		picks = append(picks, p)
+		left -= p.Quantity
+	}
+
+	if left > 0 {
+		return nil, ErrInsufficientQuantity
+	}
+
+	return picks, nil
+}
@@ -0,0 +1,176 @@
+package movement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+)
+
+func newLotNumber(t *testing.T, v string) lot.Number {
+	t.Helper()
+
+	n, err := lot.NewNumber(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func newLot(t *testing.T, itemId item.Id, number string, expiresOn string) *lot.Aggregate {
+	t.Helper()
+
+	id, err := lot.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var d lot.Date
+	if expiresOn != "" {
+		d, err = lot.ParseDate(expiresOn)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	a, err := lot.NewAggregate(id, itemId, newLotNumber(t, number), lot.Date{}, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func TestCheckLot(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	tracked := newItem(t, 0, 0)
+	tracked.LotTracked = true
+	untracked := newItem(t, 0, 0)
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	withLot := func(i *item.Aggregate, number string) *movement.Aggregate {
+		m := newMovement(t, i.Id, locationId, movement.Receipt, 1)
+		if number != "" {
+			m.Lot = newLotNumber(t, number)
+		}
+		return m
+	}
+
+	tests := []struct {
+		item *item.Aggregate
+		lot  string
+		want error
+	}{
+		{tracked, "L1", nil},
+		{tracked, "", movement.ErrLotRequired},
+		{untracked, "", nil},
+		{untracked, "L1", movement.ErrNotLotTracked},
+	}
+
+	for _, tt := range tests {
+		// When
+		err := movement.CheckLot(tt.item, withLot(tt.item, tt.lot))
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%+v: %T %+v want %+v", tt, err, err, tt.want)
+		}
+	}
+}
+
+func TestSuggestFefo(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired := newLot(t, itemId, "E", "2024-06-30")
+	today := newLot(t, itemId, "T", "2024-07-01")
+	later := newLot(t, itemId, "L", "2024-12-31")
+	never := newLot(t, itemId, "N", "")
+	empty := newLot(t, itemId, "Z", "2024-07-02")
+
+	balances := []movement.Balance{
+		{ItemId: itemId, LocationId: locationId, Lot: never.Number, Quantity: 10},
+		{ItemId: itemId, LocationId: locationId, Lot: later.Number, Quantity: 4},
+		{ItemId: itemId, LocationId: locationId, Lot: today.Number, Quantity: 2},
+		{ItemId: itemId, LocationId: locationId, Lot: expired.Number, Quantity: 5},
+		{ItemId: itemId, LocationId: locationId, Quantity: 7},
+	}
+	day, err := lot.ParseDate("2024-07-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	picks, err := movement.SuggestFefo(balances, []*lot.Aggregate{never, later, empty, today, expired}, 9, day)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	want := []movement.Pick{{Lot: today, Quantity: 2}, {Lot: later, Quantity: 4}, {Lot: never, Quantity: 3}}
+	if len(picks) != len(want) {
+		t.Fatalf("%T %+v want %+v", picks, picks, want)
+	}
+
+	for i := range want {
+		if picks[i].Lot != want[i].Lot || picks[i].Quantity != want[i].Quantity {
+			t.Errorf("%T %+v want %+v", picks[i], picks[i], want[i])
+		}
+	}
+}
+
+func TestSuggestFefoFail(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expired := newLot(t, itemId, "E", "2024-06-30")
+	valid := newLot(t, itemId, "V", "2024-12-31")
+	balances := []movement.Balance{
+		{ItemId: itemId, LocationId: locationId, Lot: expired.Number, Quantity: 5},
+		{ItemId: itemId, LocationId: locationId, Lot: valid.Number, Quantity: 2},
+	}
+	day, err := lot.ParseDate("2024-07-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, insufficientErr := movement.SuggestFefo(balances, []*lot.Aggregate{expired, valid}, 3, day)
+	_, invalidErr := movement.SuggestFefo(balances, []*lot.Aggregate{expired, valid}, 0, day)
+
+	// Then
+	if !errors.Is(insufficientErr, movement.ErrInsufficientQuantity) {
+		t.Errorf("%T %+v want %+v", insufficientErr, insufficientErr, movement.ErrInsufficientQuantity)
+	}
+
+	if invalidErr == nil {
+		t.Error("expected error but returned nil")
+	}
+}
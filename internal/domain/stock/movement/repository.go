@@ -0,0 +1,91 @@
+package movement
+
+import (
+	"context"
+	"errors"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/serial"
+)
+
+// BalanceCursor points at the last balance of a page. Balances are ordered by item, location and lot,
+// the stock without lot coming first.
+type BalanceCursor struct {
+	ItemId     item.Id
+	LocationId location.Id
+	Lot        lot.Number
+}
+
+type BalanceQuery struct {
+	ItemId     *item.Id
+	LocationId *location.Id
+	// LocationIds limits the balances to any of the locations when it is not nil.
+	LocationIds []location.Id
+	After       *BalanceCursor
+	// Limit is the most balances returned, all of them when zero.
+	Limit int
+}
+
+// IRepository stores the ledger. Movements can only be appended.
+type IRepository interface {
+	Save(ctx context.Context, a *Aggregate) error
+	// GetBalance returns the balance of the lot of the item in the location, or of the stock without lot
+	// when lotNumber is zero.
+	GetBalance(ctx context.Context, itemId item.Id, locationId location.Id, lotNumber lot.Number) (Balance, error)
+	// ListBalances returns the non-zero balances, one per item, location and lot, ordered by item, location and lot.
+	ListBalances(ctx context.Context, q BalanceQuery) ([]Balance, error)
+	// ListBySerial returns the movements that name the serial of the item, in the order they were recorded.
+	ListBySerial(ctx context.Context, itemId item.Id, number serial.Number) ([]*Aggregate, error)
+	// FindByLocation reports whether any movement has been recorded in the location, whatever balance they leave.
+	FindByLocation(ctx context.Context, locationId location.Id) (bool, error)
+}
+
+// CheckCapacity fails with location.ErrOverCapacity when m brings more into l than the capacity of l leaves room for.
+// Only receipts and transfers in are checked: an adjustment records what a count has found, and what is taken out
+// only makes room. The stock of the locations below l does not count towards its capacity.
+func CheckCapacity(ctx context.Context, r IRepository, items item.IRepository, l *location.Aggregate, m *Aggregate) error {
+	if (m.Kind != Receipt && m.Kind != TransferIn) || l.Capacity.IsUnlimited() {
+		return nil
+	}
+
+	if m.LocationId != l.Id {
+		return errors.New("CheckCapacity: movement does not belong to the location")
+	}
+
+	balances, err := r.ListBalances(ctx, BalanceQuery{LocationId: &l.Id})
+	if err != nil {
+		return err
+	}
+
+	after := make([]Balance, 0, len(balances)+1)
+	moved := false
+	for _, b := range balances {
+		if b.ItemId == m.ItemId && b.Lot == m.Lot {
+			b.Quantity += m.Quantity.Int64()
+			moved = true
+		}
+		after = append(after, b)
+	}
+	if !moved {
+		after = append(after, Balance{ItemId: m.ItemId, LocationId: l.Id, Lot: m.Lot, Quantity: m.Quantity.Int64()})
+	}
+
+	measured := map[item.Id]*item.Aggregate{}
+	if l.Capacity.LimitsMeasures() {
+		for _, b := range after {
+			if b.Quantity <= 0 {
+				continue
+			}
+
+			i, err := items.Get(ctx, b.ItemId)
+			if err != nil {
+				return err
+			}
+			measured[b.ItemId] = i
+		}
+	}
+
+	return l.Capacity.Check(LoadOf(after, measured))
+}
@@ -0,0 +1,143 @@
+package movement_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/measure"
+	"openapi/internal/domain/stock/movement"
+)
+
+// balanceRepository holds the balances of one location.
+type balanceRepository struct {
+	movement.IRepository
+	balances []movement.Balance
+}
+
+func (r balanceRepository) ListBalances(_ context.Context, q movement.BalanceQuery) ([]movement.Balance, error) {
+	bs := []movement.Balance{}
+	for _, b := range r.balances {
+		if q.LocationId == nil || b.LocationId == *q.LocationId {
+			bs = append(bs, b)
+		}
+	}
+	return bs, nil
+}
+
+// itemRepository holds the items by id.
+type itemRepository struct {
+	item.IRepository
+	items map[item.Id]*item.Aggregate
+}
+
+func (r itemRepository) Get(_ context.Context, id item.Id) (*item.Aggregate, error) {
+	a, ok := r.items[id]
+	if !ok {
+		return &item.Aggregate{}, item.ErrNotFound
+	}
+	return a, nil
+}
+
+func newItem(t *testing.T, unitVolume, unitWeight int64) *item.Aggregate {
+	t.Helper()
+
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	volume, err := measure.NewVolume(unitVolume)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	weight, err := measure.NewWeight(unitWeight)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item.RestoreAggregate(id, item.Sku{}, name, item.Each, nil, nil, volume, weight, false, false, false, 1)
+}
+
+func newLimitedLocation(t *testing.T, units, volume, weight *int64) *location.Aggregate {
+	t.Helper()
+
+	l := newLocation(t, false)
+
+	capacity, err := location.NewCapacity(units, volume, weight)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.Capacity = capacity
+
+	return l
+}
+
+func TestCheckCapacity(t *testing.T) {
+	t.Parallel()
+
+	ten, thousand := int64(10), int64(1000)
+	stored := newItem(t, 100, 0)
+	received := newItem(t, 100, 0)
+	items := itemRepository{items: map[item.Id]*item.Aggregate{stored.Id: stored, received.Id: received}}
+
+	for _, tt := range []struct {
+		name     string
+		location *location.Aggregate
+		stored   int64
+		kind     movement.Kind
+		quantity int64
+		want     error
+	}{
+		{"unlimited", newLocation(t, false), 100, movement.Receipt, 100, nil},
+		{"units up to the limit", newLimitedLocation(t, &ten, nil, nil), 4, movement.Receipt, 6, nil},
+		{"units over the limit", newLimitedLocation(t, &ten, nil, nil), 4, movement.Receipt, 7, location.ErrOverCapacity},
+		{"transfer in over the limit", newLimitedLocation(t, &ten, nil, nil), 4, movement.TransferIn, 7, location.ErrOverCapacity},
+		{"issue while over the limit", newLimitedLocation(t, &ten, nil, nil), 20, movement.Issue, 1, nil},
+		{"adjustment over the limit", newLimitedLocation(t, &ten, nil, nil), 4, movement.Adjustment, 7, nil},
+		{"volume up to the limit", newLimitedLocation(t, nil, &thousand, nil), 4, movement.Receipt, 6, nil},
+		{"volume over the limit", newLimitedLocation(t, nil, &thousand, nil), 4, movement.Receipt, 7, location.ErrOverCapacity},
+	} {
+		// Given
+		r := balanceRepository{balances: []movement.Balance{
+			{ItemId: stored.Id, LocationId: tt.location.Id, Quantity: tt.stored},
+		}}
+		m := newMovement(t, received.Id, tt.location.Id, tt.kind, tt.quantity)
+
+		// When
+		err := movement.CheckCapacity(context.Background(), r, items, tt.location, m)
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%s: %T = %v, want %v", tt.name, err, err, tt.want)
+		}
+	}
+}
+
+func TestCheckCapacityOffsetsNegativeBalance(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	ten := int64(10)
+	l := newLimitedLocation(t, &ten, nil, nil)
+	i := newItem(t, 0, 0)
+	r := balanceRepository{balances: []movement.Balance{{ItemId: i.Id, LocationId: l.Id, Quantity: -5}}}
+
+	// When
+	err := movement.CheckCapacity(context.Background(), r, itemRepository{}, l, newMovement(t, i.Id, l.Id, movement.Receipt, 15))
+
+	// Then
+	if err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
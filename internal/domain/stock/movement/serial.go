@@ -0,0 +1,53 @@
+package movement
+
+import (
+	"errors"
+	"fmt"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/serial"
+)
+
+var (
+	ErrSerialCount   = failure.Validation("stock_serial_count_mismatch", "stock item is serialized and needs one serial per unit")
+	ErrNotSerialized = failure.Validation("stock_item_not_serialized", "stock item is not serialized and takes no serials")
+)
+
+// CheckSerials fails with ErrSerialCount when m does not name one serial per unit it moves although its item
+// is serialized, and with ErrNotSerialized when m names serials although its item is not.
+func CheckSerials(i *item.Aggregate, m *Aggregate) error {
+	if m.ItemId != i.Id {
+		return errors.New("CheckSerials: movement does not belong to the item")
+	}
+
+	if !i.Serialized {
+		if len(m.Serials) > 0 {
+			return fmt.Errorf("CheckSerials: %w %+v", ErrNotSerialized, m.Serials)
+		}
+		return nil
+	}
+
+	count := m.Quantity.Int64()
+	if count < 0 {
+		count = -count
+	}
+	if int64(len(m.Serials)) != count {
+		return fmt.Errorf("CheckSerials: %w", ErrSerialCount.With("serials", len(m.Serials)).With("quantity", count))
+	}
+
+	return nil
+}
+
+// ApplySerial moves s the way m does: into the location of m when m brings quantity in,
+// and out of it when m takes quantity out.
+func ApplySerial(s *serial.Aggregate, m *Aggregate) error {
+	if s.ItemId != m.ItemId {
+		return errors.New("ApplySerial: serial does not belong to the item of the movement")
+	}
+
+	if m.Quantity.Int64() > 0 {
+		return s.Receive(m.LocationId)
+	}
+	return s.Issue(m.LocationId)
+}
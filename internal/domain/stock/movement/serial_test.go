@@ -0,0 +1,112 @@
+package movement_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+)
+
+func newSerialNumbers(t *testing.T, vs ...string) []serial.Number {
+	t.Helper()
+
+	ns, err := serial.NewNumbers(vs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ns
+}
+
+func TestCheckSerials(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	serialized := newItem(t, 0, 0)
+	serialized.Serialized = true
+	plain := newItem(t, 0, 0)
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		item     *item.Aggregate
+		kind     movement.Kind
+		quantity int64
+		serials  []string
+		want     error
+	}{
+		{serialized, movement.Receipt, 2, []string{"S1", "S2"}, nil},
+		{serialized, movement.Issue, 1, []string{"S1"}, nil},
+		{serialized, movement.Adjustment, -2, []string{"S1", "S2"}, nil},
+		{serialized, movement.Receipt, 2, []string{"S1"}, movement.ErrSerialCount},
+		{serialized, movement.Issue, 1, nil, movement.ErrSerialCount},
+		{plain, movement.Receipt, 2, nil, nil},
+		{plain, movement.Receipt, 1, []string{"S1"}, movement.ErrNotSerialized},
+	}
+
+	for _, tt := range tests {
+		m := newMovement(t, tt.item.Id, locationId, tt.kind, tt.quantity)
+		m.Serials = newSerialNumbers(t, tt.serials...)
+
+		// When
+		err := movement.CheckSerials(tt.item, m)
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%+v: %T %+v want %+v", tt, err, err, tt.want)
+		}
+	}
+}
+
+func TestApplySerial(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	i := newItem(t, 0, 0)
+	from, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id, err := serial.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := serial.NewAggregate(id, i.Id, newSerialNumbers(t, "S1")[0])
+
+	// When
+	receiptErr := movement.ApplySerial(s, newMovement(t, i.Id, from, movement.Receipt, 1))
+	againErr := movement.ApplySerial(s, newMovement(t, i.Id, to, movement.Receipt, 1))
+	outErr := movement.ApplySerial(s, newMovement(t, i.Id, from, movement.TransferOut, 1))
+	inErr := movement.ApplySerial(s, newMovement(t, i.Id, to, movement.TransferIn, 1))
+	wrongErr := movement.ApplySerial(s, newMovement(t, i.Id, from, movement.Issue, 1))
+
+	// Then
+	for _, err := range []error{receiptErr, outErr, inErr} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if !errors.Is(againErr, serial.ErrInStock) {
+		t.Errorf("%T %+v want %+v", againErr, againErr, serial.ErrInStock)
+	}
+
+	if !errors.Is(wrongErr, serial.ErrNotInLocation) {
+		t.Errorf("%T %+v want %+v", wrongErr, wrongErr, serial.ErrNotInLocation)
+	}
+
+	if got, ok := s.LocationId(); !ok || got != to {
+		t.Errorf("%T %+v want %+v", got, got, to)
+	}
+}
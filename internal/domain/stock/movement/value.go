@@ -0,0 +1,70 @@
+package movement
+
+import "fmt"
+
+type Kind struct {
+	string
+}
+
+var (
+	// Receipt brings quantity into a location.
+	Receipt = Kind{"receipt"}
+	// Issue takes quantity out of a location.
+	Issue = Kind{"issue"}
+	// Adjustment corrects the quantity of a location in either direction, e.g. after a stock count.
+	Adjustment = Kind{"adjustment"}
+	// TransferOut takes quantity out of the source location of a transfer.
+	TransferOut = Kind{"transfer_out"}
+	// TransferIn brings quantity into the destination location of a transfer.
+	TransferIn = Kind{"transfer_in"}
+)
+
+func NewKind(v string) (Kind, error) {
+	for _, k := range []Kind{Receipt, Issue, Adjustment, TransferOut, TransferIn} {
+		if k.string == v {
+			return k, nil
+		}
+	}
+	return Kind{}, fmt.Errorf("NewKind: invalid kind %+v", v)
+}
+
+func (v Kind) String() string {
+	return v.string
+}
+
+// IsTransfer reports whether the movement is one side of a transfer between locations.
+func (v Kind) IsTransfer() bool {
+	return v == TransferOut || v == TransferIn
+}
+
+// Quantity is the signed change a movement makes to the balance of its item in its location.
+type Quantity struct {
+	int64
+}
+
+func NewQuantity(kind Kind, v int64) (Quantity, error) {
+	switch kind {
+	case Receipt, TransferIn:
+		if v > 0 {
+			return Quantity{v}, nil
+		}
+	case Issue, TransferOut:
+		if v > 0 {
+			return Quantity{-v}, nil
+		}
+	case Adjustment:
+		if v != 0 {
+			return Quantity{v}, nil
+		}
+	}
+	return Quantity{}, fmt.Errorf("NewQuantity: invalid quantity %+v for %s", v, kind)
+}
+
+// RestoreQuantity returns the signed quantity of a movement that has been recorded.
+func RestoreQuantity(v int64) Quantity {
+	return Quantity{v}
+}
+
+func (v Quantity) Int64() int64 {
+	return v.int64
+}
@@ -0,0 +1,118 @@
+package movement_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/movement"
+)
+
+func TestNewKind(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"receipt", "issue", "adjustment", "transfer_out", "transfer_in"} {
+		// When
+		kind, err := movement.NewKind(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if kind.String() != value {
+			t.Errorf("%T %+v want %+v", kind, kind, value)
+		}
+	}
+}
+
+func TestNewKindFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := movement.NewKind("transfer")
+
+	// Then
+	if err == nil {
+		t.Fatal("expected error but returned nil")
+	}
+}
+
+func TestNewQuantity(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kind   movement.Kind
+		value  int64
+		expect int64
+	}{
+		{movement.Receipt, 3, 3},
+		{movement.Issue, 3, -3},
+		{movement.Adjustment, 3, 3},
+		{movement.Adjustment, -3, -3},
+		{movement.TransferOut, 3, -3},
+		{movement.TransferIn, 3, 3},
+	}
+
+	for _, tt := range tests {
+		// When
+		quantity, err := movement.NewQuantity(tt.kind, tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if quantity.Int64() != tt.expect {
+			t.Errorf("%s %+v want %+v", tt.kind, quantity.Int64(), tt.expect)
+		}
+	}
+}
+
+func TestNewQuantityFail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kind  movement.Kind
+		value int64
+	}{
+		{movement.Receipt, 0},
+		{movement.Receipt, -1},
+		{movement.Issue, 0},
+		{movement.Issue, -1},
+		{movement.Adjustment, 0},
+		{movement.TransferOut, 0},
+		{movement.TransferIn, -1},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := movement.NewQuantity(tt.kind, tt.value)
+
+		// Then
+		if err == nil {
+			t.Errorf("%s %+v expected error but returned nil", tt.kind, tt.value)
+		}
+	}
+}
+
+func TestKindIsTransfer(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kind   movement.Kind
+		expect bool
+	}{
+		{movement.Receipt, false},
+		{movement.Issue, false},
+		{movement.Adjustment, false},
+		{movement.TransferOut, true},
+		{movement.TransferIn, true},
+	}
+
+	for _, tt := range tests {
+		// When
+		actual := tt.kind.IsTransfer()
+
+		// Then
+		if actual != tt.expect {
+			t.Errorf("%s %+v want %+v", tt.kind, actual, tt.expect)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package serial
+
+import (
+	"fmt"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+)
+
+var (
+	ErrInStock       = failure.Conflict("stock_serial_in_stock", "stock serial is already in a stock location")
+	ErrNotInLocation = failure.Conflict("stock_serial_not_in_location", "stock serial is not in the stock location")
+)
+
+// Aggregate is a unit of a serialized item, registered by the first receipt of its number.
+// It is in one location at a time, or in none once it has been issued.
+type Aggregate struct {
+	Id     Id
+	ItemId item.Id
+	Number Number
+	// locationId is where the unit is, nil while it is not in stock.
+	locationId *location.Id
+}
+
+// NewAggregate returns a unit that is not in stock yet.
+func NewAggregate(id Id, itemId item.Id, number Number) *Aggregate {
+	return &Aggregate{
+		Id:     id,
+		ItemId: itemId,
+		Number: number,
+	}
+}
+
+func RestoreAggregate(id Id, itemId item.Id, number Number, locationId *location.Id) *Aggregate {
+	a := NewAggregate(id, itemId, number)
+	if locationId != nil {
+		l := *locationId
+		a.locationId = &l
+	}
+	return a
+}
+
+// LocationId returns where the unit is, and false while it is not in stock.
+func (a Aggregate) LocationId() (location.Id, bool) {
+	if a.locationId == nil {
+		return location.Id{}, false
+	}
+	return *a.locationId, true
+}
+
+// Receive brings the unit into l. It fails with ErrInStock when the unit is already in a location,
+// as it cannot be in two of them.
+func (a *Aggregate) Receive(l location.Id) error {
+	if a.locationId != nil {
+		return fmt.Errorf("Receive: %w in %+v", ErrInStock.With("serial", a.Number.String()), *a.locationId)
+	}
+
+	a.locationId = &l
+	return nil
+}
+
+// Issue takes the unit out of l. It fails with ErrNotInLocation when the unit is not there.
+func (a *Aggregate) Issue(l location.Id) error {
+	if a.locationId == nil || *a.locationId != l {
+		return fmt.Errorf("Issue: %w", ErrNotInLocation.With("serial", a.Number.String()))
+	}
+
+	a.locationId = nil
+	return nil
+}
@@ -0,0 +1,115 @@
+package serial_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/serial"
+)
+
+func newSerial(t *testing.T, locationId *location.Id) *serial.Aggregate {
+	t.Helper()
+
+	id, err := serial.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	number, err := serial.NewNumber("SN-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return serial.RestoreAggregate(id, itemId, number, locationId)
+}
+
+func newLocationId(t *testing.T) location.Id {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return id
+}
+
+func TestReceive(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	a := newSerial(t, nil)
+	l := newLocationId(t)
+
+	if _, ok := a.LocationId(); ok {
+		t.Fatalf("%T %+v must not be in stock", a, a)
+	}
+
+	// When
+	if err := a.Receive(l); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if got, ok := a.LocationId(); !ok || got != l {
+		t.Errorf("%T %+v want %+v", got, got, l)
+	}
+}
+
+func TestReceiveFailInStock(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	l := newLocationId(t)
+	a := newSerial(t, &l)
+
+	// When
+	err := a.Receive(newLocationId(t))
+
+	// Then
+	if !errors.Is(err, serial.ErrInStock) {
+		t.Errorf("%T %+v want %+v", err, err, serial.ErrInStock)
+	}
+
+	if got, _ := a.LocationId(); got != l {
+		t.Errorf("%T %+v want %+v", got, got, l)
+	}
+}
+
+func TestIssue(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	l := newLocationId(t)
+	a := newSerial(t, &l)
+
+	// When
+	otherErr := a.Issue(newLocationId(t))
+	err := a.Issue(l)
+	againErr := a.Issue(l)
+
+	// Then
+	if !errors.Is(otherErr, serial.ErrNotInLocation) {
+		t.Errorf("%T %+v want %+v", otherErr, otherErr, serial.ErrNotInLocation)
+	}
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, ok := a.LocationId(); ok {
+		t.Errorf("%T %+v must not be in stock", a, a)
+	}
+
+	if !errors.Is(againErr, serial.ErrNotInLocation) {
+		t.Errorf("%T %+v want %+v", againErr, againErr, serial.ErrNotInLocation)
+	}
+}
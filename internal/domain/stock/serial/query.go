@@ -0,0 +1,11 @@
+package serial
+
+import "openapi/internal/domain/stock/item"
+
+// ListQuery selects serials ordered by item and number.
+type ListQuery struct {
+	// Number limits the list to the serials with the number, one per item at most.
+	Number *Number
+	// ItemId limits the list to the serials of the item.
+	ItemId *item.Id
+}
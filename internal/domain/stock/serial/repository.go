@@ -0,0 +1,25 @@
+package serial
+
+import (
+	"context"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+)
+
+var (
+	ErrNotFound    = failure.NotFound("stock_serial_not_found", "stock serial not found")
+	ErrNumberTaken = failure.Conflict("stock_serial_number_taken", "the stock item already has a serial with the number")
+)
+
+type IRepository interface {
+	// Save records a new serial or where a recorded one is. ErrNumberTaken is returned when
+	// the item already has another serial with the number.
+	Save(ctx context.Context, a *Aggregate) error
+	// Get returns ErrNotFound when the item has no serial with the number.
+	Get(ctx context.Context, itemId item.Id, number Number) (*Aggregate, error)
+	List(ctx context.Context, q ListQuery) ([]*Aggregate, error)
+	// FindByLocation reports whether any serial is in the location.
+	FindByLocation(ctx context.Context, locationId location.Id) (bool, error)
+}
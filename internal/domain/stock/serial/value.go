@@ -0,0 +1,60 @@
+package serial
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"openapi/internal/domain/failure"
+)
+
+// NumberMaxLength is the most characters a serial number may have.
+const NumberMaxLength = 64
+
+// Number identifies a single unit among the units of an item, as printed on it.
+type Number struct {
+	string
+}
+
+var (
+	ErrInvalidNumber   = failure.Validation("invalid_stock_serial_number", "invalid stock serial number")
+	ErrDuplicateNumber = failure.Validation("duplicate_stock_serial_number", "stock serial number is given more than once")
+)
+
+// NewNumber trims the surrounding white space of v, and fails unless the rest is 1 to NumberMaxLength
+// characters without control characters. Serial numbers are kept in the case they are printed in.
+func NewNumber(v string) (Number, error) {
+	s := strings.TrimSpace(v)
+	if s == "" || utf8.RuneCountInString(s) > NumberMaxLength || strings.IndexFunc(s, unicode.IsControl) >= 0 {
+		return Number{}, fmt.Errorf("NewNumber: %w", ErrInvalidNumber.With("serial", v))
+	}
+	return Number{s}, nil
+}
+
+// NewNumbers returns the numbers of vs in their order, and fails with ErrDuplicateNumber when
+// two of them are the same number, as one unit cannot be counted twice.
+func NewNumbers(vs []string) ([]Number, error) {
+	ns := make([]Number, 0, len(vs))
+	seen := map[Number]bool{}
+	for _, v := range vs {
+		n, err := NewNumber(v)
+		if err != nil {
+			return nil, err
+		}
+		if seen[n] {
+			return nil, fmt.Errorf("NewNumbers: %w", ErrDuplicateNumber.With("serial", n.String()))
+		}
+		seen[n] = true
+		ns = append(ns, n)
+	}
+	return ns, nil
+}
+
+func (v Number) IsZero() bool {
+	return v.string == ""
+}
+
+func (v Number) String() string {
+	return v.string
+}
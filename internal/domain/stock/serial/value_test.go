@@ -0,0 +1,99 @@
+package serial_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"openapi/internal/domain/stock/serial"
+)
+
+func TestNewNumber(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"SN-0001", "SN-0001"},
+		{" sn 7a ", "sn 7a"},
+		{strings.Repeat("ä", serial.NumberMaxLength), strings.Repeat("ä", serial.NumberMaxLength)},
+	}
+
+	for _, tt := range tests {
+		// When
+		number, err := serial.NewNumber(tt.value)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if number.String() != tt.want {
+			t.Errorf("%T %+v want %+v", number, number, tt.want)
+		}
+
+		if number.IsZero() {
+			t.Errorf("%+v must not be zero", number)
+		}
+	}
+}
+
+func TestNewNumberFail(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"", "  ", "S\n1", strings.Repeat("a", serial.NumberMaxLength+1)} {
+		// When
+		number, err := serial.NewNumber(value)
+
+		// Then
+		if !errors.Is(err, serial.ErrInvalidNumber) {
+			t.Errorf("%q: %T %+v want %+v", value, err, err, serial.ErrInvalidNumber)
+		}
+
+		if !number.IsZero() {
+			t.Errorf("%T %+v want zero", number, number)
+		}
+	}
+}
+
+func TestNewNumbers(t *testing.T) {
+	t.Parallel()
+
+	// When
+	numbers, err := serial.NewNumbers([]string{"SN-2", " SN-1", "sn-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	got := []string{}
+	for _, n := range numbers {
+		got = append(got, n.String())
+	}
+
+	if strings.Join(got, ",") != "SN-2,SN-1,sn-1" {
+		t.Errorf("%T %+v want %+v", got, got, "SN-2,SN-1,sn-1")
+	}
+}
+
+func TestNewNumbersFail(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		values []string
+		want   error
+	}{
+		{[]string{"SN-1", "SN-2", " SN-1 "}, serial.ErrDuplicateNumber},
+		{[]string{"SN-1", ""}, serial.ErrInvalidNumber},
+	}
+
+	for _, tt := range tests {
+		// When
+		_, err := serial.NewNumbers(tt.values)
+
+		// Then
+		if !errors.Is(err, tt.want) {
+			t.Errorf("%+v: %T %+v want %+v", tt.values, err, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package transaction
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transfer"
+)
+
+// IRepositories gives access to the repositories bound to a single transaction.
+type IRepositories interface {
+	Item() item.IRepository
+	Location() location.IRepository
+	Lot() lot.IRepository
+	Movement() movement.IRepository
+	Serial() serial.IRepository
+	Transfer() transfer.IRepository
+}
+
+// IUnitOfWork runs fn in a transaction. The transaction is committed when fn returns nil,
+// and rolled back when fn returns an error or panics or when ctx is done.
+type IUnitOfWork interface {
+	Do(ctx context.Context, fn func(r IRepositories) error) error
+}
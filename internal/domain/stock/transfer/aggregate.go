@@ -0,0 +1,76 @@
+package transfer
+
+import (
+	"errors"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+)
+
+var ErrSameLocation = errors.New("source and destination locations must differ")
+
+// Aggregate moves a quantity of an item from one location to another.
+// It is recorded in the ledger as a pair of movements that are saved together.
+type Aggregate struct {
+	Id     Id
+	ItemId item.Id
+	From   location.Id
+	To     location.Id
+	Out    *movement.Aggregate
+	In     *movement.Aggregate
+}
+
+func NewAggregate(id Id, itemId item.Id, from location.Id, to location.Id, quantity int64, outId movement.Id, inId movement.Id) (*Aggregate, error) {
+	if from == to {
+		return nil, ErrSameLocation
+	}
+
+	outQuantity, err := movement.NewQuantity(movement.TransferOut, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	inQuantity, err := movement.NewQuantity(movement.TransferIn, quantity)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Aggregate{
+		Id:     id,
+		ItemId: itemId,
+		From:   from,
+		To:     to,
+		Out:    movement.NewAggregate(outId, itemId, from, movement.TransferOut, outQuantity),
+		In:     movement.NewAggregate(inId, itemId, to, movement.TransferIn, inQuantity),
+	}, nil
+}
+
+// Quantity returns the quantity moved between the locations.
+func (a Aggregate) Quantity() int64 {
+	return a.In.Quantity.Int64()
+}
+
+// Lot returns the lot moved between the locations, zero when the item is not lot tracked.
+func (a Aggregate) Lot() lot.Number {
+	return a.In.Lot
+}
+
+// SetLot moves the stock of the lot, for an item that is lot tracked.
+func (a *Aggregate) SetLot(n lot.Number) {
+	a.Out.Lot = n
+	a.In.Lot = n
+}
+
+// Serials returns the units moved between the locations, empty when the item is not serialized.
+func (a Aggregate) Serials() []serial.Number {
+	return a.In.Serials
+}
+
+// SetSerials moves the units, for an item that is serialized.
+func (a *Aggregate) SetSerials(ns []serial.Number) {
+	a.Out.Serials = ns
+	a.In.Serials = ns
+}
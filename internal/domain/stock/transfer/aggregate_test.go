@@ -0,0 +1,176 @@
+package transfer_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transfer"
+)
+
+type ids struct {
+	id     transfer.Id
+	itemId item.Id
+	from   location.Id
+	to     location.Id
+	outId  movement.Id
+	inId   movement.Id
+}
+
+func newIds(t *testing.T) ids {
+	t.Helper()
+
+	id, err := transfer.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	to, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outId, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inId, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return ids{id, itemId, from, to, outId, inId}
+}
+
+func TestNewAggregate(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	v := newIds(t)
+
+	// When
+	a, err := transfer.NewAggregate(v.id, v.itemId, v.from, v.to, 4, v.outId, v.inId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if a.Quantity() != 4 {
+		t.Errorf("%T %+v want %+v", a.Quantity(), a.Quantity(), 4)
+	}
+
+	if a.Out.Id != v.outId || a.Out.ItemId != v.itemId || a.Out.LocationId != v.from || a.Out.Kind != movement.TransferOut {
+		t.Errorf("%T %+v", a.Out, a.Out)
+	}
+
+	if a.Out.Quantity.Int64() != -4 {
+		t.Errorf("%T %+v want %+v", a.Out.Quantity.Int64(), a.Out.Quantity.Int64(), -4)
+	}
+
+	if a.In.Id != v.inId || a.In.ItemId != v.itemId || a.In.LocationId != v.to || a.In.Kind != movement.TransferIn {
+		t.Errorf("%T %+v", a.In, a.In)
+	}
+
+	if a.In.Quantity.Int64() != 4 {
+		t.Errorf("%T %+v want %+v", a.In.Quantity.Int64(), a.In.Quantity.Int64(), 4)
+	}
+}
+
+func TestNewAggregateFailSameLocation(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	v := newIds(t)
+
+	// When
+	_, err := transfer.NewAggregate(v.id, v.itemId, v.from, v.from, 4, v.outId, v.inId)
+
+	// Then
+	if !errors.Is(err, transfer.ErrSameLocation) {
+		t.Fatalf("%T %+v want %+v", err, err, transfer.ErrSameLocation)
+	}
+}
+
+func TestNewAggregateFailInvalidQuantity(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	v := newIds(t)
+
+	for _, quantity := range []int64{0, -1} {
+		// When
+		_, err := transfer.NewAggregate(v.id, v.itemId, v.from, v.to, quantity, v.outId, v.inId)
+
+		// Then
+		if err == nil {
+			t.Errorf("%+v expected error but returned nil", quantity)
+		}
+	}
+}
+
+func TestSetLot(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	v := newIds(t)
+	a, err := transfer.NewAggregate(v.id, v.itemId, v.from, v.to, 4, v.outId, v.inId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	number, err := lot.NewNumber("L1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	a.SetLot(number)
+
+	// Then
+	if a.Lot() != number || a.Out.Lot != number || a.In.Lot != number {
+		t.Errorf("%+v %+v %+v want %+v", a.Lot(), a.Out.Lot, a.In.Lot, number)
+	}
+}
+
+func TestSetSerials(t *testing.T) {
+	t.Parallel()
+
+	// Given
+	v := newIds(t)
+	a, err := transfer.NewAggregate(v.id, v.itemId, v.from, v.to, 2, v.outId, v.inId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numbers, err := serial.NewNumbers([]string{"S1", "S2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	a.SetSerials(numbers)
+
+	// Then
+	for _, got := range [][]serial.Number{a.Serials(), a.Out.Serials, a.In.Serials} {
+		if len(got) != 2 || got[0] != numbers[0] || got[1] != numbers[1] {
+			t.Errorf("%T %+v want %+v", got, got, numbers)
+		}
+	}
+}
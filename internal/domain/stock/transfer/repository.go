@@ -0,0 +1,14 @@
+package transfer
+
+import (
+	"context"
+
+	"openapi/internal/domain/stock/location"
+)
+
+type IRepository interface {
+	// Save records the transfer together with its movements.
+	Save(ctx context.Context, a *Aggregate) error
+	// FindByLocation reports whether any transfer has been made from or to the location.
+	FindByLocation(ctx context.Context, locationId location.Id) (bool, error)
+}
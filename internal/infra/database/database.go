@@ -5,8 +5,12 @@ import (
 	"openapi/internal/infra/env"
 
 	_ "github.com/lib/pq"
+	_ "modernc.org/sqlite"
 )
 
+// Open returns a connection pool sized by the DB_MAX_OPEN_CONNS, DB_MAX_IDLE_CONNS
+// and DB_CONN_MAX_LIFETIME environment variables.
+// The pool is meant to be opened once and shared, so the caller closes it on shutdown.
 func Open() (*sql.DB, error) {
 	driverName := env.GetDbDriver()
 	dataSourceName := env.GetDbDataSourceName()
@@ -16,5 +20,9 @@ func Open() (*sql.DB, error) {
 		return nil, err
 	}
 
+	db.SetMaxOpenConns(env.GetDbMaxOpenConns())
+	db.SetMaxIdleConns(env.GetDbMaxIdleConns())
+	db.SetConnMaxLifetime(env.GetDbConnMaxLifetime())
+
 	return db, nil
 }
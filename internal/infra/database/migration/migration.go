@@ -0,0 +1,327 @@
+// Package migration applies the migrations embedded in the binary to the database.
+// The applied version is kept in the schema_migrations table the same way migrate/migrate keeps it,
+// so databases migrated with either of them can be migrated further with the other.
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+
+	migrations "openapi/scripts/migrate"
+	sqliteMigrations "openapi/scripts/migrate/sqlite"
+)
+
+var ErrDirty = errors.New("migration: the database is dirty after a failed migration and must be fixed by hand")
+
+type Migration struct {
+	Version uint64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status is whether a migration has been applied to the database.
+type Status struct {
+	Migration
+	Applied bool
+}
+
+var fileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Load reads the migrations from the files named like 000001_name.up.sql and 000001_name.down.sql
+// in fsys, ordered by version.
+func Load(fsys fs.FS) ([]Migration, error) {
+	files, err := fs.Glob(fsys, "*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := map[uint64]*Migration{}
+	for _, file := range files {
+		m := fileName.FindStringSubmatch(file)
+		if m == nil {
+			return nil, fmt.Errorf("Load: invalid migration file name %s", file)
+		}
+
+		version, err := strconv.ParseUint(m[1], 10, 64)
+		if err != nil || version == 0 {
+			return nil, fmt.Errorf("Load: invalid migration version %s", file)
+		}
+
+		query, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: m[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != m[2] {
+			return nil, fmt.Errorf("Load: migrations %s and %s have the same version", migration.Name, m[2])
+		}
+
+		if m[3] == "up" {
+			migration.Up = string(query)
+		} else {
+			migration.Down = string(query)
+		}
+	}
+
+	ms := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("Load: migration %d_%s has no up file", m.Version, m.Name)
+		}
+		ms = append(ms, *m)
+	}
+
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+
+	return ms, nil
+}
+
+// Migrator migrates a database of the driver, "postgres" or "sqlite", with the migrations of the driver.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+	// lock is run at the start of each migration so that concurrent migrators wait for each other.
+	lock string
+}
+
+func New(db *sql.DB, driver string) (*Migrator, error) {
+	if db == nil {
+		return nil, fmt.Errorf("New: db is nil")
+	}
+
+	var (
+		fsys fs.FS
+		lock string
+	)
+	switch driver {
+	case "postgres":
+		fsys, lock = migrations.FS, `LOCK TABLE "schema_migrations" IN EXCLUSIVE MODE`
+	case "sqlite":
+		// the write lock of SQLite is taken by the transactions of the DSN with _txlock=immediate
+		fsys = sqliteMigrations.FS
+	default:
+		return nil, fmt.Errorf("New: no migrations for driver %s", driver)
+	}
+
+	ms, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: ms,
+		lock:       lock,
+	}, nil
+}
+
+// Latest returns the version of the last migration, the one the binary expects.
+func (m *Migrator) Latest() uint64 {
+	if len(m.migrations) == 0 {
+		return 0
+	}
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+// Version returns the version of the last migration applied to the database, 0 when there is none.
+func (m *Migrator) Version(ctx context.Context) (uint64, bool, error) {
+	if err := m.init(ctx); err != nil {
+		return 0, false, err
+	}
+	return version(ctx, m.db)
+}
+
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	current, _, err := m.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ss := make([]Status, 0, len(m.migrations))
+	for _, migration := range m.migrations {
+		ss = append(ss, Status{Migration: migration, Applied: migration.Version <= current})
+	}
+	return ss, nil
+}
+
+// Check fails unless the database is at the latest version and clean.
+func (m *Migrator) Check(ctx context.Context) error {
+	current, dirty, err := m.Version(ctx)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return ErrDirty
+	}
+	if current != m.Latest() {
+		return fmt.Errorf("migration: the database is at version %d, want %d", current, m.Latest())
+	}
+	return nil
+}
+
+// Up applies the migrations after the version of the database, each in a transaction of its own,
+// and returns the ones it has applied.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	if err := m.init(ctx); err != nil {
+		return nil, err
+	}
+
+	applied := []Migration{}
+	for {
+		migration, done, err := m.step(ctx, func(current uint64) (*Migration, uint64) {
+			for i := range m.migrations {
+				if m.migrations[i].Version > current {
+					return &m.migrations[i], m.migrations[i].Version
+				}
+			}
+			return nil, current
+		}, func(migration *Migration) string {
+			return migration.Up
+		})
+		if err != nil {
+			return applied, err
+		}
+		if done {
+			return applied, nil
+		}
+		applied = append(applied, *migration)
+	}
+}
+
+// Down reverts the last n migrations applied to the database, each in a transaction of its own,
+// and returns the ones it has reverted.
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	if err := m.init(ctx); err != nil {
+		return nil, err
+	}
+
+	reverted := []Migration{}
+	for len(reverted) < n {
+		migration, done, err := m.step(ctx, func(current uint64) (*Migration, uint64) {
+			for i := len(m.migrations) - 1; i >= 0; i-- {
+				if m.migrations[i].Version == current {
+					if i == 0 {
+						return &m.migrations[i], 0
+					}
+					return &m.migrations[i], m.migrations[i-1].Version
+				}
+			}
+			return nil, current
+		}, func(migration *Migration) string {
+			return migration.Down
+		})
+		if err != nil {
+			return reverted, err
+		}
+		if done {
+			return reverted, nil
+		}
+		reverted = append(reverted, *migration)
+	}
+	return reverted, nil
+}
+
+// step runs the query of the migration next picks after the version of the database, and moves the database to the version next returns.
+// It is done when next picks no migration.
+func (m *Migrator) step(ctx context.Context, next func(current uint64) (*Migration, uint64), query func(*Migration) string) (*Migration, bool, error) {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback()
+
+	if m.lock != "" {
+		if _, err := tx.ExecContext(ctx, m.lock); err != nil {
+			return nil, false, err
+		}
+	}
+
+	current, dirty, err := version(ctx, tx)
+	if err != nil {
+		return nil, false, err
+	}
+	if dirty {
+		return nil, false, ErrDirty
+	}
+	if !m.known(current) {
+		return nil, false, fmt.Errorf("migration: the database is at version %d, which none of the migrations has", current)
+	}
+
+	migration, to := next(current)
+	if migration == nil {
+		return nil, true, nil
+	}
+
+	q := query(migration)
+	if q == "" {
+		return nil, false, fmt.Errorf("migration: %d_%s has no down file", migration.Version, migration.Name)
+	}
+
+	if _, err := tx.ExecContext(ctx, q); err != nil {
+		return nil, false, fmt.Errorf("migration: %d_%s: %w", migration.Version, migration.Name, err)
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM "schema_migrations"`); err != nil {
+		return nil, false, err
+	}
+
+	if to > 0 {
+		if _, err := tx.ExecContext(ctx, fmt.Sprintf(`INSERT INTO "schema_migrations" ("version", "dirty") VALUES (%d, FALSE)`, to)); err != nil {
+			return nil, false, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, false, err
+	}
+
+	return migration, false, nil
+}
+
+func (m *Migrator) known(version uint64) bool {
+	if version == 0 {
+		return true
+	}
+	for _, migration := range m.migrations {
+		if migration.Version == version {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Migrator) init(ctx context.Context) error {
+	_, err := m.db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS "schema_migrations" ("version" BIGINT NOT NULL PRIMARY KEY, "dirty" BOOLEAN NOT NULL)`)
+	return err
+}
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func version(ctx context.Context, db queryer) (uint64, bool, error) {
+	var (
+		v     uint64
+		dirty bool
+	)
+	err := db.QueryRowContext(ctx, `SELECT "version", "dirty" FROM "schema_migrations" LIMIT 1`).Scan(&v, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return v, dirty, nil
+}
@@ -0,0 +1,303 @@
+package migration_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	_ "modernc.org/sqlite"
+
+	"openapi/internal/domain/stock/location"
+	sut "openapi/internal/infra/database/migration"
+	migrations "openapi/scripts/migrate"
+)
+
+func open(t *testing.T) *sql.DB {
+	t.Helper()
+
+	db, err := sql.Open("sqlite", "file:"+filepath.Join(t.TempDir(), "test.db")+"?_txlock=immediate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	return db
+}
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	// When
+	ms, err := sut.Load(migrations.FS)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ms) < 5 || ms[0].Version != 1 || ms[0].Name != "stock" {
+		t.Fatalf("%T %+v want the migrations from 000001_stock", ms, ms)
+	}
+
+	for i, m := range ms {
+		if m.Up == "" || m.Down == "" {
+			t.Errorf("%d_%s must have up and down files", m.Version, m.Name)
+		}
+		if i > 0 && ms[i-1].Version >= m.Version {
+			t.Errorf("%T %+v want ordered by version", ms, ms)
+		}
+	}
+}
+
+func TestLoadFail(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]fstest.MapFS{
+		"invalid name": {"stock.up.sql": {Data: []byte("SELECT 1")}},
+		"no up":        {"000001_stock.down.sql": {Data: []byte("SELECT 1")}},
+		"same version": {
+			"000001_stock.up.sql": {Data: []byte("SELECT 1")},
+			"000001_item.up.sql":  {Data: []byte("SELECT 1")},
+		},
+	}
+
+	for name, fsys := range tests {
+		// When
+		_, err := sut.Load(fsys)
+
+		// Then
+		if err == nil {
+			t.Errorf("%s: error must not be nil", name)
+		}
+	}
+}
+
+func TestNewFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, nilErr := sut.New(nil, "sqlite")
+	_, driverErr := sut.New(open(t), "memory")
+
+	// Then
+	if nilErr == nil || driverErr == nil {
+		t.Errorf("%v %v want errors", nilErr, driverErr)
+	}
+}
+
+func TestUpAndDown(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := open(t)
+	m, err := sut.New(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+
+	// When
+	before := m.Check(ctx)
+
+	up, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	again, err := m.Up(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after := m.Check(ctx)
+
+	status, err := m.Status(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	down, err := m.Down(ctx, len(up)+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	version, dirty, err := m.Version(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if before == nil {
+		t.Errorf("an empty database must fail the check")
+	}
+
+	if len(up) == 0 || up[len(up)-1].Version != m.Latest() {
+		t.Errorf("%T %+v want up to %d", up, up, m.Latest())
+	}
+
+	if len(again) != 0 {
+		t.Errorf("%T %+v want nothing to apply", again, again)
+	}
+
+	if after != nil {
+		t.Errorf("%T %v want nil", after, after)
+	}
+
+	for _, s := range status {
+		if !s.Applied {
+			t.Errorf("%T %+v want applied", s, s)
+		}
+	}
+
+	if len(down) != len(up) || version != 0 || dirty {
+		t.Errorf("%T %+v at %d want %d reverted at 0", down, down, version, len(up))
+	}
+
+	var tables int
+	if err := db.QueryRow(`SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name LIKE 'stock%'`).Scan(&tables); err != nil {
+		t.Fatal(err)
+	}
+
+	if tables != 0 {
+		t.Errorf("%T %+v want the tables dropped", tables, tables)
+	}
+}
+
+func TestUpFailDirty(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := open(t)
+	m, err := sut.New(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`UPDATE "schema_migrations" SET "dirty" = TRUE`); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, upErr := m.Down(context.Background(), 1)
+	checkErr := m.Check(context.Background())
+
+	// Then
+	if !errors.Is(upErr, sut.ErrDirty) || !errors.Is(checkErr, sut.ErrDirty) {
+		t.Errorf("%v %v want %v", upErr, checkErr, sut.ErrDirty)
+	}
+}
+
+func TestUpFailUnknownVersion(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := open(t)
+	m, err := sut.New(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`UPDATE "schema_migrations" SET "version" = 999999`); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = m.Up(context.Background())
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestUpRepairLocationNames(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := open(t)
+	m, err := sut.New(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx := context.Background()
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	// the names are capped at 100 characters, the longest name when the migration was written
+	long := strings.Repeat("x", 120)
+	rows := []struct {
+		id      string
+		name    string
+		deleted bool
+		want    string
+	}{
+		{"a1", "Legacy\tBay", false, "Legacy Bay"},
+		{"a2", "Dock\u00a0", false, "Dock"},
+		// SQLite cannot normalize to NFC, so the names are left as stored
+		{"a3", "Cafe\u0301", false, "Cafe\u0301"},
+		{"a4", "Caf\u00e9", false, "Caf\u00e9"},
+		{"a5", " \t\x7f ", false, "(a5)"},
+		{"a6", "Legacy\tBay", true, "Legacy Bay"},
+		{"a7", long, false, long[:100]},
+		{"a8", "Plain", false, "Plain"},
+		{"a9", "Plain\n", false, "Plain (a9)"},
+	}
+	for i, r := range rows {
+		if _, err := db.Exec(
+			`INSERT INTO "stock_location" ("id", "name", "deleted", "created_at") VALUES (?, ?, ?, ?)`,
+			r.id, r.name, r.deleted, fmt.Sprintf("2024-01-01 00:00:%02d.000", i),
+		); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	if _, err := m.Down(ctx, 1); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := m.Up(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	for _, r := range rows {
+		var (
+			name    string
+			version int64
+		)
+		if err := db.QueryRow(`SELECT "name", "version" FROM "stock_location" WHERE "id" = ?`, r.id).Scan(&name, &version); err != nil {
+			t.Fatal(err)
+		}
+
+		if name != r.want {
+			t.Errorf("%s: want %q, got %q", r.id, r.want, name)
+		}
+
+		if _, err := location.NewName(name); err != nil {
+			t.Errorf("%s: %q must be a valid name: %v", r.id, name, err)
+		}
+
+		if changed := r.name != r.want; changed != (version > 1) {
+			t.Errorf("%s: version %d want it counted up only when the name changed", r.id, version)
+		}
+	}
+}
@@ -1,7 +1,14 @@
 package env
 
-import "os"
+import (
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
 
+// GetDbDriver returns the database/sql driver of the database, "postgres" or "sqlite",
+// or "memory" to keep the data in the process.
 func GetDbDriver() string {
 	dbDriver := os.Getenv("DB_DRIVER")
 	if dbDriver == "" {
@@ -10,10 +17,100 @@ func GetDbDriver() string {
 	return dbDriver
 }
 
+// GetDbDataSourceName returns the DSN of the database, by default one on localhost for postgres
+// and the file openapi.db in the working directory for sqlite.
+// A sqlite DSN always gets the settings the repositories and the migrations rely on, see sqliteSettings.
 func GetDbDataSourceName() string {
 	dbDsn := os.Getenv("DB_DSN")
+	if GetDbDriver() == "sqlite" {
+		if dbDsn == "" {
+			dbDsn = "file:openapi.db"
+		}
+		return withSqliteSettings(dbDsn)
+	}
+
 	if dbDsn == "" {
 		dbDsn = "host=localhost port=5432 user=user password=password dbname=openapi sslmode=disable"
 	}
 	return dbDsn
 }
+
+// sqliteSettings are added to a sqlite DSN unless it already sets them.
+// Transactions take the write lock when they begin, so that concurrent ones wait for it for up to
+// the busy timeout instead of failing when they write what they have read, and the migrations
+// count on the same lock to run one at a time.
+var sqliteSettings = []struct {
+	prefix string
+	param  string
+}{
+	{"_pragma=foreign_keys(", "_pragma=foreign_keys(1)"},
+	{"_pragma=busy_timeout(", "_pragma=busy_timeout(5000)"},
+	{"_pragma=journal_mode(", "_pragma=journal_mode(WAL)"},
+	{"_txlock=", "_txlock=immediate"},
+}
+
+// withSqliteSettings adds the missing sqliteSettings to dsn. Transactions always begin immediate,
+// so any other _txlock is replaced.
+func withSqliteSettings(dsn string) string {
+	name, query, _ := strings.Cut(dsn, "?")
+
+	params := []string{}
+	for _, param := range strings.Split(query, "&") {
+		if param != "" && !strings.HasPrefix(param, "_txlock=") {
+			params = append(params, param)
+		}
+	}
+
+	for _, setting := range sqliteSettings {
+		set := false
+		for _, param := range params {
+			set = set || strings.HasPrefix(param, setting.prefix)
+		}
+		if !set {
+			params = append(params, setting.param)
+		}
+	}
+
+	return name + "?" + strings.Join(params, "&")
+}
+
+// GetDbMaxOpenConns returns the maximum number of open connections in the pool.
+// Zero means no limit.
+func GetDbMaxOpenConns() int {
+	maxOpenConns, err := strconv.Atoi(os.Getenv("DB_MAX_OPEN_CONNS"))
+	if err != nil || maxOpenConns < 0 {
+		maxOpenConns = 25
+	}
+	return maxOpenConns
+}
+
+// GetDbMaxIdleConns returns the maximum number of idle connections kept in the pool.
+func GetDbMaxIdleConns() int {
+	maxIdleConns, err := strconv.Atoi(os.Getenv("DB_MAX_IDLE_CONNS"))
+	if err != nil || maxIdleConns < 0 {
+		maxIdleConns = 25
+	}
+	return maxIdleConns
+}
+
+// GetDbConnMaxLifetime returns how long a connection may be reused, e.g. "5m".
+// Zero means connections are reused forever.
+func GetDbConnMaxLifetime() time.Duration {
+	connMaxLifetime, err := time.ParseDuration(os.Getenv("DB_CONN_MAX_LIFETIME"))
+	if err != nil || connMaxLifetime < 0 {
+		connMaxLifetime = 5 * time.Minute
+	}
+	return connMaxLifetime
+}
+
+// GetDbSchemaCheck returns what the server does at startup when the schema of the database is not
+// at the version of its migrations: nothing with "off" by default, refuse to serve with "strict",
+// or apply the migrations with "migrate".
+func GetDbSchemaCheck() string {
+	switch v := os.Getenv("DB_SCHEMA_CHECK"); v {
+	case "strict", "migrate":
+		return v
+	default:
+		return "off"
+	}
+}
@@ -0,0 +1,35 @@
+package env_test
+
+import (
+	"testing"
+
+	"openapi/internal/infra/env"
+)
+
+func TestGetDbDataSourceName(t *testing.T) {
+	tests := []struct {
+		driver string
+		dsn    string
+		want   string
+	}{
+		{"sqlite", "", "file:openapi.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"},
+		{"sqlite", "/var/lib/openapi/stock.db", "/var/lib/openapi/stock.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"},
+		{"sqlite", "file:stock.db?_pragma=busy_timeout(100)&_txlock=deferred&mode=rwc", "file:stock.db?_pragma=busy_timeout(100)&mode=rwc&_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_txlock=immediate"},
+		{"sqlite", "file:stock.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate", "file:stock.db?_pragma=foreign_keys(1)&_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"},
+		{"postgres", "host=db user=u", "host=db user=u"},
+	}
+
+	for _, tt := range tests {
+		// Given
+		t.Setenv("DB_DRIVER", tt.driver)
+		t.Setenv("DB_DSN", tt.dsn)
+
+		// When
+		got := env.GetDbDataSourceName()
+
+		// Then
+		if got != tt.want {
+			t.Errorf("%s %q: want %q, got %q", tt.driver, tt.dsn, tt.want, got)
+		}
+	}
+}
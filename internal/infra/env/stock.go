@@ -0,0 +1,16 @@
+package env
+
+import (
+	"os"
+	"strconv"
+)
+
+// GetStockLocationRetentionDays returns how many days deleted stock locations are kept before
+// they are purged for good. Zero, the default, keeps them forever.
+func GetStockLocationRetentionDays() int {
+	days, err := strconv.Atoi(os.Getenv("STOCK_LOCATION_RETENTION_DAYS"))
+	if err != nil || days < 0 {
+		days = 0
+	}
+	return days
+}
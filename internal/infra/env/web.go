@@ -1,6 +1,9 @@
 package env
 
-import "os"
+import (
+	"os"
+	"time"
+)
 
 func GetServiceUrl() string {
 	serviceUrl := os.Getenv("SERVICE_URL")
@@ -9,3 +12,30 @@ func GetServiceUrl() string {
 	}
 	return serviceUrl
 }
+
+// GetRequestTimeout returns how long a request may take before its context is cancelled, e.g. "30s".
+// Zero means requests never time out.
+func GetRequestTimeout() time.Duration {
+	requestTimeout, err := time.ParseDuration(os.Getenv("REQUEST_TIMEOUT"))
+	if err != nil || requestTimeout < 0 {
+		requestTimeout = 30 * time.Second
+	}
+	return requestTimeout
+}
+
+// GetResponseValidation returns how responses are checked against the API specification:
+// "off" by default, "warn" to log the responses that break it, or "strict" to also answer them with 500.
+func GetResponseValidation() string {
+	switch v := os.Getenv("RESPONSE_VALIDATION"); v {
+	case "warn", "strict":
+		return v
+	default:
+		return "off"
+	}
+}
+
+// GetAdminToken returns the bearer token of the administrators. The admin operations are
+// refused to everyone while it is empty, which it is by default.
+func GetAdminToken() string {
+	return os.Getenv("ADMIN_TOKEN")
+}
@@ -5,6 +5,7 @@
 package mock_location
 
 import (
+	context "context"
 	location "openapi/internal/domain/stock/location"
 	reflect "reflect"
 
@@ -34,46 +35,120 @@ func (m *MockIRepository) EXPECT() *MockIRepositoryMockRecorder {
 	return m.recorder
 }
 
+// Ancestors mocks base method.
+func (m *MockIRepository) Ancestors(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ancestors", ctx, id)
+	ret0, _ := ret[0].([]*location.Aggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Ancestors indicates an expected call of Ancestors.
+func (mr *MockIRepositoryMockRecorder) Ancestors(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ancestors", reflect.TypeOf((*MockIRepository)(nil).Ancestors), ctx, id)
+}
+
+// Descendants mocks base method.
+func (m *MockIRepository) Descendants(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Descendants", ctx, id)
+	ret0, _ := ret[0].([]*location.Aggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Descendants indicates an expected call of Descendants.
+func (mr *MockIRepositoryMockRecorder) Descendants(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Descendants", reflect.TypeOf((*MockIRepository)(nil).Descendants), ctx, id)
+}
+
 // Find mocks base method.
-func (m *MockIRepository) Find(id location.Id) (bool, error) {
+func (m *MockIRepository) Find(ctx context.Context, id location.Id) (bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Find", id)
+	ret := m.ctrl.Call(m, "Find", ctx, id)
 	ret0, _ := ret[0].(bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Find indicates an expected call of Find.
-func (mr *MockIRepositoryMockRecorder) Find(id interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) Find(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIRepository)(nil).Find), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Find", reflect.TypeOf((*MockIRepository)(nil).Find), ctx, id)
+}
+
+// FindByName mocks base method.
+func (m *MockIRepository) FindByName(ctx context.Context, name location.Name) (*location.Aggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByName", ctx, name)
+	ret0, _ := ret[0].(*location.Aggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByName indicates an expected call of FindByName.
+func (mr *MockIRepositoryMockRecorder) FindByName(ctx, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByName", reflect.TypeOf((*MockIRepository)(nil).FindByName), ctx, name)
 }
 
 // Get mocks base method.
-func (m *MockIRepository) Get(id location.Id) (*location.Aggregate, error) {
+func (m *MockIRepository) Get(ctx context.Context, id location.Id) (*location.Aggregate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", id)
+	ret := m.ctrl.Call(m, "Get", ctx, id)
 	ret0, _ := ret[0].(*location.Aggregate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Get indicates an expected call of Get.
-func (mr *MockIRepositoryMockRecorder) Get(id interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIRepository)(nil).Get), ctx, id)
+}
+
+// List mocks base method.
+func (m *MockIRepository) List(ctx context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, q)
+	ret0, _ := ret[0].([]*location.Aggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List.
+func (mr *MockIRepositoryMockRecorder) List(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockIRepository)(nil).List), ctx, q)
+}
+
+// Purge mocks base method.
+func (m *MockIRepository) Purge(ctx context.Context, id location.Id) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Purge indicates an expected call of Purge.
+func (mr *MockIRepositoryMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockIRepository)(nil).Get), id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockIRepository)(nil).Purge), ctx, id)
 }
 
 // Save mocks base method.
-func (m *MockIRepository) Save(a *location.Aggregate) error {
+func (m *MockIRepository) Save(ctx context.Context, a *location.Aggregate) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Save", a)
+	ret := m.ctrl.Call(m, "Save", ctx, a)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // Save indicates an expected call of Save.
-func (mr *MockIRepositoryMockRecorder) Save(a interface{}) *gomock.Call {
+func (mr *MockIRepositoryMockRecorder) Save(ctx, a interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRepository)(nil).Save), a)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRepository)(nil).Save), ctx, a)
 }
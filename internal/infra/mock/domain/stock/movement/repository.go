@@ -0,0 +1,114 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/stock/movement/repository.go
+
+// Package mock_movement is a generated GoMock package.
+package mock_movement
+
+import (
+	context "context"
+	item "openapi/internal/domain/stock/item"
+	location "openapi/internal/domain/stock/location"
+	lot "openapi/internal/domain/stock/lot"
+	movement "openapi/internal/domain/stock/movement"
+	serial "openapi/internal/domain/stock/serial"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIRepository is a mock of IRepository interface.
+type MockIRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRepositoryMockRecorder
+}
+
+// MockIRepositoryMockRecorder is the mock recorder for MockIRepository.
+type MockIRepositoryMockRecorder struct {
+	mock *MockIRepository
+}
+
+// NewMockIRepository creates a new mock instance.
+func NewMockIRepository(ctrl *gomock.Controller) *MockIRepository {
+	mock := &MockIRepository{ctrl: ctrl}
+	mock.recorder = &MockIRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRepository) EXPECT() *MockIRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByLocation mocks base method.
+func (m *MockIRepository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByLocation", ctx, locationId)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByLocation indicates an expected call of FindByLocation.
+func (mr *MockIRepositoryMockRecorder) FindByLocation(ctx, locationId interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByLocation", reflect.TypeOf((*MockIRepository)(nil).FindByLocation), ctx, locationId)
+}
+
+// GetBalance mocks base method.
+func (m *MockIRepository) GetBalance(ctx context.Context, itemId item.Id, locationId location.Id, lotNumber lot.Number) (movement.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBalance", ctx, itemId, locationId, lotNumber)
+	ret0, _ := ret[0].(movement.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBalance indicates an expected call of GetBalance.
+func (mr *MockIRepositoryMockRecorder) GetBalance(ctx, itemId, locationId, lotNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockIRepository)(nil).GetBalance), ctx, itemId, locationId, lotNumber)
+}
+
+// ListBalances mocks base method.
+func (m *MockIRepository) ListBalances(ctx context.Context, q movement.BalanceQuery) ([]movement.Balance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBalances", ctx, q)
+	ret0, _ := ret[0].([]movement.Balance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBalances indicates an expected call of ListBalances.
+func (mr *MockIRepositoryMockRecorder) ListBalances(ctx, q interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBalances", reflect.TypeOf((*MockIRepository)(nil).ListBalances), ctx, q)
+}
+
+// ListBySerial mocks base method.
+func (m *MockIRepository) ListBySerial(ctx context.Context, itemId item.Id, number serial.Number) ([]*movement.Aggregate, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBySerial", ctx, itemId, number)
+	ret0, _ := ret[0].([]*movement.Aggregate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBySerial indicates an expected call of ListBySerial.
+func (mr *MockIRepositoryMockRecorder) ListBySerial(ctx, itemId, number interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBySerial", reflect.TypeOf((*MockIRepository)(nil).ListBySerial), ctx, itemId, number)
+}
+
+// Save mocks base method.
+func (m *MockIRepository) Save(ctx context.Context, a *movement.Aggregate) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Save", ctx, a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Save indicates an expected call of Save.
+func (mr *MockIRepositoryMockRecorder) Save(ctx, a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Save", reflect.TypeOf((*MockIRepository)(nil).Save), ctx, a)
+}
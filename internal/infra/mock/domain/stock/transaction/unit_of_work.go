@@ -0,0 +1,163 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: internal/domain/stock/transaction/unit_of_work.go
+
+// Package mock_transaction is a generated GoMock package.
+package mock_transaction
+
+import (
+	context "context"
+	item "openapi/internal/domain/stock/item"
+	location "openapi/internal/domain/stock/location"
+	lot "openapi/internal/domain/stock/lot"
+	movement "openapi/internal/domain/stock/movement"
+	serial "openapi/internal/domain/stock/serial"
+	transaction "openapi/internal/domain/stock/transaction"
+	transfer "openapi/internal/domain/stock/transfer"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIRepositories is a mock of IRepositories interface.
+type MockIRepositories struct {
+	ctrl     *gomock.Controller
+	recorder *MockIRepositoriesMockRecorder
+}
+
+// MockIRepositoriesMockRecorder is the mock recorder for MockIRepositories.
+type MockIRepositoriesMockRecorder struct {
+	mock *MockIRepositories
+}
+
+// NewMockIRepositories creates a new mock instance.
+func NewMockIRepositories(ctrl *gomock.Controller) *MockIRepositories {
+	mock := &MockIRepositories{ctrl: ctrl}
+	mock.recorder = &MockIRepositoriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIRepositories) EXPECT() *MockIRepositoriesMockRecorder {
+	return m.recorder
+}
+
+// Item mocks base method.
+func (m *MockIRepositories) Item() item.IRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Item")
+	ret0, _ := ret[0].(item.IRepository)
+	return ret0
+}
+
+// Item indicates an expected call of Item.
+func (mr *MockIRepositoriesMockRecorder) Item() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Item", reflect.TypeOf((*MockIRepositories)(nil).Item))
+}
+
+// Location mocks base method.
+func (m *MockIRepositories) Location() location.IRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Location")
+	ret0, _ := ret[0].(location.IRepository)
+	return ret0
+}
+
+// Location indicates an expected call of Location.
+func (mr *MockIRepositoriesMockRecorder) Location() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Location", reflect.TypeOf((*MockIRepositories)(nil).Location))
+}
+
+// Lot mocks base method.
+func (m *MockIRepositories) Lot() lot.IRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Lot")
+	ret0, _ := ret[0].(lot.IRepository)
+	return ret0
+}
+
+// Lot indicates an expected call of Lot.
+func (mr *MockIRepositoriesMockRecorder) Lot() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lot", reflect.TypeOf((*MockIRepositories)(nil).Lot))
+}
+
+// Movement mocks base method.
+func (m *MockIRepositories) Movement() movement.IRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Movement")
+	ret0, _ := ret[0].(movement.IRepository)
+	return ret0
+}
+
+// Movement indicates an expected call of Movement.
+func (mr *MockIRepositoriesMockRecorder) Movement() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Movement", reflect.TypeOf((*MockIRepositories)(nil).Movement))
+}
+
+// Serial mocks base method.
+func (m *MockIRepositories) Serial() serial.IRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Serial")
+	ret0, _ := ret[0].(serial.IRepository)
+	return ret0
+}
+
+// Serial indicates an expected call of Serial.
+func (mr *MockIRepositoriesMockRecorder) Serial() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Serial", reflect.TypeOf((*MockIRepositories)(nil).Serial))
+}
+
+// Transfer mocks base method.
+func (m *MockIRepositories) Transfer() transfer.IRepository {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Transfer")
+	ret0, _ := ret[0].(transfer.IRepository)
+	return ret0
+}
+
+// Transfer indicates an expected call of Transfer.
+func (mr *MockIRepositoriesMockRecorder) Transfer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Transfer", reflect.TypeOf((*MockIRepositories)(nil).Transfer))
+}
+
+// MockIUnitOfWork is a mock of IUnitOfWork interface.
+type MockIUnitOfWork struct {
+	ctrl     *gomock.Controller
+	recorder *MockIUnitOfWorkMockRecorder
+}
+
+// MockIUnitOfWorkMockRecorder is the mock recorder for MockIUnitOfWork.
+type MockIUnitOfWorkMockRecorder struct {
+	mock *MockIUnitOfWork
+}
+
+// NewMockIUnitOfWork creates a new mock instance.
+func NewMockIUnitOfWork(ctrl *gomock.Controller) *MockIUnitOfWork {
+	mock := &MockIUnitOfWork{ctrl: ctrl}
+	mock.recorder = &MockIUnitOfWorkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIUnitOfWork) EXPECT() *MockIUnitOfWorkMockRecorder {
+	return m.recorder
+}
+
+// Do mocks base method.
+func (m *MockIUnitOfWork) Do(ctx context.Context, fn func(transaction.IRepositories) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Do", ctx, fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Do indicates an expected call of Do.
+func (mr *MockIUnitOfWorkMockRecorder) Do(ctx, fn interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockIUnitOfWork)(nil).Do), ctx, fn)
+}
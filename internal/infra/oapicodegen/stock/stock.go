@@ -19,61 +19,725 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
-// BadRequestResponse defines model for BadRequestResponse.
-type BadRequestResponse struct {
-	Message string `json:"message"`
+const (
+	AdminTokenScopes = "AdminToken.Scopes"
+)
+
+// Defines values for NewStockMovementKind.
+const (
+	Adjustment NewStockMovementKind = "adjustment"
+	Issue      NewStockMovementKind = "issue"
+	Receipt    NewStockMovementKind = "receipt"
+)
+
+// Defines values for ProblemCode.
+const (
+	ProblemCodeBadRequest                      ProblemCode = "bad_request"
+	ProblemCodeConflict                        ProblemCode = "conflict"
+	ProblemCodeDuplicateStockItemBarcode       ProblemCode = "duplicate_stock_item_barcode"
+	ProblemCodeDuplicateStockItemUnit          ProblemCode = "duplicate_stock_item_unit"
+	ProblemCodeDuplicateStockSerialNumber      ProblemCode = "duplicate_stock_serial_number"
+	ProblemCodeForbidden                       ProblemCode = "forbidden"
+	ProblemCodeInsufficientQuantity            ProblemCode = "insufficient_quantity"
+	ProblemCodeInternalError                   ProblemCode = "internal_error"
+	ProblemCodeInvalidCursor                   ProblemCode = "invalid_cursor"
+	ProblemCodeInvalidStockItemBarcode         ProblemCode = "invalid_stock_item_barcode"
+	ProblemCodeInvalidStockItemName            ProblemCode = "invalid_stock_item_name"
+	ProblemCodeInvalidStockItemPackSize        ProblemCode = "invalid_stock_item_pack_size"
+	ProblemCodeInvalidStockItemSku             ProblemCode = "invalid_stock_item_sku"
+	ProblemCodeInvalidStockItemUnit            ProblemCode = "invalid_stock_item_unit"
+	ProblemCodeInvalidStockLocationCapacity    ProblemCode = "invalid_stock_location_capacity"
+	ProblemCodeInvalidStockLocationName        ProblemCode = "invalid_stock_location_name"
+	ProblemCodeInvalidStockLocationType        ProblemCode = "invalid_stock_location_type"
+	ProblemCodeInvalidStockLotDates            ProblemCode = "invalid_stock_lot_dates"
+	ProblemCodeInvalidStockLotNumber           ProblemCode = "invalid_stock_lot_number"
+	ProblemCodeInvalidStockMovement            ProblemCode = "invalid_stock_movement"
+	ProblemCodeInvalidStockSerialNumber        ProblemCode = "invalid_stock_serial_number"
+	ProblemCodeInvalidStockTransfer            ProblemCode = "invalid_stock_transfer"
+	ProblemCodeInvalidVolume                   ProblemCode = "invalid_volume"
+	ProblemCodeInvalidWeight                   ProblemCode = "invalid_weight"
+	ProblemCodeMethodNotAllowed                ProblemCode = "method_not_allowed"
+	ProblemCodeNotFound                        ProblemCode = "not_found"
+	ProblemCodePreconditionFailed              ProblemCode = "precondition_failed"
+	ProblemCodeStockItemHasStock               ProblemCode = "stock_item_has_stock"
+	ProblemCodeStockItemNotAvailable           ProblemCode = "stock_item_not_available"
+	ProblemCodeStockItemNotFound               ProblemCode = "stock_item_not_found"
+	ProblemCodeStockItemNotLotTracked          ProblemCode = "stock_item_not_lot_tracked"
+	ProblemCodeStockItemNotSerialized          ProblemCode = "stock_item_not_serialized"
+	ProblemCodeStockItemSkuTaken               ProblemCode = "stock_item_sku_taken"
+	ProblemCodeStockItemVersionMismatch        ProblemCode = "stock_item_version_mismatch"
+	ProblemCodeStockLocationHasChildren        ProblemCode = "stock_location_has_children"
+	ProblemCodeStockLocationHasHistory         ProblemCode = "stock_location_has_history"
+	ProblemCodeStockLocationInUse              ProblemCode = "stock_location_in_use"
+	ProblemCodeStockLocationNameTaken          ProblemCode = "stock_location_name_taken"
+	ProblemCodeStockLocationNotAvailable       ProblemCode = "stock_location_not_available"
+	ProblemCodeStockLocationNotDeleted         ProblemCode = "stock_location_not_deleted"
+	ProblemCodeStockLocationNotFound           ProblemCode = "stock_location_not_found"
+	ProblemCodeStockLocationOverCapacity       ProblemCode = "stock_location_over_capacity"
+	ProblemCodeStockLocationParentCycle        ProblemCode = "stock_location_parent_cycle"
+	ProblemCodeStockLocationParentNotAvailable ProblemCode = "stock_location_parent_not_available"
+	ProblemCodeStockLocationVersionMismatch    ProblemCode = "stock_location_version_mismatch"
+	ProblemCodeStockLotDatesMismatch           ProblemCode = "stock_lot_dates_mismatch"
+	ProblemCodeStockLotNotAvailable            ProblemCode = "stock_lot_not_available"
+	ProblemCodeStockLotNotFound                ProblemCode = "stock_lot_not_found"
+	ProblemCodeStockLotNumberTaken             ProblemCode = "stock_lot_number_taken"
+	ProblemCodeStockLotRequired                ProblemCode = "stock_lot_required"
+	ProblemCodeStockSerialCountMismatch        ProblemCode = "stock_serial_count_mismatch"
+	ProblemCodeStockSerialInStock              ProblemCode = "stock_serial_in_stock"
+	ProblemCodeStockSerialNotAvailable         ProblemCode = "stock_serial_not_available"
+	ProblemCodeStockSerialNotFound             ProblemCode = "stock_serial_not_found"
+	ProblemCodeStockSerialNotInLocation        ProblemCode = "stock_serial_not_in_location"
+	ProblemCodeStockSerialNumberTaken          ProblemCode = "stock_serial_number_taken"
+	ProblemCodeTimeout                         ProblemCode = "timeout"
+	ProblemCodeUnauthorized                    ProblemCode = "unauthorized"
+	ProblemCodeUnknownStockItemUnit            ProblemCode = "unknown_stock_item_unit"
+	ProblemCodeUnsupportedMediaType            ProblemCode = "unsupported_media_type"
+	ProblemCodeValidationFailed                ProblemCode = "validation_failed"
+)
+
+// Defines values for StockLocationType.
+const (
+	Bin        StockLocationType = "bin"
+	Quarantine StockLocationType = "quarantine"
+	Staging    StockLocationType = "staging"
+	Virtual    StockLocationType = "virtual"
+	Warehouse  StockLocationType = "warehouse"
+	Zone       StockLocationType = "zone"
+)
+
+// Defines values for GetStockLocationsParamsOrder.
+const (
+	GetStockLocationsParamsOrderAsc  GetStockLocationsParamsOrder = "asc"
+	GetStockLocationsParamsOrderDesc GetStockLocationsParamsOrder = "desc"
+)
+
+// Defines values for GetStockLocationChildrenParamsOrder.
+const (
+	GetStockLocationChildrenParamsOrderAsc  GetStockLocationChildrenParamsOrder = "asc"
+	GetStockLocationChildrenParamsOrderDesc GetStockLocationChildrenParamsOrder = "desc"
+)
+
+// ExpiringStockLot defines model for ExpiringStockLot.
+type ExpiringStockLot struct {
+	// Expired The lot expired before today
+	Expired        bool                `json:"expired"`
+	ExpiresOn      openapi_types.Date  `json:"expires_on"`
+	ItemId         openapi_types.UUID  `json:"item_id"`
+	LocationId     openapi_types.UUID  `json:"location_id"`
+	Lot            string              `json:"lot"`
+	ManufacturedOn *openapi_types.Date `json:"manufactured_on,omitempty"`
+	Quantity       int64               `json:"quantity"`
+}
+
+// ExpiringStockLots defines model for ExpiringStockLots.
+type ExpiringStockLots struct {
+	Items []ExpiringStockLot `json:"items"`
+}
+
+// MoveStockLocation defines model for MoveStockLocation.
+type MoveStockLocation struct {
+	// ParentId Stock Location to move under, the root when omitted
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
 }
 
 // NewStockItem defines model for NewStockItem.
 type NewStockItem struct {
-	Name string `json:"name" validate:"required,lt=100"`
+	// Barcodes GTINs of the Stock Item, each an EAN-8, UPC-A, EAN-13 or GTIN-14 with a valid check digit
+	Barcodes *[]StockItemBarcode `json:"barcodes,omitempty"`
+
+	// BaseUnit Unit of measure the quantities of the Stock Item are counted in, each when omitted.
+	// Stored in lower case, at most 20 letters and digits. It cannot be changed later.
+	BaseUnit *string `json:"base_unit,omitempty"`
+
+	// LotTracked Count the stock per lot, so that every movement and transfer of the Stock Item names its lot.
+	// Not tracked when omitted on creation, kept as is when omitted on update. It can only be changed
+	// while the Stock Item has no stock on hand.
+	LotTracked *bool  `json:"lot_tracked,omitempty"`
+	Name       string `json:"name" validate:"required,max=100"`
+
+	// PackSizes Units of measure the Stock Item is also packed in, each once and none of them the base unit
+	PackSizes *[]StockItemPackSize `json:"pack_sizes,omitempty"`
+
+	// Serialized Register every unit by its serial number, so that every movement and transfer of the Stock Item
+	// names the serials of the units it moves. Not serialized when omitted on creation, kept as is when
+	// omitted on update. It can only be changed while the Stock Item has no stock on hand.
+	Serialized *bool `json:"serialized,omitempty"`
+
+	// Sku Stock keeping unit, stored without surrounding white space and in upper case. At most 64 letters,
+	// digits and the characters - _ . / of ASCII. Unique among the active Stock Items.
+	Sku StockItemSku `json:"sku"`
+
+	// UnitVolume Cubic centimetres of one unit, counted towards the volume capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitVolume *int64 `json:"unit_volume,omitempty"`
+
+	// UnitWeight Grams of one unit, counted towards the weight capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitWeight *int64 `json:"unit_weight,omitempty"`
 }
 
 // NewStockLocation defines model for NewStockLocation.
 type NewStockLocation struct {
-	Name string `json:"name" validate:"required,lt=100"`
+	// AllowNegativeStock Allow the quantity on hand to go below zero
+	AllowNegativeStock *bool `json:"allow_negative_stock,omitempty"`
+
+	// Capacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+	// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+	// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+	Capacity *StockLocationCapacity `json:"capacity,omitempty"`
+
+	// Name Stored without surrounding white space and normalized to Unicode NFC, at most 100 characters
+	// without control characters. Unique among the active Stock Locations.
+	Name string `json:"name" validate:"required,max=100"`
+
+	// ParentId Stock Location to create the new one under, a root location when omitted
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Type What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+	// A virtual location holds stock that is not in any physical place, such as stock in transit.
+	Type *StockLocationType `json:"type,omitempty"`
+}
+
+// NewStockMovement defines model for NewStockMovement.
+type NewStockMovement struct {
+	// ExpiresOn Last day the lot may be used, only given with a receipt. A lot without it never expires.
+	// The first receipt of a lot records its dates, the later ones must give the same dates or none.
+	ExpiresOn  *openapi_types.Date  `json:"expires_on,omitempty"`
+	ItemId     openapi_types.UUID   `json:"item_id" validate:"required"`
+	Kind       NewStockMovementKind `json:"kind" validate:"required,oneof=receipt issue adjustment"`
+	LocationId openapi_types.UUID   `json:"location_id" validate:"required"`
+
+	// Lot Lot of a lot tracked Stock Item, required for them and refused for the other Stock Items.
+	// Stored without surrounding white space, at most 64 characters without control characters.
+	// Only a receipt records a new lot.
+	Lot *StockLotNumber `json:"lot,omitempty"`
+
+	// ManufacturedOn Day the lot was made, only given with a receipt. The first receipt of a lot records its dates,
+	// the later ones must give the same dates or none.
+	ManufacturedOn *openapi_types.Date `json:"manufactured_on,omitempty"`
+
+	// Quantity Positive for receipts and issues, signed for adjustments, never zero. Counted in unit.
+	Quantity int64 `json:"quantity" validate:"nonzero"`
+
+	// Serials Serial numbers of the units moved, one per unit of the quantity, each once. Required for serialized
+	// Stock Items and refused for the other Stock Items. A receipt registers the serial numbers it is the
+	// first to bring in; the other movements only move registered units out of the Stock Location they are in.
+	Serials *StockSerialNumbers `json:"serials,omitempty"`
+
+	// Unit Unit the quantity is counted in, the base unit of the Stock Item or one of its pack sizes.
+	// The movement is recorded in the base unit, which is also the unit when it is omitted.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// NewStockMovementKind defines model for NewStockMovement.Kind.
+type NewStockMovementKind string
+
+// NewStockTransfer defines model for NewStockTransfer.
+type NewStockTransfer struct {
+	FromLocationId openapi_types.UUID `json:"from_location_id" validate:"required"`
+	ItemId         openapi_types.UUID `json:"item_id" validate:"required"`
+
+	// Lot Lot of a lot tracked Stock Item, required for them and refused for the other Stock Items.
+	// Stored without surrounding white space, at most 64 characters without control characters.
+	// Only a receipt records a new lot.
+	Lot      *StockLotNumber `json:"lot,omitempty"`
+	Quantity int64           `json:"quantity" validate:"required,gt=0"`
+
+	// Serials Serial numbers of the units moved, one per unit of the quantity, each once. Required for serialized
+	// Stock Items and refused for the other Stock Items. A receipt registers the serial numbers it is the
+	// first to bring in; the other movements only move registered units out of the Stock Location they are in.
+	Serials      *StockSerialNumbers `json:"serials,omitempty"`
+	ToLocationId openapi_types.UUID  `json:"to_location_id" validate:"required"`
+}
+
+// Problem Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Problem struct {
+	// Code Stable identifier of the problem for clients to branch on
+	Code ProblemCode `json:"code"`
+
+	// Detail Explanation for a human, which may change between releases
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Fields of the request that broke a rule
+	Errors *[]ProblemField `json:"errors,omitempty"`
+
+	// Instance Path of the request
+	Instance *string `json:"instance,omitempty"`
+	Status   int     `json:"status"`
+	Title    string  `json:"title"`
+
+	// Type Always about:blank, the title is the HTTP status text
+	Type string `json:"type"`
+}
+
+// ProblemCode Stable identifier of the problem for clients to branch on
+type ProblemCode string
+
+// ProblemField defines model for ProblemField.
+type ProblemField struct {
+	// Field JSON name of the field
+	Field string `json:"field"`
+
+	// Reason Rule the field broke, such as required or maxLength=100
+	Reason string `json:"reason"`
+}
+
+// StockBalance defines model for StockBalance.
+type StockBalance struct {
+	ItemId     openapi_types.UUID `json:"item_id"`
+	LocationId openapi_types.UUID `json:"location_id"`
+
+	// Lot Lot of a lot tracked Stock Item, absent for the other Stock Items
+	Lot      *string `json:"lot,omitempty"`
+	Quantity int64   `json:"quantity"`
+}
+
+// StockBalances defines model for StockBalances.
+type StockBalances struct {
+	Items []StockBalance `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// StockItem defines model for StockItem.
+type StockItem struct {
+	Barcodes   []string            `json:"barcodes"`
+	BaseUnit   string              `json:"base_unit"`
+	Id         openapi_types.UUID  `json:"id"`
+	LotTracked bool                `json:"lot_tracked"`
+	Name       string              `json:"name"`
+	PackSizes  []StockItemPackSize `json:"pack_sizes"`
+	Serialized bool                `json:"serialized"`
+	Sku        string              `json:"sku"`
+
+	// UnitVolume Cubic centimetres of one unit, 0 when not declared
+	UnitVolume int64 `json:"unit_volume"`
+
+	// UnitWeight Grams of one unit, 0 when not declared
+	UnitWeight int64 `json:"unit_weight"`
+}
+
+// StockItemBarcode defines model for StockItemBarcode.
+type StockItemBarcode = string
+
+// StockItemPackSize defines model for StockItemPackSize.
+type StockItemPackSize struct {
+	// Quantity Base units one pack holds, such as 12 for 1 case = 12 each
+	Quantity int64 `json:"quantity"`
+
+	// Unit Unit of measure of the pack, stored in lower case, at most 20 letters and digits
+	Unit string `json:"unit"`
+}
+
+// StockItemSku Stock keeping unit, stored without surrounding white space and in upper case. At most 64 letters,
+// digits and the characters - _ . / of ASCII. Unique among the active Stock Items.
+type StockItemSku = string
+
+// StockItems defines model for StockItems.
+type StockItems struct {
+	Items []StockItem `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// StockLocation defines model for StockLocation.
+type StockLocation struct {
+	AllowNegativeStock bool `json:"allow_negative_stock"`
+
+	// Capacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+	// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+	// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+	Capacity *StockLocationCapacity `json:"capacity,omitempty"`
+	Deleted  bool                   `json:"deleted"`
+	Id       openapi_types.UUID     `json:"id"`
+	Name     string                 `json:"name"`
+
+	// ParentId Stock Location this one is part of, absent for a root location
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Type What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+	// A virtual location holds stock that is not in any physical place, such as stock in transit.
+	Type StockLocationType `json:"type"`
+}
+
+// StockLocationCapacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+type StockLocationCapacity struct {
+	// MaxUnits Units of all Stock Items together
+	MaxUnits *int64 `json:"max_units,omitempty"`
+
+	// MaxVolume Cubic centimetres, from the unit_volume of the Stock Items
+	MaxVolume *int64 `json:"max_volume,omitempty"`
+
+	// MaxWeight Grams, from the unit_weight of the Stock Items
+	MaxWeight *int64 `json:"max_weight,omitempty"`
+}
+
+// StockLocationType What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+// A virtual location holds stock that is not in any physical place, such as stock in transit.
+type StockLocationType string
+
+// StockLocations defines model for StockLocations.
+type StockLocations struct {
+	Items []StockLocation `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// StockLotNumber Lot of a lot tracked Stock Item, required for them and refused for the other Stock Items.
+// Stored without surrounding white space, at most 64 characters without control characters.
+// Only a receipt records a new lot.
+type StockLotNumber = string
+
+// StockLotPick defines model for StockLotPick.
+type StockLotPick struct {
+	// ExpiresOn Absent for a lot that never expires
+	ExpiresOn *openapi_types.Date `json:"expires_on,omitempty"`
+	Lot       string              `json:"lot"`
+	Quantity  int64               `json:"quantity"`
+}
+
+// StockLotPicks defines model for StockLotPicks.
+type StockLotPicks struct {
+	Items []StockLotPick `json:"items"`
+}
+
+// StockSerial defines model for StockSerial.
+type StockSerial struct {
+	ItemId openapi_types.UUID `json:"item_id"`
+
+	// LocationId Stock Location the unit is in, absent while it is not in stock
+	LocationId *openapi_types.UUID `json:"location_id,omitempty"`
+
+	// Movements Movements of the unit in the order they were recorded
+	Movements []StockSerialMovement `json:"movements"`
+	Serial    string                `json:"serial"`
+}
+
+// StockSerialMovement defines model for StockSerialMovement.
+type StockSerialMovement struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind One of receipt, issue, adjustment, transfer_out and transfer_in
+	Kind       string             `json:"kind"`
+	LocationId openapi_types.UUID `json:"location_id"`
+
+	// Quantity Change the whole movement made to the balance, of which the unit is one
+	Quantity int64 `json:"quantity"`
+}
+
+// StockSerialNumber Serial number of a unit of a serialized Stock Item, unique per Stock Item.
+// Stored without surrounding white space, at most 64 characters without control characters.
+type StockSerialNumber = string
+
+// StockSerialNumbers Serial numbers of the units moved, one per unit of the quantity, each once. Required for serialized
+// Stock Items and refused for the other Stock Items. A receipt registers the serial numbers it is the
+// first to bring in; the other movements only move registered units out of the Stock Location they are in.
+type StockSerialNumbers = []StockSerialNumber
+
+// StockSerials defines model for StockSerials.
+type StockSerials struct {
+	Items []StockSerial `json:"items"`
+}
+
+// UpdateStockItem defines model for UpdateStockItem.
+type UpdateStockItem struct {
+	// Barcodes Replace the barcodes, kept as is when omitted and removed when empty
+	Barcodes *[]StockItemBarcode `json:"barcodes,omitempty"`
+
+	// LotTracked Count the stock per lot, so that every movement and transfer of the Stock Item names its lot.
+	// Not tracked when omitted on creation, kept as is when omitted on update. It can only be changed
+	// while the Stock Item has no stock on hand.
+	LotTracked *bool  `json:"lot_tracked,omitempty"`
+	Name       string `json:"name" validate:"required,max=100"`
+
+	// PackSizes Replace the pack sizes, kept as is when omitted and removed when empty
+	PackSizes *[]StockItemPackSize `json:"pack_sizes,omitempty"`
+
+	// Serialized Register every unit by its serial number, so that every movement and transfer of the Stock Item
+	// names the serials of the units it moves. Not serialized when omitted on creation, kept as is when
+	// omitted on update. It can only be changed while the Stock Item has no stock on hand.
+	Serialized *bool `json:"serialized,omitempty"`
+
+	// Sku Stock keeping unit, stored without surrounding white space and in upper case. At most 64 letters,
+	// digits and the characters - _ . / of ASCII. Unique among the active Stock Items.
+	Sku *StockItemSku `json:"sku,omitempty"`
+
+	// UnitVolume Cubic centimetres of one unit, counted towards the volume capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitVolume *int64 `json:"unit_volume,omitempty"`
+
+	// UnitWeight Grams of one unit, counted towards the weight capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitWeight *int64 `json:"unit_weight,omitempty"`
+}
+
+// UpdateStockLocation defines model for UpdateStockLocation.
+type UpdateStockLocation struct {
+	// AllowNegativeStock Allow the quantity on hand to go below zero, kept as is when omitted
+	AllowNegativeStock *bool `json:"allow_negative_stock,omitempty"`
+
+	// Capacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+	// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+	// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+	Capacity *StockLocationCapacity `json:"capacity,omitempty"`
+
+	// Name Stored without surrounding white space and normalized to Unicode NFC, at most 100 characters
+	// without control characters. Unique among the active Stock Locations.
+	Name string `json:"name" validate:"required,max=100"`
+
+	// Type What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+	// A virtual location holds stock that is not in any physical place, such as stock in transit.
+	Type *StockLocationType `json:"type,omitempty"`
 }
 
-// BadRequest defines model for BadRequest.
-type BadRequest = BadRequestResponse
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// BadRequest Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type BadRequest = Problem
+
+// Conflict Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Conflict = Problem
 
 // Created defines model for Created.
 type Created struct {
 	Id openapi_types.UUID `json:"id" validate:"required"`
 }
 
+// Forbidden Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Forbidden = Problem
+
+// InternalServerError Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type InternalServerError = Problem
+
+// NotFound Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type NotFound = Problem
+
+// PreconditionFailed Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type PreconditionFailed = Problem
+
+// ServiceUnavailable Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type ServiceUnavailable = Problem
+
+// Unauthorized Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Unauthorized = Problem
+
+// UnsupportedMediaType Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type UnsupportedMediaType = Problem
+
+// GetStockBalancesParams defines parameters for GetStockBalances.
+type GetStockBalancesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After      *string             `form:"after,omitempty" json:"after,omitempty"`
+	ItemId     *openapi_types.UUID `form:"item_id,omitempty" json:"item_id,omitempty"`
+	LocationId *openapi_types.UUID `form:"location_id,omitempty" json:"location_id,omitempty"`
+}
+
+// GetStockItemsParams defines parameters for GetStockItems.
+type GetStockItemsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After *string `form:"after,omitempty" json:"after,omitempty"`
+
+	// Sku Only the Stock Item with the SKU, compared without regard to case
+	Sku *string `form:"sku,omitempty" json:"sku,omitempty"`
+
+	// Barcode Only the Stock Items with the barcode
+	Barcode *string `form:"barcode,omitempty" json:"barcode,omitempty"`
+}
+
+// DeleteStockItemParams defines parameters for DeleteStockItem.
+type DeleteStockItemParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutStockItemParams defines parameters for PutStockItem.
+type PutStockItemParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStockLocationsParams defines parameters for GetStockLocations.
+type GetStockLocationsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After      *string                       `form:"after,omitempty" json:"after,omitempty"`
+	NamePrefix *string                       `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+	Order      *GetStockLocationsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Type Only the Stock Locations of the type
+	Type           *StockLocationType `form:"type,omitempty" json:"type,omitempty"`
+	IncludeDeleted *bool              `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetStockLocationsParamsOrder defines parameters for GetStockLocations.
+type GetStockLocationsParamsOrder string
+
+// DeleteStockLocationParams defines parameters for DeleteStockLocation.
+type DeleteStockLocationParams struct {
+	// Cascade Delete the active descendants of the location too
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutStockLocationParams defines parameters for PutStockLocation.
+type PutStockLocationParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStockLocationChildrenParams defines parameters for GetStockLocationChildren.
+type GetStockLocationChildrenParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After          *string                              `form:"after,omitempty" json:"after,omitempty"`
+	Order          *GetStockLocationChildrenParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	IncludeDeleted *bool                                `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetStockLocationChildrenParamsOrder defines parameters for GetStockLocationChildren.
+type GetStockLocationChildrenParamsOrder string
+
+// MoveStockLocationParams defines parameters for MoveStockLocation.
+type MoveStockLocationParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RestoreStockLocationParams defines parameters for RestoreStockLocation.
+type RestoreStockLocationParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStockLotsExpiringParams defines parameters for GetStockLotsExpiring.
+type GetStockLotsExpiringParams struct {
+	// Within Days from today, such as 30d
+	Within *string `form:"within,omitempty" json:"within,omitempty"`
+}
+
+// GetStockLotSuggestionsParams defines parameters for GetStockLotSuggestions.
+type GetStockLotSuggestionsParams struct {
+	ItemId     openapi_types.UUID `form:"item_id" json:"item_id"`
+	LocationId openapi_types.UUID `form:"location_id" json:"location_id"`
+	Quantity   int64              `form:"quantity" json:"quantity"`
+}
+
+// GetStockSerialParams defines parameters for GetStockSerial.
+type GetStockSerialParams struct {
+	// ItemId Only the unit of the Stock Item
+	ItemId *openapi_types.UUID `form:"item_id,omitempty" json:"item_id,omitempty"`
+}
+
 // PostStockItemJSONRequestBody defines body for PostStockItem for application/json ContentType.
 type PostStockItemJSONRequestBody = NewStockItem
 
 // PutStockItemJSONRequestBody defines body for PutStockItem for application/json ContentType.
-type PutStockItemJSONRequestBody = NewStockItem
+type PutStockItemJSONRequestBody = UpdateStockItem
 
 // PostStockLocationJSONRequestBody defines body for PostStockLocation for application/json ContentType.
 type PostStockLocationJSONRequestBody = NewStockLocation
 
 // PutStockLocationJSONRequestBody defines body for PutStockLocation for application/json ContentType.
-type PutStockLocationJSONRequestBody = NewStockLocation
+type PutStockLocationJSONRequestBody = UpdateStockLocation
+
+// MoveStockLocationJSONRequestBody defines body for MoveStockLocation for application/json ContentType.
+type MoveStockLocationJSONRequestBody = MoveStockLocation
+
+// PostStockMovementJSONRequestBody defines body for PostStockMovement for application/json ContentType.
+type PostStockMovementJSONRequestBody = NewStockMovement
+
+// PostStockTransferJSONRequestBody defines body for PostStockTransfer for application/json ContentType.
+type PostStockTransferJSONRequestBody = NewStockTransfer
 
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// List Stock Balances
+	// (GET /stock/balances)
+	GetStockBalances(ctx echo.Context, params GetStockBalancesParams) error
+	// List Stock Items
+	// (GET /stock/items)
+	GetStockItems(ctx echo.Context, params GetStockItemsParams) error
 	// Create Stock Item
 	// (POST /stock/items)
 	PostStockItem(ctx echo.Context) error
 	// Delete Stock Item
 	// (DELETE /stock/items/{stockItemId})
-	DeleteStockItem(ctx echo.Context, stockItemId openapi_types.UUID) error
+	DeleteStockItem(ctx echo.Context, stockItemId openapi_types.UUID, params DeleteStockItemParams) error
 	// Update Stock Item
 	// (PUT /stock/items/{stockItemId})
-	PutStockItem(ctx echo.Context, stockItemId openapi_types.UUID) error
+	PutStockItem(ctx echo.Context, stockItemId openapi_types.UUID, params PutStockItemParams) error
+	// List Stock Locations
+	// (GET /stock/locations)
+	GetStockLocations(ctx echo.Context, params GetStockLocationsParams) error
 	// Create Stock Location
 	// (POST /stock/locations)
 	PostStockLocation(ctx echo.Context) error
 	// Delete Stock Location
 	// (DELETE /stock/locations/{StockLocationId})
-	DeleteStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error
+	DeleteStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params DeleteStockLocationParams) error
+	// Get Stock Location
+	// (GET /stock/locations/{StockLocationId})
+	GetStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error
 	// Update Stock Location
 	// (PUT /stock/locations/{StockLocationId})
-	PutStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error
+	PutStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params PutStockLocationParams) error
+	// List Ancestor Stock Locations
+	// (GET /stock/locations/{StockLocationId}/ancestors)
+	GetStockLocationAncestors(ctx echo.Context, stockLocationId openapi_types.UUID) error
+	// List Rolled-up Stock Balances
+	// (GET /stock/locations/{StockLocationId}/balances)
+	GetStockLocationBalances(ctx echo.Context, stockLocationId openapi_types.UUID) error
+	// List Child Stock Locations
+	// (GET /stock/locations/{StockLocationId}/children)
+	GetStockLocationChildren(ctx echo.Context, stockLocationId openapi_types.UUID, params GetStockLocationChildrenParams) error
+	// Move Stock Location
+	// (POST /stock/locations/{StockLocationId}:move)
+	MoveStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params MoveStockLocationParams) error
+	// Purge Stock Location
+	// (POST /stock/locations/{StockLocationId}:purge)
+	PurgeStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error
+	// Restore Stock Location
+	// (POST /stock/locations/{StockLocationId}:restore)
+	RestoreStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params RestoreStockLocationParams) error
+	// List Expiring Stock Lots
+	// (GET /stock/lots/expiring)
+	GetStockLotsExpiring(ctx echo.Context, params GetStockLotsExpiringParams) error
+	// Suggest Stock Lots to Issue
+	// (GET /stock/lots/suggestions)
+	GetStockLotSuggestions(ctx echo.Context, params GetStockLotSuggestionsParams) error
+	// Record Stock Movement
+	// (POST /stock/movements)
+	PostStockMovement(ctx echo.Context) error
+	// Get Stock Serial
+	// (GET /stock/serials/{serial})
+	GetStockSerial(ctx echo.Context, serial StockSerialNumber, params GetStockSerialParams) error
+	// Transfer Stock
+	// (POST /stock/transfers)
+	PostStockTransfer(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -81,6 +745,84 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// GetStockBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockBalances(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockBalancesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "item_id", ctx.QueryParams(), &params.ItemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "location_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "location_id", ctx.QueryParams(), &params.LocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter location_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockBalances(ctx, params)
+	return err
+}
+
+// GetStockItems converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockItems(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockItemsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "sku" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sku", ctx.QueryParams(), &params.Sku)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter sku: %s", err))
+	}
+
+	// ------------- Optional query parameter "barcode" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "barcode", ctx.QueryParams(), &params.Barcode)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter barcode: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockItems(ctx, params)
+	return err
+}
+
 // PostStockItem converts echo context to params.
 func (w *ServerInterfaceWrapper) PostStockItem(ctx echo.Context) error {
 	var err error
@@ -101,8 +843,28 @@ func (w *ServerInterfaceWrapper) DeleteStockItem(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stockItemId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteStockItemParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteStockItem(ctx, stockItemId)
+	err = w.Handler.DeleteStockItem(ctx, stockItemId, params)
 	return err
 }
 
@@ -117,8 +879,81 @@ func (w *ServerInterfaceWrapper) PutStockItem(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter stockItemId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutStockItemParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutStockItem(ctx, stockItemId, params)
+	return err
+}
+
+// GetStockLocations converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLocations(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockLocationsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "name_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "name_prefix", ctx.QueryParams(), &params.NamePrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter name_prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "type", ctx.QueryParams(), &params.Type)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter type: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutStockItem(ctx, stockItemId)
+	err = w.Handler.GetStockLocations(ctx, params)
 	return err
 }
 
@@ -142,8 +977,50 @@ func (w *ServerInterfaceWrapper) DeleteStockLocation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteStockLocationParams
+	// ------------- Optional query parameter "cascade" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cascade", ctx.QueryParams(), &params.Cascade)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cascade: %s", err))
+	}
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.DeleteStockLocation(ctx, stockLocationId)
+	err = w.Handler.DeleteStockLocation(ctx, stockLocationId, params)
+	return err
+}
+
+// GetStockLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLocation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockLocation(ctx, stockLocationId)
 	return err
 }
 
@@ -158,8 +1035,289 @@ func (w *ServerInterfaceWrapper) PutStockLocation(ctx echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutStockLocationParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutStockLocation(ctx, stockLocationId, params)
+	return err
+}
+
+// GetStockLocationAncestors converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLocationAncestors(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockLocationAncestors(ctx, stockLocationId)
+	return err
+}
+
+// GetStockLocationBalances converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLocationBalances(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockLocationBalances(ctx, stockLocationId)
+	return err
+}
+
+// GetStockLocationChildren converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLocationChildren(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockLocationChildrenParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "after", ctx.QueryParams(), &params.After)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter after: %s", err))
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", ctx.QueryParams(), &params.Order)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter order: %s", err))
+	}
+
+	// ------------- Optional query parameter "include_deleted" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "include_deleted", ctx.QueryParams(), &params.IncludeDeleted)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter include_deleted: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockLocationChildren(ctx, stockLocationId, params)
+	return err
+}
+
+// MoveStockLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) MoveStockLocation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MoveStockLocationParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.MoveStockLocation(ctx, stockLocationId, params)
+	return err
+}
+
+// PurgeStockLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) PurgeStockLocation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PurgeStockLocation(ctx, stockLocationId)
+	return err
+}
+
+// RestoreStockLocation converts echo context to params.
+func (w *ServerInterfaceWrapper) RestoreStockLocation(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "StockLocationId" -------------
+	var stockLocationId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, ctx.Param("StockLocationId"), &stockLocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter StockLocationId: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RestoreStockLocationParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, valueList[0], &IfMatch)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.RestoreStockLocation(ctx, stockLocationId, params)
+	return err
+}
+
+// GetStockLotsExpiring converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLotsExpiring(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockLotsExpiringParams
+	// ------------- Optional query parameter "within" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "within", ctx.QueryParams(), &params.Within)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter within: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockLotsExpiring(ctx, params)
+	return err
+}
+
+// GetStockLotSuggestions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockLotSuggestions(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockLotSuggestionsParams
+	// ------------- Required query parameter "item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "item_id", ctx.QueryParams(), &params.ItemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	// ------------- Required query parameter "location_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "location_id", ctx.QueryParams(), &params.LocationId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter location_id: %s", err))
+	}
+
+	// ------------- Required query parameter "quantity" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "quantity", ctx.QueryParams(), &params.Quantity)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter quantity: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockLotSuggestions(ctx, params)
+	return err
+}
+
+// PostStockMovement converts echo context to params.
+func (w *ServerInterfaceWrapper) PostStockMovement(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostStockMovement(ctx)
+	return err
+}
+
+// GetStockSerial converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStockSerial(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "serial" -------------
+	var serial StockSerialNumber
+
+	err = runtime.BindStyledParameterWithLocation("simple", false, "serial", runtime.ParamLocationPath, ctx.Param("serial"), &serial)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter serial: %s", err))
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStockSerialParams
+	// ------------- Optional query parameter "item_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "item_id", ctx.QueryParams(), &params.ItemId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter item_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStockSerial(ctx, serial, params)
+	return err
+}
+
+// PostStockTransfer converts echo context to params.
+func (w *ServerInterfaceWrapper) PostStockTransfer(ctx echo.Context) error {
+	var err error
+
 	// Invoke the callback with all the unmarshaled arguments
-	err = w.Handler.PutStockLocation(ctx, stockLocationId)
+	err = w.Handler.PostStockTransfer(ctx)
 	return err
 }
 
@@ -191,31 +1349,136 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/stock/balances", wrapper.GetStockBalances)
+	router.GET(baseURL+"/stock/items", wrapper.GetStockItems)
 	router.POST(baseURL+"/stock/items", wrapper.PostStockItem)
 	router.DELETE(baseURL+"/stock/items/:stockItemId", wrapper.DeleteStockItem)
 	router.PUT(baseURL+"/stock/items/:stockItemId", wrapper.PutStockItem)
+	router.GET(baseURL+"/stock/locations", wrapper.GetStockLocations)
 	router.POST(baseURL+"/stock/locations", wrapper.PostStockLocation)
 	router.DELETE(baseURL+"/stock/locations/:StockLocationId", wrapper.DeleteStockLocation)
+	router.GET(baseURL+"/stock/locations/:StockLocationId", wrapper.GetStockLocation)
 	router.PUT(baseURL+"/stock/locations/:StockLocationId", wrapper.PutStockLocation)
+	router.GET(baseURL+"/stock/locations/:StockLocationId/ancestors", wrapper.GetStockLocationAncestors)
+	router.GET(baseURL+"/stock/locations/:StockLocationId/balances", wrapper.GetStockLocationBalances)
+	router.GET(baseURL+"/stock/locations/:StockLocationId/children", wrapper.GetStockLocationChildren)
+	router.POST(baseURL+"/stock/locations/:StockLocationId:move", wrapper.MoveStockLocation)
+	router.POST(baseURL+"/stock/locations/:StockLocationId:purge", wrapper.PurgeStockLocation)
+	router.POST(baseURL+"/stock/locations/:StockLocationId:restore", wrapper.RestoreStockLocation)
+	router.GET(baseURL+"/stock/lots/expiring", wrapper.GetStockLotsExpiring)
+	router.GET(baseURL+"/stock/lots/suggestions", wrapper.GetStockLotSuggestions)
+	router.POST(baseURL+"/stock/movements", wrapper.PostStockMovement)
+	router.GET(baseURL+"/stock/serials/:serial", wrapper.GetStockSerial)
+	router.POST(baseURL+"/stock/transfers", wrapper.PostStockTransfer)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RWTU/jPBD+K9G879FN0pa9RNoDsKxUgQCB9oR6sJKh9W5iG3tCQVX++8pOP9Kmpa2W",
-	"FRV7q+1nPp+ZJ51CqgqtJEqykEzBoNVKWvSHM57d4VOJltwpVZJQ+p9c61yknISS0U+rpLuz6RgL7n79",
-	"b/AREvgvWrqO6lcbLV3ezSJBVVUMMrSpEdp5hMQFDuaRKwbnBjlhdlAS2iiNhkRdifDGj8oUnCCBshQZ",
-	"MKBXjZCAJSPkCBi8dBTXopOqDEcoO/hChneIj7yLZ56LjJMzMPhUCoNZnfrilDy4OMMN9cwLqBgMJKGR",
-	"PL9H84zmwhhlnPdV/BwU1KighlUMrhV9V6XM2ibXioL6qWJwc9kG3FyCS2xGxCq9Cy5ajSvQWj7yD6vd",
-	"Wq98Dhy6LHFyTyr9NSAs2i4lL7y/gr+Ioiwg6cbxn3PBcvrajeM2JT5cM6srVQ/NsWTmroV8VG3KfLqB",
-	"62JwejsABrlIcUZTnSucap6OMeiFMTAoTQ4JjIl0EkWTySTk/jVUZhTNTG10NTi/uL6/6PTCOBxTkbt5",
-	"IUE5bgr4jMbWuXTDOIwdVmmUXAtIoB/GYR8YaE5jX3xknX0kCAt/1qpWjk3LECxjgXdqPCmDDBK4VZaW",
-	"A1Q3DS2dqez13YRoZUbXqCFTor9oaGEv7m5zucBFjT0/iePd+IbAVgy+7GOyST/8XpdFwc3rxv669yY3",
-	"0dTOSx9kVc1QjoRtrr75+7e4qhFNtjQ3vEBCYyF5mIJwftyIAJsPbSM6rPedNfh7W6+ratjiaI8GOh10",
-	"9Jzshi7U9v3IaTe0YqDLDWvyQ2e71qSkD+z7MazkAXQfvI0fNSFt3hvrm8++XfvK6+Jbt1ViG4i/yeki",
-	"zOeV2kaJbb6i6UobDpHdrRw2pLeB2S0Da5n80xK8JG0fGd6+TiUdAQ/Hsr6fXpZX22G9UU3zyl9wt/35",
-	"WFlKuv1eH6ph9TsAAP//2U0mPm8PAAA=",
+	"H4sIAAAAAAAC/+x9W3PbuJLwX0HxOw/f1tKynPjMmfGpeXA8yax3kkwqdnYfRlkVRLYkHJOAAoC2NVn9",
+	"9y00ABK8SbJsK/EkeYlF4tIA+t6N5ucoEflCcOBaRSefoznQFCT++fKSzsz/KahEsoVmgkcn0X+BVExw",
+	"IqZEz4FIUKKQCcRECzIBooBrMqHJFWGcnE8P3lCdzKM4kvCpYBLS6ETLAuJIJXPIqRleLxcQnURKS8Zn",
+	"0Wq1iqMFlTQH7eA4n9pBWqAYAD0c1w4s83cyp3wGhCkyoQpSIvg/HayfClCaTCnLFLlhek6Oj56Rmzlw",
+	"wrRprzTNIIojZoa3WxHFEae5gTBYzVroJaiF4AoQ+Bc0fW+nNb8SwTVw/JMuFhlLqFnK4UKKSQb5v/9L",
+	"mXV9Dob/m4RpdBL9v8PqlA7tW3X4zvayk9Z35gVNiZ92FUdngk8zluwVhHJOM78EqiFdM3172oUUC5Ca",
+	"2W1k2HkqZE51dBIVBUujuLH5cXR7IOiCHSQihRnwA7jVkh5oOsMhrmnGUqpNhxIZ3Xm5Xyd/mHk+di3G",
+	"LWAVRy9vF8xMd6FFcvVaaHWnVa3bzPbIHZD8/psB4pWQE5amwPd5ouWkMZGgC8khtbRjaGsCVIIkWlwZ",
+	"YlKEC43PaZozbh8bwM+5BslpdgHyGuRLKYXc5xL89MTOTywAqzh6K/QrUfB0n8C8FZrYSVexOdeTz51n",
+	"/U5CInjKzMNXlGWwVyDD2YmbfhVHZv9YAh84vaYso5MM9gmUm50E03fhpOf3qQCLkGaWDDQg72f1Rprl",
+	"IApkV0iAL2hGeQIPR971UXtJG5uda8gfeGY75PppXws7/sPOXI7aPXncpXN0DeqaHWIbHKs2vHocqDfv",
+	"mX7HkqsHn92Nun7yC5CMZg88tx+0d+oPnBZ6LiT7c7+8KJx3Hb0nVEqGJF8TSxZ0VSwWQmpI30DK6CVq",
+	"EPtcQjk/QQCIgaBToIp06QVp60DNShap16kejqRWXrW1PRsKSVstA9OiC4jLOZBMaOIakAlMhQSiRUqX",
+	"leI2ESIDisuxDdVY8JqiZ9bYUvRWccQ05ONtlMJVHGWOkrdvrzs0+zjKKS+mNNGFhHRbOD8VlGuml7XG",
+	"jOsfjqvWjGuYgYxauqhbo4WotkVxufP15QUTfuzTVRuKtZc05R93UVGjVbkKKiVddq5BISxvxDW0hEwd",
+	"lgWVwPWYdeAT9iS+K9GC5OIaSMFTkLElfiG0pR6RM61xazYctQH2LdyU4rEN0YRKY0yoNkC/Xp6/Vd74",
+	"tNCZIWICNJkTysnL07cHP8bkw7uzg9MYfx09J0IS0/Hg6Nian5SgVUKSOSRXJGUzZg56q6MooX5hYWwf",
+	"RRwZ83dccKbb8H/gTBvwc6CqMJQ5B+Jwh0HHwgiVQBJRcMO5GHfrDPd7MOIXWkh8TTJxA5IkVEFMqCa5",
+	"UJo8G5IMtAapCOWpXa0akHPDsDkXmky86Z6SjGqQgxGPDNndvgY+0/Po5NkwjnLG/c+jbuIda0mTqy6u",
+	"dGbgx4UpXNgCpOFSMVGC6DnVBK5BLhG1cuAawdSScjUF2bElnOagCNPKDDIYcaPQu7lrO0MEJ4mxIJng",
+	"MbmChSZUGd7ebFQgT/dbQgTPlsGmjPjNnGXQhGJOUdLZFQlO5pSndufaXNZ6Mj6He3o03LCpdzer45ze",
+	"/nw0HEbWl5NcjRX7E1Q3EqomFgZLY4rQTAmysHtaop3gCeDhcMHBHUxupSZVhivsQEbvaHJ1wf7spCOF",
+	"GpFXd+preA8zpjRIhztmbjJZIlbYboQX+QTkjkg24hbLzFM7XkmcBe4e0ziUGhCDfhWk22PgiG+NguTe",
+	"GKiuiq2P5OKqMF3MOsfXIityaG//WTFhCUmAG/tNS8u6DFaYXnHJsbS4oTK122iHIgld0ITppelQFy/K",
+	"0XIKSUal38qh4d47UvSIh9LIy/+ccZYXeXQybOsCbtk3wGbzDvb9q6T5Fku13b/ypTZUBoMhjlN9DAR0",
+	"v9pAs0zcjDnMqGbXMEY0bO/YqWkVirmlR1WiBZkZU8E0+BOk6ERcv4d3Mh7PfKeA97YUG9x2puei0EQV",
+	"UhqXEOMzQ2saiFrQktnJ3NG2FuQDZ4Yhk7evzioRezQcGlKVNDFidsT9sIngWooseDcwA3wqgNBc8Jl1",
+	"1SVm/zoQJIr3KzDuoAUiZlqGxOHGUQPqhNRqhF47vqNq6B/c4azRlGwicwuN3zi232dLeQuovvDXVGmS",
+	"0iXRzq7KKbLlQhlLGJn0jF0D91qlhATYQg/IKbb2aMA04Ub6OLPMnK0x1KZMKu37GB5BsZeERBhGYqSM",
+	"OTBlFW1UzcxOK5IXSuPE+ELRHGxDwz644E1mcG9jbicPfxxdMevYBW440B+RW2kUR0ypwoBE038VSuO5",
+	"fHwAdBYcxPRnv6E4CQmmWN3VKN1x3c6Q3cbZ9BZ1lB4rt46LvwRoeEMVyWkKa3HwTig24o+DY6Eh3vBw",
+	"C8WQ8U2F9BBaCwUPTsVEsRmHFN9Xp6hiR0tGYgzIWWkaoTgedAnBhuDb/lS54GYWPFRVufy29OTZs1Ve",
+	"p+ixBWuSkamarVfTrDsMIYFnZV6YgzTaOkGN3/GXUtVlyp243ajasLGRd8m81Pm9hhuGZytL84524RrP",
+	"Sug8QUbR8KF4xn3pVPQ2455KkY/3Qc+PzSd34hdrPVyl2nd0D+wv2epM/zy8Pw1osYfD6kW4FrK0AGrg",
+	"n3cmt/mWfUFS0JjPQBVJYcoMp5osyftXZ+QfPw7/MSAXIgfiHNkKfeNLArcaOOZL5IAbQzjcaqIt2Qk9",
+	"L7VHfIDe8duS8t1gMVGF8XWFxqlhBYaxW3qlE1FoS611kjFb2aXemUgeYSlwzaYMZGNC5MBJxswhY7qJ",
+	"pBy9AVFcyvYJTccuDhAZfhdEKpAju7B57M/NbPrUhjXjiAs9nmJENo5y0HORjs0jNDCwQVG578e5cd+P",
+	"EVfiKPHZDmalVcS0Gpq5yPMYMOQcRz7eaF4hLOOkkErI4AFaMhVuoC7Z+9YB0vO2tF6qFs6irh44W7M5",
+	"BuJu59z4xpprHS+c77T7pXfRtN+U/qIojtLCxj2ge9zO127kgl9xccM3zullU+uFd8lEcRTug0GHMuQd",
+	"R41t3uK1x6/GoPXHZQ+X1jTOmcp97lF7zBQysGZN4yXj40J1AUJzGGt6Bbz9ztlfyTLJoPfthoXOqRon",
+	"c5alEnj32zlTWshl+6W4Bhmia7BPvZvhEbGxJHxsJsOf7T0PfcVtytFj67jrfIUqaAC8HpfsPnzYt026",
+	"88z9jK2TcfP1LNwMFXgom+DaV9VimlTTfF97ilpge17fp3N5wcv6CmszNRbp3jHeOKxgMMZLLMFFqmI6",
+	"ZYmRBuNKYnao/lZAdqQP3i4yynE8q9uTeZFT7hVRY2K7TMIJ6BsATiRkQBUefGsW5OsdPu5XDLJUVemS",
+	"LtvEOIInUlyBsZQK3L2t/NVO6uOoXa5qxpWmPOmQru+onjfA6FqH0lQXKgiABi5JzXQGwau2t6Tpc7uh",
+	"S6cGnEwyyq+sLYHjEGYVh/+4vHxH7KzEaBnRJu3dSToLTAlwbJWKQGWye9RW1/3jOqz/efH7W4zo+D2y",
+	"7Tp2SAJVXYbx+yKDqqc93UpH8isgQpLSdEGn16b1ekDcvB8bGUrdMd1HDo433FMisOl9BCwMitIJ5gUb",
+	"KisVzKCBeszQea9m3Urzuk9kvHYiHXRp9Guv4XUEMcxzj3imKVnQGZT75vKaM6rsi2gbA1dVi9wc3C7X",
+	"2EPZPTHlVuvtsSiM0vYHKls96+HExwrx9catWvDcJzg1tL4NHsRftnAb7RAa2nGidm50HEZnQlyIK2yq",
+	"nVF9g+qQ19GgdgQ1zPUJDpghojVIs8r/+f9/DA9++vj5x9X/2j+OnsVHx6t/+1sXurUxoEUM/f7BF95D",
+	"pXBHzeLIXGSpqnj70TNkbUeY8EB+Nr9NnLozEEZvnUdkiP8CH8mzvqPenL3h7WSaXMVE3T0L437uNIcB",
+	"bebqg7g9gZwrgIUJdFkkVdtHwoyTdbFwSxuQU7e0H4790uIRtwvD5u5OiIt8kQMyJgNyaDbt9OLs/HxT",
+	"KAwlVMvn+MPxxlyUeirvvaWLGemrEy13D8s+QmjV27+do28pktZInK3jkXNmeQRTZEGlodCa6tMISe4v",
+	"ColjO57ttOfKZdB5Tq3zPQvOqKH8sdxl8dwYo6YKDZT7wrSCbOqZpifwdkPlYvDM+AxPq+SCzMxQZfGb",
+	"bNhlTAqOz9dkuiDxb5Ox8NrwScNoqJvLwlElivlMAcOxVPB8UmgiYVooUGRaSFRrayEk70VShPEuR2hO",
+	"b1GArkuOolkWciKixQzMTGvzLI66hImZbWtdJSbGV13GYVzHdvRH7QbHWvWlObdt/ABzt24NXHYarv/d",
+	"h8nKEHJMKLmhEuaiUHB/7Dsl10zqgmZVsgKSisMxdBW4RHBmRl2SxXypWEIzsshoEtiYtgPjFu2Yc717",
+	"13gJchRHfwpu/psw64GhMxvp+FRQaYQ4vnRQdfpU2jcv7i3eqmsiX52I8+Guuxu+flhv+uaIFZZlpP32",
+	"cJVLu0EdqvS6H45DHWdN4s+I/25i9WWAvgzCU0yhsamsu+k67tbK3XJbTkMBiftoEL6Wp7JNgL8va/8+",
+	"bgSbd9/Wa8M7Pw+A93bTtk+lD+KaD+r82aDcuHg8U5gT4IjLJoSykEN5B+7G6X3spUP4+TSpWrKrzxkQ",
+	"MgUknCW5AQllTsGdcn7t/vl5+l0CXZe8+5xNrke4ssZ59ad/bXlkPpepcefHZl84io5t5kocZKvEpSYy",
+	"NmwhVE3GjD+EY7DfgD6zTnTMR52LLMgHyWkKPug8sb6z2CqSxgEfYpwVVzt5LFxSxyZHYJgn0EEMYSq3",
+	"Zfk+F4aGSdch7y+sVbmosfbH5ey7se16isT6tTeyz81RYgaiXWeYH+S3OMjZH5D37nSQ3Vf7NuLVDqkt",
+	"RSQ5DSSYzcGvZSJ4eC0C6TmMuM1Cw8wBs9uM/zMYO69YjpGP5mc5MKRuvaIo19fmj0u8JOMV/buyoiql",
+	"psmImlc97y1y7Fh3kDj2suFOF6XeAyqpjsZtq/48cnv0iFX2BeQLm7XwUHeivl8QekIXhELkqdIKHx19",
+	"vt8F+n4X6PtdoK/gLlB5fSIQQHu8CNS7xu83hL7kDaEHvZqDLD0pJNPLC9PXotJpmjN+iVUj2rUNzGOz",
+	"YVM2K/zRkNNf3py/HV/+/tvLt97jpLDIkC8XhtiC5Sgq7JlrvbBOScanos8GR8Z6+u48iqOMJcAVrt5i",
+	"S3S6oMkcyLPBMIqjQmZu1JPDw5ubmwHFtwMhZ4euqzp8fX728u3Fy4Nng+FgrvMsyOxpT+hy7qKT6Ggw",
+	"HAxNW7EAThcsOomeD4aD5xjw1XPctkOkucNJkFQxg66EEaZ07bq5I8C6rYQ4XUe2mPhmmSg18W7nmwkC",
+	"oqfAJkSHlll9TBzSX5Mi1stoHIXM5oYhdhs2g7/OU8NxQdeTR+ol6/74bAvIfSoAMxzdSWFooVY8LoUp",
+	"LTLtIq5BZHiDEzvucYGWVUSoIoHL1CzfplHDNROF8l7QLiDpVIOsAdlyfnSvLvCClF031V/oGapuqm8/",
+	"3MdG7b1nw2EfmyjbNcsyxdHxNr2Csn6rOPr7Nl26Sp9h3+dbANkut4WMq8hzKpeeoCxaV2tZxZ4gS/W3",
+	"nxq7w94kpKDfPvSQiLv+ZYbAO1vlxYGL3z4YIqweOAuwLHNjTWa8N7aGznyo55sisqaHz21wwB7DbY6x",
+	"uBkNNQUJMypRE0io6gPF5vPcDxDVOt+eyaq3/RPuTsSuwtnTp+ByIQuhOsjVFqMMfYrkPeKkIsfDnyxp",
+	"Ud4mZkIzCTRdoo3m0GbQorl3QlVE5wq3gtIvRLp8sDJjtaI7jctSWhawauHA0eYdDmp07oAAx8Oftpgi",
+	"qGl6fPT3zR06y419FejWQqKWuDj8rPwZnacri4YZ6A4L5Rd8Ho7VRCrbIkSrLlZu1MiAM1Wzr60fvIWS",
+	"0bVXFQCHvsDwbrzn9992RrnjzV3KwqSIcs82d+goF/pVIFwbSQyDK7qSG9HW38TfrNN+VyZX6KeBjA/P",
+	"e5uu/K3Y7xbIY8dN90YLd+fXOxPPE2f1LXoKWX0W5vD0WwfNRL3ALDAU0mMX9Cr05UDfLecT/G+8kDBl",
+	"t7t0x6Po3pyIqiRIALO/zPo7cro2avrB4Vu3S3njuA2Te7VDuV3vquteK+NJVqQQXnhtr3pKMwVtB+09",
+	"LIsKXf8C1kVtMVtYGJXrDSsrkTIfOqzMgRb8FnaIH2zEm3LanLD17h0Ph1UZXDtblfwLt2YlQppJHRYM",
+	"RrzfinldJVs/piVTr3H93Zr5ktZMcBZtMXf4uXZeGyybCzHVB2moufqOA4IKrUsar5JAbK6uR26HoUQV",
+	"SQKQltk7I45BVNOb2ewCphXB75koba7M+lpHJsvFD1LOklBu4rAS3JWZgmuWOUJcFHKGJFEnRR+pLccw",
+	"ZOco0ywZeEqxplHBM1CKJFQlNAU3t3Kpq4rcQJZ10Vtg4AUUt1mvbhzGvnTruMeEDZygwa5UcQZ/zEL0",
+	"CD63b3sQTHs0O/eoan9ldmqYk96pHP8KTbG6UefdO13cX+/ZF6Z9+dPvOM+t3BSVWNjOVfE65MNe/XHl",
+	"0pzKw1SVaePd65iUaCsTdWo9hX4yLPjx3Rt308m+uzj+Ki6OO+p/hxgt1a54y/oAqaGc6moa3uZMxQ33",
+	"OeyOcluZVbHNQ+y5BLpeXJyW0D09uaG+HcGBKOLPqm1pb4eJD5U3w3hXiovNNfXzl3dtY2JWYcyIxWDE",
+	"X2IjB0j5JR2D3CxtIzahigTZGoPRZnzekDPzNaLzPbNDnio2vxdZBulBsViTWLIOm8sCcBvZatPBmDIJ",
+	"ic6WzuPUZKYP5Hw+qwrU7V1L+va83A/opv4LeYafKm9A2tlRzJ0YK8YA0u18foc3PupDN13PhgcQd0W/",
+	"+vBT+TqoFR0Tmgk+szwCy4xXfp2al6ztc3b16bJGGYt6seua80xIO0hQrWLEuzzW5I24brgOb+Yg8SKt",
+	"9x0y1XIbksBr2CVr29/Y+vYMwPYePFXz75u15swR7mbLnaD7ew1vAZlTA0/mffOEuj/SlicH45+26L+R",
+	"tkpLqoW5F3EZcgX8PsGcXgOZgEli9aPZu6ZG765acGDoCqoXleFCEtSVykFVXAKFXznQMTaifDni5TXV",
+	"OCguU16mVbFR83mQX5sBzuyuZMX2susNU4AeKqzIb5WCboeSnMET9F7ewzl+tA3lBFXFsdMWWF99snsv",
+	"vqAvRsXuLg2iRniL5o+Pq48hkSNq7UjlLvLVT+cfeGo9MkhGhkzFtMNTa0bpieDZWond0buGGN7F40vx",
+	"M+laWQPG1bXIgeLVxXjEqzi4La+hAqVkTdjbLumJ6QDf3bBfUfjLYdBawtTqENyHYDfb9nVZV93YUpbU",
+	"cKDaB9ntR4RSEwUXU/vF4LjeacRRmnpF2X9j2JmAaVzeD2vfH/PCuzG9FdaJyN2f6x1ZWvnv4LZpq/Wt",
+	"JOX8xXYdvlLX82HaY2DbnegxlG23oAqqq30aH6/Svz2U8Gx/NfhJZz355ZSYoFULnVUxm4Fanwd5YdtU",
+	"qLim8BfRwn/2q7pljHjAOKFNh5YvTOIR2f4yn06xwGPuh1Z4VcrWxXLwGkuyJX2qiiam04ibWnIEkzr0",
+	"nPLaxecNaH4R7MpWeZrVHcCHd47V7gQ++PB+R7Ybe6tKg/dxKvmP/3+lEu7LU7YnxoqmDcmdG5ILibtW",
+	"YaxbVXyPxcMIbRTPIrWPvVlar0dYmmSMlRzdIFa4lEmL06p2WVh+obvApK98hB6r4+FPa9Mb31Sfr3nM",
+	"9MZymu/pjV9GKUMctbgSnEWJ5874P/xs/1j1CjGT31LVixHT7tplwYXORt0aX+iramsL+trPNjaQGet+",
+	"YblAW7Xb1QtDgtxUy29AbJGqEbdTW+nXWVIN8ydzgZ8PxzI1tftA5jMuqC6a2exY68TehS/ht8WdIN+0",
+	"X2LcsfTXmhz87i8vRvEGObyP8Khdg/oWk8R8IbWKEkufXL/EQfdmoBc2ZQvqiYK3yEmL0rOA4kcxPsvA",
+	"egFpUoogD8H2MigFpV3Q9M4C6LL6TNpjCqBymu8C6EtgvN9+i5L2EGx1G8sda3VnjKqezYXSJ0fPnz2P",
+	"Vh9X/zcAFdT6yWWUAAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,97 @@
+// Package memory is a database kept in the memory of the process, for development and tests.
+// Its tables are maps guarded by a single lock, so a transaction sees every table as of its start
+// and nobody sees its writes before it commits.
+package memory
+
+import "sync"
+
+type DB struct {
+	mu     *sync.RWMutex
+	tables *tables
+	// undo reverts the writes of the transaction, nil outside of one.
+	undo *[]func()
+}
+
+type tables struct {
+	mu sync.Mutex
+	m  map[string]any
+}
+
+func Open() *DB {
+	return &DB{
+		mu:     &sync.RWMutex{},
+		tables: &tables{m: map[string]any{}},
+	}
+}
+
+// Table returns the table of db with the name, creating it on first use.
+func Table[K comparable, V any](db *DB, name string) map[K]V {
+	db.tables.mu.Lock()
+	defer db.tables.mu.Unlock()
+
+	t, ok := db.tables.m[name]
+	if !ok {
+		t = map[K]V{}
+		db.tables.m[name] = t
+	}
+	return t.(map[K]V)
+}
+
+// Read runs fn while nobody writes. In a transaction the lock is already held.
+func (db *DB) Read(fn func()) {
+	if db.undo == nil {
+		db.mu.RLock()
+		defer db.mu.RUnlock()
+	}
+	fn()
+}
+
+// Write runs fn while nobody else reads or writes. fn returns how to revert its write,
+// which a transaction keeps until it is over, or an error when it has written nothing.
+func (db *DB) Write(fn func() (undo func(), err error)) error {
+	if db.undo == nil {
+		db.mu.Lock()
+		defer db.mu.Unlock()
+	}
+
+	undo, err := fn()
+	if err != nil {
+		return err
+	}
+
+	if db.undo != nil && undo != nil {
+		*db.undo = append(*db.undo, undo)
+	}
+	return nil
+}
+
+// Begin starts a transaction, waiting until the transaction before it is over.
+// The returned DB reads and writes in the transaction and must be ended with Commit or Rollback.
+func (db *DB) Begin() *DB {
+	db.mu.Lock()
+	return &DB{
+		mu:     db.mu,
+		tables: db.tables,
+		undo:   &[]func(){},
+	}
+}
+
+func (tx *DB) Commit() {
+	tx.end()
+}
+
+// Rollback reverts the writes of the transaction, the last one first.
+func (tx *DB) Rollback() {
+	for i := len(*tx.undo) - 1; i >= 0; i-- {
+		(*tx.undo)[i]()
+	}
+	tx.end()
+}
+
+func (tx *DB) end() {
+	if tx.undo == nil {
+		panic("memory: not in a transaction")
+	}
+	tx.undo = nil
+	tx.mu.Unlock()
+}
@@ -0,0 +1,122 @@
+package item
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/infra/repository/memory"
+)
+
+type Repository struct {
+	item.IRepository
+	db   *memory.DB
+	rows map[item.Id]item.Aggregate
+}
+
+// NewRepository returns a repository that keeps the stock items in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+func NewRepository(db *memory.DB) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db:   db,
+		rows: memory.Table[item.Id, item.Aggregate](db, "stock_items"),
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *item.Aggregate) error {
+	return r.db.Write(func() (func(), error) {
+		before, found := r.rows[a.Id]
+
+		if a.Version() == 0 {
+			if found {
+				return nil, fmt.Errorf("Save: stock item %s already exists", a.Id)
+			}
+		} else if !found || before.Version() != a.Version() {
+			// compare-and-swap on the version read by Get
+			return nil, item.ErrVersionMismatch
+		}
+
+		// the SKUs of active items are unique, as in the partial unique index of the SQL databases
+		if !a.IsDeleted() {
+			for _, other := range r.rows {
+				if !other.IsDeleted() && other.Sku == a.Sku && other.Id != a.Id {
+					return nil, item.ErrSkuTaken
+				}
+			}
+		}
+
+		*a = *item.RestoreAggregate(a.Id, a.Sku, a.Name, a.BaseUnit(), a.Barcodes(), a.PackSizes(), a.UnitVolume, a.UnitWeight, a.LotTracked, a.Serialized, a.IsDeleted(), a.Version()+1)
+		r.rows[a.Id] = *a
+
+		return func() {
+			if found {
+				r.rows[a.Id] = before
+			} else {
+				delete(r.rows, a.Id)
+			}
+		}, nil
+	})
+}
+
+func (r *Repository) Get(ctx context.Context, id item.Id) (*item.Aggregate, error) {
+	var (
+		a     item.Aggregate
+		found bool
+	)
+	r.db.Read(func() {
+		a, found = r.rows[id]
+	})
+
+	if !found {
+		return &item.Aggregate{}, item.ErrNotFound
+	}
+
+	return &a, nil
+}
+
+func (r *Repository) Find(ctx context.Context, id item.Id) (bool, error) {
+	var found bool
+	r.db.Read(func() {
+		_, found = r.rows[id]
+	})
+
+	return found, nil
+}
+
+func (r *Repository) List(ctx context.Context, q item.ListQuery) ([]*item.Aggregate, error) {
+	as := []*item.Aggregate{}
+	r.db.Read(func() {
+		for _, data := range r.rows {
+			if data.IsDeleted() {
+				continue
+			}
+			if q.Sku != nil && data.Sku != *q.Sku {
+				continue
+			}
+			if q.Barcode != nil && !slices.Contains(data.Barcodes(), *q.Barcode) {
+				continue
+			}
+			if q.After != nil && data.Sku.String() <= q.After.String() {
+				continue
+			}
+
+			a := data
+			as = append(as, &a)
+		}
+	})
+
+	sort.Slice(as, func(i, j int) bool {
+		return as[i].Sku.String() < as[j].Sku.String()
+	})
+
+	if len(as) > q.Limit {
+		as = as[:q.Limit]
+	}
+
+	return as, nil
+}
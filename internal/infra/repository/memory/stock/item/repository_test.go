@@ -0,0 +1,34 @@
+package item_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/infra/repository/memory"
+	sut "openapi/internal/infra/repository/memory/stock/item"
+	"openapi/internal/infra/repository/repositorytest"
+)
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestItemRepository(t, func(t *testing.T) item.IRepository {
+		r, err := sut.NewRepository(memory.Open())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,244 @@
+package location
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/infra/repository/memory"
+)
+
+type Repository struct {
+	location.IRepository
+	db   *memory.DB
+	rows map[location.Id]row
+}
+
+type row struct {
+	location.Aggregate
+	// deletedAt is when the location has been deleted, zero while it is not.
+	deletedAt time.Time
+}
+
+// NewRepository returns a repository that keeps the stock locations in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+func NewRepository(db *memory.DB) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db:   db,
+		rows: memory.Table[location.Id, row](db, "stock_locations"),
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *location.Aggregate) error {
+	return r.db.Write(func() (func(), error) {
+		before, found := r.rows[a.Id]
+
+		if a.Version() == 0 {
+			if found {
+				return nil, fmt.Errorf("Save: stock location %s already exists", a.Id)
+			}
+		} else if !found || before.Version() != a.Version() {
+			// compare-and-swap on the version read by Get
+			return nil, location.ErrVersionMismatch
+		}
+
+		// the names of active locations are unique, as in the partial unique index of the SQL databases
+		if !a.IsDeleted() {
+			if other, ok := r.findByName(a.Name); ok && other.Id != a.Id {
+				return nil, location.ErrNameTaken
+			}
+		}
+
+		*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+
+		after := row{Aggregate: *a}
+		if a.IsDeleted() {
+			// a location deleted again keeps the time it was deleted first
+			after.deletedAt = before.deletedAt
+			if after.deletedAt.IsZero() {
+				after.deletedAt = time.Now()
+			}
+		}
+		r.rows[a.Id] = after
+
+		return func() {
+			if found {
+				r.rows[a.Id] = before
+			} else {
+				delete(r.rows, a.Id)
+			}
+		}, nil
+	})
+}
+
+func (r *Repository) Get(ctx context.Context, id location.Id) (*location.Aggregate, error) {
+	var (
+		data  row
+		found bool
+	)
+	r.db.Read(func() {
+		data, found = r.rows[id]
+	})
+
+	if !found {
+		return &location.Aggregate{}, location.ErrNotFound
+	}
+
+	a := data.Aggregate
+	return &a, nil
+}
+
+func (r *Repository) Find(ctx context.Context, id location.Id) (bool, error) {
+	var found bool
+	r.db.Read(func() {
+		_, found = r.rows[id]
+	})
+
+	return found, nil
+}
+
+func (r *Repository) FindByName(ctx context.Context, name location.Name) (*location.Aggregate, error) {
+	var (
+		data  row
+		found bool
+	)
+	r.db.Read(func() {
+		data, found = r.findByName(name)
+	})
+
+	if !found {
+		return nil, location.ErrNotFound
+	}
+
+	a := data.Aggregate
+	return &a, nil
+}
+
+// findByName returns the active row with the name. The caller holds the lock.
+func (r *Repository) findByName(name location.Name) (row, bool) {
+	for _, data := range r.rows {
+		if !data.IsDeleted() && data.Name == name {
+			return data, true
+		}
+	}
+	return row{}, false
+}
+
+func (r *Repository) List(ctx context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+	as := []*location.Aggregate{}
+	r.db.Read(func() {
+		for _, data := range r.rows {
+			if q.DeletedBefore != nil {
+				if !data.IsDeleted() || !data.deletedAt.Before(*q.DeletedBefore) {
+					continue
+				}
+			} else if data.IsDeleted() && !q.IncludeDeleted {
+				continue
+			}
+			if q.ParentId != nil && (data.ParentId() == nil || *data.ParentId() != *q.ParentId) {
+				continue
+			}
+			if q.Type != nil && data.Type != *q.Type {
+				continue
+			}
+			if !strings.HasPrefix(data.Name.String(), q.NamePrefix) {
+				continue
+			}
+			if q.After != nil && !follows(data.Aggregate, *q.After, q.Order) {
+				continue
+			}
+
+			a := data.Aggregate
+			as = append(as, &a)
+		}
+	})
+
+	sort.Slice(as, func(i, j int) bool {
+		return follows(*as[j], location.Cursor{Name: as[i].Name, Id: as[i].Id}, q.Order)
+	})
+
+	if len(as) > q.Limit {
+		as = as[:q.Limit]
+	}
+
+	return as, nil
+}
+
+func (r *Repository) Ancestors(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	as := []*location.Aggregate{}
+	r.db.Read(func() {
+		data, found := r.rows[id]
+		for found && data.ParentId() != nil {
+			data, found = r.rows[*data.ParentId()]
+			if found {
+				a := data.Aggregate
+				as = append(as, &a)
+			}
+		}
+	})
+
+	// root first
+	for i, j := 0, len(as)-1; i < j; i, j = i+1, j-1 {
+		as[i], as[j] = as[j], as[i]
+	}
+
+	return as, nil
+}
+
+func (r *Repository) Descendants(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	as := []*location.Aggregate{}
+	r.db.Read(func() {
+		children := map[location.Id][]location.Aggregate{}
+		for _, data := range r.rows {
+			if p := data.ParentId(); p != nil {
+				children[*p] = append(children[*p], data.Aggregate)
+			}
+		}
+
+		queue := []location.Id{id}
+		for len(queue) > 0 {
+			for _, child := range children[queue[0]] {
+				a := child
+				as = append(as, &a)
+				queue = append(queue, child.Id)
+			}
+			queue = queue[1:]
+		}
+	})
+
+	return as, nil
+}
+
+func (r *Repository) Purge(ctx context.Context, id location.Id) error {
+	return r.db.Write(func() (func(), error) {
+		before, found := r.rows[id]
+		if !found {
+			return nil, location.ErrNotFound
+		}
+
+		delete(r.rows, id)
+
+		return func() {
+			r.rows[id] = before
+		}, nil
+	})
+}
+
+// follows reports whether a comes after the cursor, ordered by name and then by id.
+func follows(a location.Aggregate, c location.Cursor, order location.Order) bool {
+	cmp := strings.Compare(a.Name.String(), c.Name.String())
+	if cmp == 0 {
+		cmp = strings.Compare(a.Id.String(), c.Id.String())
+	}
+
+	if order == location.OrderDesc {
+		return cmp < 0
+	}
+	return cmp > 0
+}
@@ -0,0 +1,98 @@
+package lot
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/infra/repository/memory"
+)
+
+type Repository struct {
+	lot.IRepository
+	db   *memory.DB
+	rows map[lot.Id]lot.Aggregate
+}
+
+// NewRepository returns a repository that keeps the lots in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+func NewRepository(db *memory.DB) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db:   db,
+		rows: memory.Table[lot.Id, lot.Aggregate](db, "stock_lots"),
+	}, nil
+}
+
+// Save records the lot. Recorded lots are never updated.
+func (r *Repository) Save(ctx context.Context, a *lot.Aggregate) error {
+	return r.db.Write(func() (func(), error) {
+		if _, found := r.rows[a.Id]; found {
+			return nil, fmt.Errorf("Save: stock lot %s already exists", a.Id)
+		}
+
+		// the numbers of the lots of an item are unique, as in the unique constraint of the SQL databases
+		for _, other := range r.rows {
+			if other.ItemId == a.ItemId && other.Number == a.Number {
+				return nil, lot.ErrNumberTaken
+			}
+		}
+
+		r.rows[a.Id] = *a
+
+		return func() {
+			delete(r.rows, a.Id)
+		}, nil
+	})
+}
+
+func (r *Repository) Get(ctx context.Context, itemId item.Id, number lot.Number) (*lot.Aggregate, error) {
+	var (
+		a     lot.Aggregate
+		found bool
+	)
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if row.ItemId == itemId && row.Number == number {
+				a, found = row, true
+				return
+			}
+		}
+	})
+
+	if !found {
+		return &lot.Aggregate{}, lot.ErrNotFound
+	}
+
+	return &a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q lot.ListQuery) ([]*lot.Aggregate, error) {
+	as := []*lot.Aggregate{}
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if q.ItemId != nil && row.ItemId != *q.ItemId {
+				continue
+			}
+			if q.ExpiresBy != nil && (row.ExpiresOn.IsZero() || q.ExpiresBy.Before(row.ExpiresOn)) {
+				continue
+			}
+
+			a := row
+			as = append(as, &a)
+		}
+	})
+
+	sort.Slice(as, func(i, j int) bool {
+		if as[i].ExpiresOn != as[j].ExpiresOn || as[i].Number != as[j].Number {
+			return lot.ExpiresFirst(as[i], as[j])
+		}
+		return as[i].ItemId.String() < as[j].ItemId.String()
+	})
+
+	return as, nil
+}
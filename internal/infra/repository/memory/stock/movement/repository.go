@@ -0,0 +1,180 @@
+package movement
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/infra/repository/memory"
+)
+
+type Repository struct {
+	movement.IRepository
+	db   *memory.DB
+	rows map[movement.Id]movement.Aggregate
+	// seqs numbers the movements in the order they were recorded.
+	seqs map[movement.Id]int
+}
+
+// NewRepository returns a repository that keeps the ledger in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+func NewRepository(db *memory.DB) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db:   db,
+		rows: memory.Table[movement.Id, movement.Aggregate](db, "stock_movements"),
+		seqs: memory.Table[movement.Id, int](db, "stock_movement_seqs"),
+	}, nil
+}
+
+// Save appends the movement to the ledger. Recorded movements are never updated.
+func (r *Repository) Save(ctx context.Context, a *movement.Aggregate) error {
+	return r.db.Write(func() (func(), error) {
+		if _, found := r.rows[a.Id]; found {
+			return nil, fmt.Errorf("Save: stock movement %s already exists", a.Id)
+		}
+
+		row := *a
+		row.Serials = slices.Clone(a.Serials)
+		r.seqs[a.Id] = len(r.rows)
+		r.rows[a.Id] = row
+
+		return func() {
+			delete(r.rows, a.Id)
+			delete(r.seqs, a.Id)
+		}, nil
+	})
+}
+
+func (r *Repository) GetBalance(ctx context.Context, itemId item.Id, locationId location.Id, lotNumber lot.Number) (movement.Balance, error) {
+	b := movement.Balance{
+		ItemId:     itemId,
+		LocationId: locationId,
+		Lot:        lotNumber,
+	}
+
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if row.ItemId == itemId && row.LocationId == locationId && row.Lot == lotNumber {
+				b.Quantity += row.Quantity.Int64()
+			}
+		}
+	})
+
+	return b, nil
+}
+
+func (r *Repository) ListBalances(ctx context.Context, q movement.BalanceQuery) ([]movement.Balance, error) {
+	type key struct {
+		itemId     item.Id
+		locationId location.Id
+		lot        lot.Number
+	}
+
+	sums := map[key]int64{}
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if q.ItemId != nil && row.ItemId != *q.ItemId {
+				continue
+			}
+			if q.LocationId != nil && row.LocationId != *q.LocationId {
+				continue
+			}
+			if q.LocationIds != nil && !slices.Contains(q.LocationIds, row.LocationId) {
+				continue
+			}
+			sums[key{row.ItemId, row.LocationId, row.Lot}] += row.Quantity.Int64()
+		}
+	})
+
+	bs := make([]movement.Balance, 0, len(sums))
+	for k, quantity := range sums {
+		if quantity == 0 {
+			continue
+		}
+		bs = append(bs, movement.Balance{
+			ItemId:     k.itemId,
+			LocationId: k.locationId,
+			Lot:        k.lot,
+			Quantity:   quantity,
+		})
+	}
+
+	sort.Slice(bs, func(i, j int) bool {
+		if bs[i].ItemId != bs[j].ItemId {
+			return bs[i].ItemId.String() < bs[j].ItemId.String()
+		}
+		if bs[i].LocationId != bs[j].LocationId {
+			return bs[i].LocationId.String() < bs[j].LocationId.String()
+		}
+		return bs[i].Lot.String() < bs[j].Lot.String()
+	})
+
+	if q.After != nil {
+		after := *q.After
+		i := sort.Search(len(bs), func(i int) bool { return follows(bs[i], after) })
+		bs = bs[i:]
+	}
+
+	if q.Limit > 0 && len(bs) > q.Limit {
+		bs = bs[:q.Limit]
+	}
+
+	return bs, nil
+}
+
+// follows reports whether b comes after the balance c points at.
+func follows(b movement.Balance, c movement.BalanceCursor) bool {
+	if b.ItemId != c.ItemId {
+		return b.ItemId.String() > c.ItemId.String()
+	}
+	if b.LocationId != c.LocationId {
+		return b.LocationId.String() > c.LocationId.String()
+	}
+	return b.Lot.String() > c.Lot.String()
+}
+
+func (r *Repository) ListBySerial(ctx context.Context, itemId item.Id, number serial.Number) ([]*movement.Aggregate, error) {
+	as := []*movement.Aggregate{}
+	seqs := map[*movement.Aggregate]int{}
+	r.db.Read(func() {
+		for id, row := range r.rows {
+			if row.ItemId != itemId || !slices.Contains(row.Serials, number) {
+				continue
+			}
+
+			a := row
+			a.Serials = slices.Clone(row.Serials)
+			as = append(as, &a)
+			seqs[&a] = r.seqs[id]
+		}
+	})
+
+	sort.Slice(as, func(i, j int) bool {
+		return seqs[as[i]] < seqs[as[j]]
+	})
+
+	return as, nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	var found bool
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if row.LocationId == locationId {
+				found = true
+				return
+			}
+		}
+	})
+
+	return found, nil
+}
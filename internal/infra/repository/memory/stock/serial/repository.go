@@ -0,0 +1,116 @@
+package serial
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/infra/repository/memory"
+)
+
+type Repository struct {
+	serial.IRepository
+	db   *memory.DB
+	rows map[serial.Id]serial.Aggregate
+}
+
+// NewRepository returns a repository that keeps the serials in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+func NewRepository(db *memory.DB) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db:   db,
+		rows: memory.Table[serial.Id, serial.Aggregate](db, "stock_serials"),
+	}, nil
+}
+
+// Save inserts the serial when it is new, and otherwise updates where it is.
+func (r *Repository) Save(ctx context.Context, a *serial.Aggregate) error {
+	return r.db.Write(func() (func(), error) {
+		before, found := r.rows[a.Id]
+		if !found {
+			// the numbers of the serials of an item are unique, as in the unique constraint of the SQL databases
+			for _, other := range r.rows {
+				if other.ItemId == a.ItemId && other.Number == a.Number {
+					return nil, serial.ErrNumberTaken
+				}
+			}
+		}
+
+		r.rows[a.Id] = *a
+
+		return func() {
+			if found {
+				r.rows[a.Id] = before
+			} else {
+				delete(r.rows, a.Id)
+			}
+		}, nil
+	})
+}
+
+func (r *Repository) Get(ctx context.Context, itemId item.Id, number serial.Number) (*serial.Aggregate, error) {
+	var (
+		a     serial.Aggregate
+		found bool
+	)
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if row.ItemId == itemId && row.Number == number {
+				a, found = row, true
+				return
+			}
+		}
+	})
+
+	if !found {
+		return &serial.Aggregate{}, serial.ErrNotFound
+	}
+
+	return &a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q serial.ListQuery) ([]*serial.Aggregate, error) {
+	as := []*serial.Aggregate{}
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if q.Number != nil && row.Number != *q.Number {
+				continue
+			}
+			if q.ItemId != nil && row.ItemId != *q.ItemId {
+				continue
+			}
+
+			a := row
+			as = append(as, &a)
+		}
+	})
+
+	sort.Slice(as, func(i, j int) bool {
+		if as[i].ItemId != as[j].ItemId {
+			return as[i].ItemId.String() < as[j].ItemId.String()
+		}
+		return as[i].Number.String() < as[j].Number.String()
+	})
+
+	return as, nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	var found bool
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if id, ok := row.LocationId(); ok && id == locationId {
+				found = true
+				return
+			}
+		}
+	})
+
+	return found, nil
+}
@@ -0,0 +1,34 @@
+package serial_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/infra/repository/memory"
+	sut "openapi/internal/infra/repository/memory/stock/serial"
+	"openapi/internal/infra/repository/repositorytest"
+)
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestSerialRepository(t, func(t *testing.T) serial.IRepository {
+		r, err := sut.NewRepository(memory.Open())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,147 @@
+package transaction
+
+import (
+	"context"
+	"fmt"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/domain/stock/transfer"
+	"openapi/internal/infra/repository/memory"
+	itemInfra "openapi/internal/infra/repository/memory/stock/item"
+	locationInfra "openapi/internal/infra/repository/memory/stock/location"
+	lotInfra "openapi/internal/infra/repository/memory/stock/lot"
+	movementInfra "openapi/internal/infra/repository/memory/stock/movement"
+	serialInfra "openapi/internal/infra/repository/memory/stock/serial"
+	transferInfra "openapi/internal/infra/repository/memory/stock/transfer"
+)
+
+type UnitOfWork struct {
+	transaction.IUnitOfWork
+	db *memory.DB
+}
+
+func NewUnitOfWork(db *memory.DB) (*UnitOfWork, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewUnitOfWork: db is nil")
+	}
+	return &UnitOfWork{
+		db: db,
+	}, nil
+}
+
+// Do runs fn in a transaction. Transactions run one at a time, so they never conflict and are not retried.
+// The transaction is rolled back when ctx is done before it commits.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(r transaction.IRepositories) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	tx := u.db.Begin()
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	r, err := NewRepositories(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := fn(r); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := ctx.Err(); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	tx.Commit()
+	return nil
+}
+
+type Repositories struct {
+	item     *itemInfra.Repository
+	location *locationInfra.Repository
+	lot      *lotInfra.Repository
+	movement *movementInfra.Repository
+	serial   *serialInfra.Repository
+	transfer *transferInfra.Repository
+}
+
+// NewRepositories returns the repositories that keep their aggregates in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+func NewRepositories(db *memory.DB) (*Repositories, error) {
+	i, err := itemInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := locationInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	lt, err := lotInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := movementInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := serialInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := transferInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repositories{
+		item:     i,
+		location: l,
+		lot:      lt,
+		movement: m,
+		serial:   s,
+		transfer: t,
+	}, nil
+}
+
+func (r *Repositories) Item() item.IRepository {
+	return r.item
+}
+
+func (r *Repositories) Location() location.IRepository {
+	return r.location
+}
+
+func (r *Repositories) Lot() lot.IRepository {
+	return r.lot
+}
+
+func (r *Repositories) Movement() movement.IRepository {
+	return r.movement
+}
+
+func (r *Repositories) Serial() serial.IRepository {
+	return r.serial
+}
+
+func (r *Repositories) Transfer() transfer.IRepository {
+	return r.transfer
+}
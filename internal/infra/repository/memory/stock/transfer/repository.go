@@ -0,0 +1,74 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transfer"
+	"openapi/internal/infra/repository/memory"
+	movementInfra "openapi/internal/infra/repository/memory/stock/movement"
+)
+
+type Repository struct {
+	transfer.IRepository
+	db        *memory.DB
+	rows      map[transfer.Id]transfer.Aggregate
+	movements *movementInfra.Repository
+}
+
+// NewRepository returns a repository that keeps the transfers in db, which is either
+// a database from memory.Open or a transaction from its Begin.
+// Save writes several rows, so db should be a transaction to keep them atomic.
+func NewRepository(db *memory.DB) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+
+	movements, err := movementInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:        db,
+		rows:      memory.Table[transfer.Id, transfer.Aggregate](db, "stock_transfers"),
+		movements: movements,
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *transfer.Aggregate) error {
+	if err := r.movements.Save(ctx, a.Out); err != nil {
+		return err
+	}
+
+	if err := r.movements.Save(ctx, a.In); err != nil {
+		return err
+	}
+
+	return r.db.Write(func() (func(), error) {
+		if _, found := r.rows[a.Id]; found {
+			return nil, fmt.Errorf("Save: stock transfer %s already exists", a.Id)
+		}
+
+		r.rows[a.Id] = *a
+
+		return func() {
+			delete(r.rows, a.Id)
+		}, nil
+	})
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	var found bool
+	r.db.Read(func() {
+		for _, row := range r.rows {
+			if row.From == locationId || row.To == locationId {
+				found = true
+				return
+			}
+		}
+	})
+
+	return found, nil
+}
@@ -0,0 +1,44 @@
+package transfer_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transfer"
+	"openapi/internal/infra/repository/memory"
+	movementInfra "openapi/internal/infra/repository/memory/stock/movement"
+	sut "openapi/internal/infra/repository/memory/stock/transfer"
+	"openapi/internal/infra/repository/repositorytest"
+)
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestTransferRepository(t, func(t *testing.T) (transfer.IRepository, movement.IRepository) {
+		db := memory.Open()
+
+		r, err := sut.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		movements, err := movementInfra.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return r, movements
+	})
+}
@@ -0,0 +1,306 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+)
+
+// TestItemRepository runs the cases of item.IRepository against the repositories newRepository returns.
+func TestItemRepository(t *testing.T, newRepository func(t *testing.T) item.IRepository) {
+	t.Run("SaveAndGet", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newItem(t, "test")
+
+		unitVolume, err := measure.NewVolume(1500)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.UnitVolume = unitVolume
+
+		unitWeight, err := measure.NewWeight(250)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.UnitWeight = unitWeight
+
+		if err := a.SetBarcodes([]item.Barcode{newBarcode(t, "4006381333931"), newBarcode(t, "96385074")}); err != nil {
+			t.Fatal(err)
+		}
+
+		unit, err := item.NewUnit("case")
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		packSize, err := item.NewPackSize(unit, 12)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := a.SetPackSizes([]item.PackSize{packSize}); err != nil {
+			t.Fatal(err)
+		}
+		a.LotTracked = true
+		a.Serialized = true
+
+		// When
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if a.Version() != 1 {
+			t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 1)
+		}
+
+		if !reflect.DeepEqual(got, a) {
+			t.Errorf("%T %+v want %+v", got, got, a)
+		}
+	})
+
+	t.Run("SaveFailSkuTaken", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newItem(t, "test")
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		other := newItem(t, "other")
+		if err := r.Save(context.Background(), other); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		taken := newItem(t, "taken")
+		taken.Sku = a.Sku
+		insertErr := r.Save(context.Background(), taken)
+
+		other.Sku = a.Sku
+		updateErr := r.Save(context.Background(), other)
+
+		// Then
+		if !errors.Is(insertErr, item.ErrSkuTaken) {
+			t.Errorf("%T = %v, want %v", insertErr, insertErr, item.ErrSkuTaken)
+		}
+
+		if !errors.Is(updateErr, item.ErrSkuTaken) {
+			t.Errorf("%T = %v, want %v", updateErr, updateErr, item.ErrSkuTaken)
+		}
+	})
+
+	t.Run("SaveSkuOfDeleted", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newItem(t, "test")
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		a.Delete()
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		b := newItem(t, "test")
+		b.Sku = a.Sku
+		err := r.Save(context.Background(), b)
+
+		// Then
+		if err != nil {
+			t.Errorf("%T = %v, want nil", err, err)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		barcode := newUniqueBarcode(t)
+		as := []*item.Aggregate{newItem(t, "first"), newItem(t, "second"), newItem(t, "deleted")}
+		for _, a := range as {
+			if err := a.SetBarcodes([]item.Barcode{barcode}); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		as[2].Delete()
+		if err := r.Save(context.Background(), as[2]); err != nil {
+			t.Fatal(err)
+		}
+
+		first, second := as[0], as[1]
+		if second.Sku.String() < first.Sku.String() {
+			first, second = second, first
+		}
+
+		// When
+		bySku, err := r.List(context.Background(), item.ListQuery{Sku: &first.Sku, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		byBarcode, err := r.List(context.Background(), item.ListQuery{Barcode: &barcode, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		after, err := r.List(context.Background(), item.ListQuery{Barcode: &barcode, After: &first.Sku, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		limited, err := r.List(context.Background(), item.ListQuery{Barcode: &barcode, Limit: 1})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(bySku) != 1 || !reflect.DeepEqual(bySku[0], first) {
+			t.Errorf("%T %+v want %+v", bySku, bySku, first)
+		}
+
+		if len(byBarcode) != 2 || byBarcode[0].Id != first.Id || byBarcode[1].Id != second.Id {
+			t.Errorf("%T %+v want %+v and %+v", byBarcode, byBarcode, first, second)
+		}
+
+		if len(after) != 1 || after[0].Id != second.Id {
+			t.Errorf("%T %+v want %+v", after, after, second)
+		}
+
+		if len(limited) != 1 || limited[0].Id != first.Id {
+			t.Errorf("%T %+v want %+v", limited, limited, first)
+		}
+	})
+
+	t.Run("GetDeleted", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newItem(t, "test")
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		a.Delete()
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		got, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := r.Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if !got.IsDeleted() || got.Version() != 2 {
+			t.Errorf("%T %+v want %+v", got, got, a)
+		}
+
+		if !found {
+			t.Errorf("%T %+v want %+v", found, found, true)
+		}
+	})
+
+	t.Run("GetFailNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		id, err := item.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		_, err = r.Get(context.Background(), id)
+		found, findErr := r.Find(context.Background(), id)
+
+		// Then
+		if !errors.Is(err, item.ErrNotFound) {
+			t.Errorf("%T = %v, want %v", err, err, item.ErrNotFound)
+		}
+
+		if findErr != nil || found {
+			t.Errorf("%T %+v %v want %+v", found, found, findErr, false)
+		}
+	})
+
+	t.Run("SaveFailVersionMismatch", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newItem(t, "test")
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		first, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		second, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		if err := r.Save(context.Background(), first); err != nil {
+			t.Fatal(err)
+		}
+
+		err = r.Save(context.Background(), second)
+
+		// Then
+		if !errors.Is(err, item.ErrVersionMismatch) {
+			t.Errorf("%T %+v want %+v", err, err, item.ErrVersionMismatch)
+		}
+	})
+}
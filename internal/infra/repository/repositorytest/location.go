@@ -0,0 +1,720 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/location"
+)
+
+// TestLocationRepository runs the cases of location.IRepository against the repositories newRepository returns.
+func TestLocationRepository(t *testing.T, newRepository func(t *testing.T) location.IRepository) {
+	t.Run("SaveAndGet", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		a.AllowNegativeStock = true
+		a.Type = location.Bin
+
+		units, volume := int64(100), int64(50000)
+		capacity, err := location.NewCapacity(&units, &volume, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.Capacity = capacity
+
+		// When
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if a.Version() != 1 {
+			t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 1)
+		}
+
+		if *got != *a {
+			t.Errorf("%T %+v want %+v", got, got, a)
+		}
+	})
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "before_"+uuid.NewString())
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		before, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name, err := location.NewName("after_" + uuid.NewString())
+		if err != nil {
+			t.Fatal(err)
+		}
+		before.Name = name
+		before.Delete()
+
+		if err := r.Save(context.Background(), before); err != nil {
+			t.Fatal(err)
+		}
+
+		after, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if after.Name != name || !after.IsDeleted() || after.Version() != 2 {
+			t.Errorf("%T %+v want %+v", after, after, before)
+		}
+	})
+
+	t.Run("GetFailNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		id, err := location.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		_, err = r.Get(context.Background(), id)
+
+		// Then
+		if !errors.Is(err, location.ErrNotFound) {
+			t.Errorf("%T = %v, want %v", err, err, location.ErrNotFound)
+		}
+	})
+
+	t.Run("GetDeleted", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		a.Delete()
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		got, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := r.Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if !got.IsDeleted() {
+			t.Errorf("%T %+v want deleted", got, got)
+		}
+
+		if !found {
+			t.Errorf("%T %+v want %+v", found, found, true)
+		}
+	})
+
+	t.Run("Find", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+
+		// When
+		notFound, err := r.Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		found, err := r.Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if notFound {
+			t.Errorf("%T %+v want %+v", notFound, notFound, false)
+		}
+
+		if !found {
+			t.Errorf("%T %+v want %+v", found, found, true)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		prefix := "list_%" + uuid.NewString()
+		as := []*location.Aggregate{}
+		for _, suffix := range []string{"a", "b", "c"} {
+			a := newLocation(t, prefix+suffix)
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+			as = append(as, a)
+		}
+
+		// matches the prefix only when % is taken as a wildcard
+		if err := r.Save(context.Background(), newLocation(t, "list_x"+prefix[len("list_%"):]+"a")); err != nil {
+			t.Fatal(err)
+		}
+
+		as[1].Delete()
+		if err := r.Save(context.Background(), as[1]); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		active, err := r.List(context.Background(), location.ListQuery{NamePrefix: prefix, Order: location.OrderAsc, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		all, err := r.List(context.Background(), location.ListQuery{NamePrefix: prefix, IncludeDeleted: true, Order: location.OrderDesc, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		after, err := r.List(context.Background(), location.ListQuery{
+			NamePrefix:     prefix,
+			IncludeDeleted: true,
+			Order:          location.OrderAsc,
+			After:          &location.Cursor{Name: as[0].Name, Id: as[0].Id},
+			Limit:          1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(active) != 2 || active[0].Id != as[0].Id || active[1].Id != as[2].Id {
+			t.Errorf("%T %+v want %+v and %+v", active, active, as[0], as[2])
+		}
+
+		if len(all) != 3 || all[0].Id != as[2].Id || all[2].Id != as[0].Id {
+			t.Errorf("%T %+v want descending %+v", all, all, as)
+		}
+
+		if len(after) != 1 || after[0].Id != as[1].Id || !after[0].IsDeleted() {
+			t.Errorf("%T %+v want %+v", after, after, as[1])
+		}
+	})
+
+	t.Run("FindByName", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		name := "name_" + uuid.NewString()
+		deleted := newLocation(t, name)
+		deleted.Delete()
+		if err := r.Save(context.Background(), deleted); err != nil {
+			t.Fatal(err)
+		}
+
+		active := newLocation(t, name)
+		if err := r.Save(context.Background(), active); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		found, err := r.FindByName(context.Background(), active.Name)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, notFoundErr := r.FindByName(context.Background(), newLocation(t, "name_"+uuid.NewString()).Name)
+
+		// Then
+		if found.Id != active.Id {
+			t.Errorf("%T %+v want %+v", found, found, active)
+		}
+
+		if !errors.Is(notFoundErr, location.ErrNotFound) {
+			t.Errorf("%T = %v, want %v", notFoundErr, notFoundErr, location.ErrNotFound)
+		}
+	})
+
+	t.Run("SaveFailNameTaken", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		name := "name_" + uuid.NewString()
+		if err := r.Save(context.Background(), newLocation(t, name)); err != nil {
+			t.Fatal(err)
+		}
+
+		renamed := newLocation(t, "name_"+uuid.NewString())
+		if err := r.Save(context.Background(), renamed); err != nil {
+			t.Fatal(err)
+		}
+
+		restored := newLocation(t, name)
+		restored.Delete()
+		if err := r.Save(context.Background(), restored); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		createErr := r.Save(context.Background(), newLocation(t, name))
+
+		renamed.Name = newLocation(t, name).Name
+		renameErr := r.Save(context.Background(), renamed)
+
+		restored.Restore()
+		restoreErr := r.Save(context.Background(), restored)
+
+		// Then
+		for _, err := range []error{createErr, renameErr, restoreErr} {
+			if !errors.Is(err, location.ErrNameTaken) {
+				t.Errorf("%T = %v, want %v", err, err, location.ErrNameTaken)
+			}
+		}
+	})
+
+	t.Run("ListDeletedBefore", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		prefix := "deleted_" + uuid.NewString()
+		as := []*location.Aggregate{}
+		for _, suffix := range []string{"a", "b", "c"} {
+			a := newLocation(t, prefix+suffix)
+			if suffix != "b" {
+				a.Delete()
+			}
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+			as = append(as, a)
+		}
+
+		// deleted again, which must not move the time it was deleted
+		if err := r.Save(context.Background(), as[0]); err != nil {
+			t.Fatal(err)
+		}
+
+		// restored, so no longer deleted
+		as[2].Restore()
+		if err := r.Save(context.Background(), as[2]); err != nil {
+			t.Fatal(err)
+		}
+
+		later := time.Now().Add(time.Hour)
+		earlier := time.Now().Add(-time.Hour)
+
+		// When
+		expired, err := r.List(context.Background(), location.ListQuery{NamePrefix: prefix, DeletedBefore: &later, Order: location.OrderAsc, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		recent, err := r.List(context.Background(), location.ListQuery{NamePrefix: prefix, DeletedBefore: &earlier, Order: location.OrderAsc, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(expired) != 1 || expired[0].Id != as[0].Id {
+			t.Errorf("%T %+v want %+v", expired, expired, as[0])
+		}
+
+		if len(recent) != 0 {
+			t.Errorf("%T %+v want none", recent, recent)
+		}
+	})
+
+	t.Run("Purge", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		a.Delete()
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		if err := r.Purge(context.Background(), a.Id); err != nil {
+			t.Fatal(err)
+		}
+
+		_, getErr := r.Get(context.Background(), a.Id)
+		purgeErr := r.Purge(context.Background(), a.Id)
+
+		// Then
+		if !errors.Is(getErr, location.ErrNotFound) {
+			t.Errorf("%T = %v, want %v", getErr, getErr, location.ErrNotFound)
+		}
+
+		if !errors.Is(purgeErr, location.ErrNotFound) {
+			t.Errorf("%T = %v, want %v", purgeErr, purgeErr, location.ErrNotFound)
+		}
+	})
+
+	t.Run("ListByType", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		prefix := "type_" + uuid.NewString() + "_"
+		want := []*location.Aggregate{}
+		for i, typ := range []location.Type{location.Quarantine, location.Bin, location.Quarantine} {
+			a := newLocation(t, prefix+string(rune('a'+i)))
+			a.Type = typ
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+			if typ == location.Quarantine {
+				want = append(want, a)
+			}
+		}
+
+		// When
+		typ := location.Quarantine
+		got, err := r.List(context.Background(), location.ListQuery{
+			NamePrefix: prefix,
+			Type:       &typ,
+			Order:      location.OrderAsc,
+			Limit:      10,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(got) != len(want) {
+			t.Fatalf("%T %+v want %+v", got, got, want)
+		}
+		for i := range want {
+			if *got[i] != *want[i] {
+				t.Errorf("%T %+v want %+v", got[i], got[i], want[i])
+			}
+		}
+	})
+
+	t.Run("SaveParent", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		parent := saveChild(t, r, nil)
+		a := saveChild(t, r, parent)
+
+		// When
+		withParent, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		withParent.MoveToRoot()
+		if err := r.Save(context.Background(), withParent); err != nil {
+			t.Fatal(err)
+		}
+
+		root, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if p := a.ParentId(); p == nil || *p != parent.Id {
+			t.Fatalf("%T %+v want %+v", p, p, parent.Id)
+		}
+
+		if p := withParent.ParentId(); p != nil {
+			t.Errorf("%T %+v want %+v", p, p, nil)
+		}
+
+		if p := root.ParentId(); p != nil {
+			t.Errorf("%T %+v want %+v", p, p, nil)
+		}
+	})
+
+	t.Run("ListChildren", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		parent := saveChild(t, r, nil)
+		child := saveChild(t, r, parent)
+		deleted := saveChild(t, r, parent)
+		deleted.Delete()
+		if err := r.Save(context.Background(), deleted); err != nil {
+			t.Fatal(err)
+		}
+		saveChild(t, r, child)
+
+		// When
+		active, err := r.List(context.Background(), location.ListQuery{ParentId: &parent.Id, Order: location.OrderAsc, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		all, err := r.List(context.Background(), location.ListQuery{ParentId: &parent.Id, IncludeDeleted: true, Order: location.OrderAsc, Limit: 10})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(active) != 1 || active[0].Id != child.Id {
+			t.Errorf("%T %+v want %+v", active, active, child)
+		}
+
+		if len(all) != 2 {
+			t.Errorf("%T %+v want %+v", all, all, 2)
+		}
+	})
+
+	t.Run("Ancestors", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		root := saveChild(t, r, nil)
+		parent := saveChild(t, r, root)
+		a := saveChild(t, r, parent)
+
+		// When
+		path, err := r.Ancestors(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		none, err := r.Ancestors(context.Background(), root.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(path) != 2 || path[0].Id != root.Id || path[1].Id != parent.Id {
+			t.Errorf("%T %+v want %+v and %+v", path, path, root, parent)
+		}
+
+		if len(none) != 0 {
+			t.Errorf("%T %+v want %+v", none, none, 0)
+		}
+	})
+
+	t.Run("Descendants", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		root := saveChild(t, r, nil)
+		child := saveChild(t, r, root)
+		grandchild := saveChild(t, r, child)
+		grandchild.Delete()
+		if err := r.Save(context.Background(), grandchild); err != nil {
+			t.Fatal(err)
+		}
+		other := saveChild(t, r, nil)
+		saveChild(t, r, other)
+
+		// When
+		all, err := r.Descendants(context.Background(), root.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		none, err := r.Descendants(context.Background(), grandchild.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		got := map[location.Id]bool{}
+		for _, a := range all {
+			got[a.Id] = true
+		}
+
+		if len(all) != 2 || !got[child.Id] || !got[grandchild.Id] {
+			t.Errorf("%T %+v want %+v and %+v", all, all, child, grandchild)
+		}
+
+		if len(none) != 0 {
+			t.Errorf("%T %+v want %+v", none, none, 0)
+		}
+	})
+
+	t.Run("SaveFailVersionMismatch", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		first, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		second, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		if err := r.Save(context.Background(), first); err != nil {
+			t.Fatal(err)
+		}
+
+		err = r.Save(context.Background(), second)
+
+		// Then
+		if !errors.Is(err, location.ErrVersionMismatch) {
+			t.Errorf("%T %+v want %+v", err, err, location.ErrVersionMismatch)
+		}
+
+		if second.Version() != 1 {
+			t.Errorf("%T %+v want %+v", second.Version(), second.Version(), 1)
+		}
+
+		got, err := r.Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.Version() != 2 {
+			t.Errorf("%T %+v want %+v", got.Version(), got.Version(), 2)
+		}
+	})
+
+	// テスト観点: 同じバージョンを同時に保存しても、成功するのは1件だけであること
+	t.Run("SaveConcurrently", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		const n = 8
+		errs := make([]error, n)
+		var wg sync.WaitGroup
+		for i := 0; i < n; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+
+				got, err := r.Get(context.Background(), a.Id)
+				if err != nil {
+					errs[i] = err
+					return
+				}
+
+				// every goroutine has read version 1 before any of them saves
+				got = location.RestoreAggregate(got.Id, got.Name, got.Type, got.Capacity, got.ParentId(), got.IsDeleted(), got.AllowNegativeStock, 1)
+				errs[i] = r.Save(context.Background(), got)
+			}(i)
+		}
+		wg.Wait()
+
+		// Then
+		saved := 0
+		for _, err := range errs {
+			switch {
+			case err == nil:
+				saved++
+			case !errors.Is(err, location.ErrVersionMismatch):
+				t.Errorf("%T %+v want %+v", err, err, location.ErrVersionMismatch)
+			}
+		}
+
+		if saved != 1 {
+			t.Errorf("%T %+v want %+v", saved, saved, 1)
+		}
+	})
+}
+
+// saveChild saves a new location under parent, or at the root when parent is nil.
+func saveChild(t *testing.T, r location.IRepository, parent *location.Aggregate) *location.Aggregate {
+	t.Helper()
+
+	a := newLocation(t, "test_"+uuid.NewString())
+	if parent != nil {
+		if err := a.MoveUnder(parent, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
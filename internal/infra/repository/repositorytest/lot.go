@@ -0,0 +1,179 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+)
+
+// TestLotRepository runs the cases of lot.IRepository against the repositories newRepository returns.
+func TestLotRepository(t *testing.T, newRepository func(t *testing.T) lot.IRepository) {
+	t.Run("SaveAndGet", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+		a := newLot(t, itemId, "L-2024/07", newDate(t, "2024-07-01"), newDate(t, "2025-06-30"))
+		undated := newLot(t, itemId, "L2", lot.Date{}, lot.Date{})
+
+		// When
+		for _, l := range []*lot.Aggregate{a, undated} {
+			if err := r.Save(context.Background(), l); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		got, err := r.Get(context.Background(), itemId, a.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		gotUndated, err := r.Get(context.Background(), itemId, undated.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if !reflect.DeepEqual(got, a) {
+			t.Errorf("%T %+v want %+v", got, got, a)
+		}
+
+		if !reflect.DeepEqual(gotUndated, undated) {
+			t.Errorf("%T %+v want %+v", gotUndated, gotUndated, undated)
+		}
+	})
+
+	t.Run("SaveFailNumberTaken", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+		otherItemId, _ := newIds(t)
+		if err := r.Save(context.Background(), newLot(t, itemId, "L1", lot.Date{}, lot.Date{})); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		err := r.Save(context.Background(), newLot(t, itemId, "L1", lot.Date{}, newDate(t, "2025-01-01")))
+		otherErr := r.Save(context.Background(), newLot(t, otherItemId, "L1", lot.Date{}, lot.Date{}))
+
+		// Then
+		if !errors.Is(err, lot.ErrNumberTaken) {
+			t.Errorf("%T %+v want %+v", err, err, lot.ErrNumberTaken)
+		}
+
+		if otherErr != nil {
+			t.Errorf("another item may have a lot with the same number, %+v", otherErr)
+		}
+	})
+
+	t.Run("GetFailNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+
+		// When
+		_, err := r.Get(context.Background(), itemId, newLotNumber(t, "L1"))
+
+		// Then
+		if !errors.Is(err, lot.ErrNotFound) {
+			t.Errorf("%T %+v want %+v", err, err, lot.ErrNotFound)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+		never := newLot(t, itemId, "A", lot.Date{}, lot.Date{})
+		later := newLot(t, itemId, "B", lot.Date{}, newDate(t, "2124-08-01"))
+		sameDayD := newLot(t, itemId, "D", lot.Date{}, newDate(t, "2124-07-01"))
+		sameDayC := newLot(t, itemId, "C", newDate(t, "2124-01-01"), newDate(t, "2124-07-01"))
+
+		for _, a := range []*lot.Aggregate{never, later, sameDayD, sameDayC} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		all, err := r.List(context.Background(), lot.ListQuery{ItemId: &itemId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		by := newDate(t, "2124-07-31")
+		expiring, err := r.List(context.Background(), lot.ListQuery{ItemId: &itemId, ExpiresBy: &by})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if want := []*lot.Aggregate{sameDayC, sameDayD, later, never}; !reflect.DeepEqual(all, want) {
+			t.Errorf("%T %+v want %+v", all, all, want)
+		}
+
+		if want := []*lot.Aggregate{sameDayC, sameDayD}; !reflect.DeepEqual(expiring, want) {
+			t.Errorf("%T %+v want %+v", expiring, expiring, want)
+		}
+	})
+
+	t.Run("ListExpiresBy", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, err := item.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// lots of other tests may expire by the day as well, so only the lot of the test is looked for
+		expired := newLot(t, itemId, "L1", lot.Date{}, newDate(t, "2000-01-01"))
+		if err := r.Save(context.Background(), expired); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		by := newDate(t, "2000-01-01")
+		as, err := r.List(context.Background(), lot.ListQuery{ExpiresBy: &by})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		found := false
+		for _, a := range as {
+			if a.ExpiresOn.Time().After(by.Time()) {
+				t.Errorf("%+v expires after %+v", a, by)
+			}
+			found = found || a.Id == expired.Id
+		}
+
+		if !found {
+			t.Errorf("%T %+v want %+v in it", as, as, expired)
+		}
+	})
+}
@@ -0,0 +1,494 @@
+package repositorytest
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transfer"
+)
+
+// TestMovementRepository runs the cases of movement.IRepository against the repositories newRepository returns.
+func TestMovementRepository(t *testing.T, newRepository func(t *testing.T) movement.IRepository) {
+	t.Run("SaveFailAppendOnly", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		a := newMovement(t, itemId, locationId, movement.Receipt, 3)
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		err := r.Save(context.Background(), a)
+
+		// Then
+		if err == nil {
+			t.Error("saving the same movement twice must be rejected")
+		}
+	})
+
+	t.Run("GetBalance", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+
+		// When
+		empty, err := r.GetBalance(context.Background(), itemId, locationId, lot.Number{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for _, a := range []*movement.Aggregate{
+			newMovement(t, itemId, locationId, movement.Receipt, 10),
+			newMovement(t, itemId, locationId, movement.Issue, 4),
+			newMovement(t, itemId, locationId, movement.Adjustment, -1),
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		b, err := r.GetBalance(context.Background(), itemId, locationId, lot.Number{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if empty.Quantity != 0 {
+			t.Errorf("%T %+v want %+v", empty.Quantity, empty.Quantity, 0)
+		}
+
+		if b.ItemId != itemId || b.LocationId != locationId || b.Quantity != 5 {
+			t.Errorf("%T %+v want %+v", b, b, 5)
+		}
+	})
+
+	t.Run("ListBalances", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		_, otherLocationId := newIds(t)
+		_, emptyLocationId := newIds(t)
+
+		for _, a := range []*movement.Aggregate{
+			newMovement(t, itemId, locationId, movement.Receipt, 10),
+			newMovement(t, itemId, otherLocationId, movement.Receipt, 2),
+			newMovement(t, itemId, emptyLocationId, movement.Receipt, 2),
+			newMovement(t, itemId, emptyLocationId, movement.Issue, 2),
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		byItem, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		byLocation, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId, LocationId: &locationId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(byItem) != 2 {
+			t.Fatalf("%T %+v want %+v", byItem, byItem, 2)
+		}
+
+		if byItem[0].LocationId.String() > byItem[1].LocationId.String() {
+			t.Errorf("%T %+v want ordered by location", byItem, byItem)
+		}
+
+		for _, b := range byItem {
+			if b.LocationId == emptyLocationId {
+				t.Errorf("zero balance must be omitted, %+v", b)
+			}
+		}
+
+		if len(byLocation) != 1 || byLocation[0].Quantity != 10 {
+			t.Errorf("%T %+v want %+v", byLocation, byLocation, 10)
+		}
+	})
+
+	t.Run("ListBalancesPage", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		_, otherLocationId := newIds(t)
+
+		for _, a := range []*movement.Aggregate{
+			newLotMovement(t, itemId, locationId, newLotNumber(t, "L1"), movement.Receipt, 4),
+			newMovement(t, itemId, locationId, movement.Receipt, 1),
+			newLotMovement(t, itemId, otherLocationId, newLotNumber(t, "L2"), movement.Receipt, 2),
+			newMovement(t, itemId, otherLocationId, movement.Receipt, 3),
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		all, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		paged := []movement.Balance{}
+		q := movement.BalanceQuery{ItemId: &itemId, Limit: 3}
+		for {
+			bs, err := r.ListBalances(context.Background(), q)
+			if err != nil {
+				t.Fatal(err)
+			}
+			paged = append(paged, bs...)
+
+			if len(bs) < q.Limit {
+				break
+			}
+			last := bs[len(bs)-1]
+			q.After = &movement.BalanceCursor{ItemId: last.ItemId, LocationId: last.LocationId, Lot: last.Lot}
+			q.Limit = 1
+		}
+
+		// Then
+		if len(all) != 4 {
+			t.Fatalf("%T %+v want %+v", all, all, 4)
+		}
+
+		if !reflect.DeepEqual(paged, all) {
+			t.Errorf("%T %+v want %+v", paged, paged, all)
+		}
+	})
+
+	t.Run("BalancesPerLot", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		l1, l2 := newLotNumber(t, "L1"), newLotNumber(t, "L2")
+
+		for _, a := range []*movement.Aggregate{
+			newLotMovement(t, itemId, locationId, l2, movement.Receipt, 4),
+			newLotMovement(t, itemId, locationId, l1, movement.Receipt, 10),
+			newLotMovement(t, itemId, locationId, l1, movement.Issue, 3),
+			newMovement(t, itemId, locationId, movement.Receipt, 1),
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		b, err := r.GetBalance(context.Background(), itemId, locationId, l1)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		withoutLot, err := r.GetBalance(context.Background(), itemId, locationId, lot.Number{})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		bs, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if b.Lot != l1 || b.Quantity != 7 {
+			t.Errorf("%T %+v want %+v of %+v", b, b, 7, l1)
+		}
+
+		if !withoutLot.Lot.IsZero() || withoutLot.Quantity != 1 {
+			t.Errorf("%T %+v want %+v without lot", withoutLot, withoutLot, 1)
+		}
+
+		want := []movement.Balance{
+			{ItemId: itemId, LocationId: locationId, Quantity: 1},
+			{ItemId: itemId, LocationId: locationId, Lot: l1, Quantity: 7},
+			{ItemId: itemId, LocationId: locationId, Lot: l2, Quantity: 4},
+		}
+		if !reflect.DeepEqual(bs, want) {
+			t.Errorf("%T %+v want %+v", bs, bs, want)
+		}
+	})
+
+	t.Run("ListBalancesByLocations", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		_, otherLocationId := newIds(t)
+		_, excludedLocationId := newIds(t)
+
+		for _, a := range []*movement.Aggregate{
+			newMovement(t, itemId, locationId, movement.Receipt, 10),
+			newMovement(t, itemId, otherLocationId, movement.Receipt, 2),
+			newMovement(t, itemId, excludedLocationId, movement.Receipt, 5),
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		some, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId, LocationIds: []location.Id{locationId, otherLocationId}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		none, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId, LocationIds: []location.Id{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if len(some) != 2 {
+			t.Fatalf("%T %+v want %+v", some, some, 2)
+		}
+
+		for _, b := range some {
+			if b.LocationId == excludedLocationId {
+				t.Errorf("%+v must be excluded", b)
+			}
+		}
+
+		if len(none) != 0 {
+			t.Errorf("%T %+v want %+v", none, none, 0)
+		}
+	})
+
+	t.Run("FindByLocation", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		_, unusedLocationId := newIds(t)
+
+		// the movements net to zero, so they leave no balance behind
+		for _, a := range []*movement.Aggregate{
+			newMovement(t, itemId, locationId, movement.Receipt, 5),
+			newMovement(t, itemId, locationId, movement.Issue, 5),
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		found, err := r.FindByLocation(context.Background(), locationId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		unused, err := r.FindByLocation(context.Background(), unusedLocationId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if !found {
+			t.Errorf("%v must have movements", locationId)
+		}
+
+		if unused {
+			t.Errorf("%v must have no movements", unusedLocationId)
+		}
+	})
+
+	t.Run("ListBySerial", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, from := newIds(t)
+		otherItemId, to := newIds(t)
+		number := newSerialNumber(t, "S1")
+		other := newSerialNumber(t, "S2")
+
+		receipt := newSerialMovement(t, itemId, from, movement.Receipt, number, other)
+		out := newSerialMovement(t, itemId, from, movement.TransferOut, number)
+		in := newSerialMovement(t, itemId, to, movement.TransferIn, number)
+		issue := newSerialMovement(t, itemId, to, movement.Issue, number)
+		for _, a := range []*movement.Aggregate{
+			receipt,
+			newSerialMovement(t, itemId, from, movement.Issue, other),
+			newSerialMovement(t, otherItemId, from, movement.Receipt, number),
+			out,
+			in,
+			issue,
+		} {
+			if err := r.Save(context.Background(), a); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		as, err := r.ListBySerial(context.Background(), itemId, number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		none, err := r.ListBySerial(context.Background(), itemId, newSerialNumber(t, "S3"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if want := []*movement.Aggregate{receipt, out, in, issue}; !reflect.DeepEqual(as, want) {
+			t.Errorf("%T %+v want %+v", as, as, want)
+		}
+
+		if len(none) != 0 {
+			t.Errorf("%T %+v want %+v", none, none, 0)
+		}
+	})
+}
+
+// TestTransferRepository runs the cases of transfer.IRepository against the repositories newRepositories returns.
+// The movement repository must read what the transfer repository writes.
+func TestTransferRepository(t *testing.T, newRepositories func(t *testing.T) (transfer.IRepository, movement.IRepository)) {
+	t.Run("Save", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r, movements := newRepositories(t)
+
+		// Given
+		id, err := transfer.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		itemId, from := newIds(t)
+		_, to := newIds(t)
+
+		outId, err := movement.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		inId, err := movement.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a, err := transfer.NewAggregate(id, itemId, from, to, 3, outId, inId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		number := newLotNumber(t, "L1")
+		a.SetLot(number)
+
+		// When
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		out, err := movements.GetBalance(context.Background(), itemId, from, number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		in, err := movements.GetBalance(context.Background(), itemId, to, number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if out.Quantity != -3 || in.Quantity != 3 {
+			t.Errorf("%+v %+v want -3 and 3", out, in)
+		}
+	})
+
+	t.Run("FindByLocation", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r, _ := newRepositories(t)
+
+		// Given
+		id, err := transfer.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		itemId, from := newIds(t)
+		_, to := newIds(t)
+		_, unused := newIds(t)
+
+		outId, err := movement.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		inId, err := movement.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a, err := transfer.NewAggregate(id, itemId, from, to, 3, outId, inId)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		found := map[location.Id]bool{}
+		for _, l := range []location.Id{from, to, unused} {
+			f, err := r.FindByLocation(context.Background(), l)
+			if err != nil {
+				t.Fatal(err)
+			}
+			found[l] = f
+		}
+
+		// Then
+		if !found[from] || !found[to] {
+			t.Errorf("%+v want both ends of the transfer found", found)
+		}
+
+		if found[unused] {
+			t.Errorf("%v must have no transfers", unused)
+		}
+	})
+}
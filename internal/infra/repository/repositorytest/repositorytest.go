@@ -0,0 +1,192 @@
+// Package repositorytest is the behaviour every implementation of the stock repositories shares.
+// The tests of an implementation call the Test functions with constructors of their repositories,
+// so that the same cases run against each of them.
+//
+// The repositories given to a Test function may already hold aggregates saved by other tests,
+// so the cases only look at what they have saved themselves.
+package repositorytest
+
+import (
+	"fmt"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+)
+
+func newLocation(t *testing.T, name string) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := location.NewName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.NewAggregate(id, n)
+}
+
+func newItem(t *testing.T, name string) *item.Aggregate {
+	t.Helper()
+
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the SKUs of the items are unique however many tests share the database
+	sku, err := item.NewSku(id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := item.NewName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return item.NewAggregate(id, sku, n, item.Each)
+}
+
+func newBarcode(t *testing.T, v string) item.Barcode {
+	t.Helper()
+
+	b, err := item.NewBarcode(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// newUniqueBarcode returns an EAN-13 no other test uses, so that the items listed by it are only the test's own.
+func newUniqueBarcode(t *testing.T) item.Barcode {
+	t.Helper()
+
+	digits := fmt.Sprintf("%012d", rand.Int63n(1e12))
+
+	sum := 0
+	for i, d := range digits {
+		n := int(d - '0')
+		if i%2 == 1 {
+			n *= 3
+		}
+		sum += n
+	}
+
+	return newBarcode(t, digits+strconv.Itoa((10-sum%10)%10))
+}
+
+func newIds(t *testing.T) (item.Id, location.Id) {
+	t.Helper()
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return itemId, locationId
+}
+
+func newMovement(t *testing.T, itemId item.Id, locationId location.Id, kind movement.Kind, value int64) *movement.Aggregate {
+	t.Helper()
+
+	id, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quantity, err := movement.NewQuantity(kind, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return movement.NewAggregate(id, itemId, locationId, kind, quantity)
+}
+
+func newLotMovement(t *testing.T, itemId item.Id, locationId location.Id, number lot.Number, kind movement.Kind, value int64) *movement.Aggregate {
+	t.Helper()
+
+	a := newMovement(t, itemId, locationId, kind, value)
+	a.Lot = number
+	return a
+}
+
+func newLotNumber(t *testing.T, v string) lot.Number {
+	t.Helper()
+
+	n, err := lot.NewNumber(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func newDate(t *testing.T, v string) lot.Date {
+	t.Helper()
+
+	d, err := lot.ParseDate(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func newLot(t *testing.T, itemId item.Id, number string, manufacturedOn lot.Date, expiresOn lot.Date) *lot.Aggregate {
+	t.Helper()
+
+	id, err := lot.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := lot.NewAggregate(id, itemId, newLotNumber(t, number), manufacturedOn, expiresOn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func newSerialNumber(t *testing.T, v string) serial.Number {
+	t.Helper()
+
+	n, err := serial.NewNumber(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func newSerialMovement(t *testing.T, itemId item.Id, locationId location.Id, kind movement.Kind, numbers ...serial.Number) *movement.Aggregate {
+	t.Helper()
+
+	a := newMovement(t, itemId, locationId, kind, int64(len(numbers)))
+	a.Serials = numbers
+	return a
+}
+
+func newSerial(t *testing.T, itemId item.Id, number string) *serial.Aggregate {
+	t.Helper()
+
+	id, err := serial.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return serial.NewAggregate(id, itemId, newSerialNumber(t, number))
+}
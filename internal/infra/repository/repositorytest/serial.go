@@ -0,0 +1,241 @@
+package repositorytest
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"openapi/internal/domain/stock/serial"
+)
+
+// TestSerialRepository runs the cases of serial.IRepository against the repositories newRepository returns.
+func TestSerialRepository(t *testing.T, newRepository func(t *testing.T) serial.IRepository) {
+	t.Run("SaveAndGet", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, locationId := newIds(t)
+		a := newSerial(t, itemId, "SN-0001")
+		issued := newSerial(t, itemId, "SN-0002")
+
+		if err := a.Receive(locationId); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		for _, s := range []*serial.Aggregate{a, issued} {
+			if err := r.Save(context.Background(), s); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		got, err := r.Get(context.Background(), itemId, a.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		gotIssued, err := r.Get(context.Background(), itemId, issued.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if !reflect.DeepEqual(got, a) {
+			t.Errorf("%T %+v want %+v", got, got, a)
+		}
+
+		if !reflect.DeepEqual(gotIssued, issued) {
+			t.Errorf("%T %+v want %+v", gotIssued, gotIssued, issued)
+		}
+	})
+
+	t.Run("SaveMoves", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, from := newIds(t)
+		_, to := newIds(t)
+		a := newSerial(t, itemId, "SN-0001")
+		if err := a.Receive(from); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		if err := a.Issue(from); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		issued, err := r.Get(context.Background(), itemId, a.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if err := a.Receive(to); err != nil {
+			t.Fatal(err)
+		}
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := r.Get(context.Background(), itemId, a.Number)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if l, ok := issued.LocationId(); ok {
+			t.Errorf("%T %+v must not be in stock", l, l)
+		}
+
+		if l, ok := got.LocationId(); !ok || l != to {
+			t.Errorf("%T %+v want %+v", l, l, to)
+		}
+	})
+
+	t.Run("FindByLocation", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, in := newIds(t)
+		_, out := newIds(t)
+		a := newSerial(t, itemId, "SN-0001")
+		issued := newSerial(t, itemId, "SN-0002")
+
+		if err := a.Receive(in); err != nil {
+			t.Fatal(err)
+		}
+		if err := issued.Receive(out); err != nil {
+			t.Fatal(err)
+		}
+		if err := issued.Issue(out); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, s := range []*serial.Aggregate{a, issued} {
+			if err := r.Save(context.Background(), s); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// When
+		foundIn, err := r.FindByLocation(context.Background(), in)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		foundOut, err := r.FindByLocation(context.Background(), out)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if !foundIn {
+			t.Errorf("%v must have a serial", in)
+		}
+
+		if foundOut {
+			t.Errorf("%v must have no serial once it has been issued", out)
+		}
+	})
+
+	t.Run("SaveFailNumberTaken", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+		otherItemId, _ := newIds(t)
+		if err := r.Save(context.Background(), newSerial(t, itemId, "SN-1")); err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		err := r.Save(context.Background(), newSerial(t, itemId, "SN-1"))
+		otherErr := r.Save(context.Background(), newSerial(t, otherItemId, "SN-1"))
+
+		// Then
+		if !errors.Is(err, serial.ErrNumberTaken) {
+			t.Errorf("%T %+v want %+v", err, err, serial.ErrNumberTaken)
+		}
+
+		if otherErr != nil {
+			t.Errorf("another item may have a serial with the same number, %+v", otherErr)
+		}
+	})
+
+	t.Run("GetFailNotFound", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+
+		// When
+		_, err := r.Get(context.Background(), itemId, newSerialNumber(t, "SN-1"))
+
+		// Then
+		if !errors.Is(err, serial.ErrNotFound) {
+			t.Errorf("%T %+v want %+v", err, err, serial.ErrNotFound)
+		}
+	})
+
+	t.Run("List", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		r := newRepository(t)
+
+		// Given
+		itemId, _ := newIds(t)
+		b := newSerial(t, itemId, "B")
+		a := newSerial(t, itemId, "A")
+		for _, s := range []*serial.Aggregate{b, a} {
+			if err := r.Save(context.Background(), s); err != nil {
+				t.Fatal(err)
+			}
+		}
+
+		// serials of other tests may have the number as well, so only the item of the test is looked at
+		number := newSerialNumber(t, "B")
+
+		// When
+		all, err := r.List(context.Background(), serial.ListQuery{ItemId: &itemId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		byNumber, err := r.List(context.Background(), serial.ListQuery{Number: &number, ItemId: &itemId})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		if want := []*serial.Aggregate{a, b}; !reflect.DeepEqual(all, want) {
+			t.Errorf("%T %+v want %+v", all, all, want)
+		}
+
+		if want := []*serial.Aggregate{b}; !reflect.DeepEqual(byNumber, want) {
+			t.Errorf("%T %+v want %+v", byNumber, byNumber, want)
+		}
+	})
+}
@@ -0,0 +1,176 @@
+package repositorytest
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/stock/transaction"
+)
+
+// TestUnitOfWork runs the cases of transaction.IUnitOfWork against the units of work newUnitOfWork returns.
+// The repositories it returns are outside of any transaction and must read what the unit of work commits.
+func TestUnitOfWork(t *testing.T, newUnitOfWork func(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories)) {
+	t.Run("Commit", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		u, r := newUnitOfWork(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+
+		// When
+		err := u.Do(context.Background(), func(tx transaction.IRepositories) error {
+			if err := tx.Location().Save(context.Background(), a); err != nil {
+				return err
+			}
+
+			// the transaction sees its own writes
+			found, err := tx.Location().Find(context.Background(), a.Id)
+			if err != nil {
+				return err
+			}
+			if !found {
+				return fmt.Errorf("saved location not found in the transaction")
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// Then
+		found, err := r.Location().Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if !found {
+			t.Errorf("location must be committed")
+		}
+	})
+
+	t.Run("Rollback", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		u, r := newUnitOfWork(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		if err := r.Location().Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+
+		i := newItem(t, "test")
+
+		// When
+		err := u.Do(context.Background(), func(tx transaction.IRepositories) error {
+			a.Delete()
+			if err := tx.Location().Save(context.Background(), a); err != nil {
+				return err
+			}
+			if err := tx.Item().Save(context.Background(), i); err != nil {
+				return err
+			}
+			return fmt.Errorf("fail after save")
+		})
+
+		// Then
+		if err == nil {
+			t.Fatal("error must not be nil")
+		}
+
+		got, err := r.Location().Get(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if got.IsDeleted() || got.Version() != 1 {
+			t.Errorf("%T %+v want the location as before the transaction", got, got)
+		}
+
+		found, err := r.Item().Find(context.Background(), i.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found {
+			t.Errorf("item must be rolled back")
+		}
+	})
+
+	t.Run("RollbackOnPanic", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		u, r := newUnitOfWork(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+
+		// When
+		func() {
+			defer func() {
+				if p := recover(); p == nil {
+					t.Error("panic must be propagated")
+				}
+			}()
+
+			u.Do(context.Background(), func(tx transaction.IRepositories) error {
+				if err := tx.Location().Save(context.Background(), a); err != nil {
+					return err
+				}
+				panic("fail after save")
+			})
+		}()
+
+		// Then
+		found, err := r.Location().Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found {
+			t.Errorf("location must be rolled back")
+		}
+	})
+
+	t.Run("RollbackOnCancel", func(t *testing.T) {
+		t.Parallel()
+
+		// Setup
+		u, r := newUnitOfWork(t)
+
+		// Given
+		a := newLocation(t, "test_"+uuid.NewString())
+		ctx, cancel := context.WithCancel(context.Background())
+
+		// When
+		err := u.Do(ctx, func(tx transaction.IRepositories) error {
+			if err := tx.Location().Save(ctx, a); err != nil {
+				return err
+			}
+			cancel()
+			return nil
+		})
+
+		// Then
+		if err == nil {
+			t.Fatal("error must not be nil")
+		}
+
+		found, err := r.Location().Find(context.Background(), a.Id)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if found {
+			t.Errorf("location must be rolled back")
+		}
+	})
+}
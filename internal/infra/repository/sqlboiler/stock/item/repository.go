@@ -0,0 +1,283 @@
+package item
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"openapi/internal/infra/sqlboiler"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+	"github.com/volatiletech/sqlboiler/v4/types"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+)
+
+type Repository struct {
+	item.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *item.Aggregate) error {
+	barcodes, packSizes, err := encode(a)
+	if err != nil {
+		return err
+	}
+
+	if a.Version() == 0 {
+		data := &sqlboiler.StockItem{
+			ID:         a.Id.String(),
+			Sku:        a.Sku.String(),
+			Name:       a.Name.String(),
+			BaseUnit:   a.BaseUnit().String(),
+			Barcodes:   barcodes,
+			PackSizes:  packSizes,
+			UnitVolume: a.UnitVolume.Int64(),
+			UnitWeight: a.UnitWeight.Int64(),
+			LotTracked: a.LotTracked,
+			Serialized: a.Serialized,
+			Deleted:    a.IsDeleted(),
+			Version:    1,
+		}
+
+		err := data.Insert(ctx, r.db, boil.Infer())
+		if isUniqueViolation(err) {
+			return item.ErrSkuTaken
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		// compare-and-swap on the version read by Get
+		updated, err := sqlboiler.StockItems(
+			sqlboiler.StockItemWhere.ID.EQ(a.Id.String()),
+			sqlboiler.StockItemWhere.Version.EQ(a.Version()),
+		).UpdateAll(ctx, r.db, sqlboiler.M{
+			sqlboiler.StockItemColumns.Sku:        a.Sku.String(),
+			sqlboiler.StockItemColumns.Name:       a.Name.String(),
+			sqlboiler.StockItemColumns.Barcodes:   barcodes,
+			sqlboiler.StockItemColumns.PackSizes:  packSizes,
+			sqlboiler.StockItemColumns.UnitVolume: a.UnitVolume.Int64(),
+			sqlboiler.StockItemColumns.UnitWeight: a.UnitWeight.Int64(),
+			sqlboiler.StockItemColumns.LotTracked: a.LotTracked,
+			sqlboiler.StockItemColumns.Serialized: a.Serialized,
+			sqlboiler.StockItemColumns.Deleted:    a.IsDeleted(),
+			sqlboiler.StockItemColumns.Version:    a.Version() + 1,
+			sqlboiler.StockItemColumns.UpdatedAt:  time.Now().In(boil.GetLocation()),
+		})
+		if isUniqueViolation(err) {
+			return item.ErrSkuTaken
+		}
+		if err != nil {
+			return err
+		}
+
+		if updated == 0 {
+			return item.ErrVersionMismatch
+		}
+	}
+
+	*a = *item.RestoreAggregate(a.Id, a.Sku, a.Name, a.BaseUnit(), a.Barcodes(), a.PackSizes(), a.UnitVolume, a.UnitWeight, a.LotTracked, a.Serialized, a.IsDeleted(), a.Version()+1)
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, id item.Id) (*item.Aggregate, error) {
+	data, err := sqlboiler.FindStockItem(ctx, r.db, id.UUID().String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return &item.Aggregate{}, item.ErrNotFound
+	}
+	if err != nil {
+		return &item.Aggregate{}, err
+	}
+
+	a, err := restore(data)
+	if err != nil {
+		return &item.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) Find(ctx context.Context, id item.Id) (bool, error) {
+	found, err := sqlboiler.StockItemExists(ctx, r.db, id.String())
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func (r *Repository) List(ctx context.Context, q item.ListQuery) ([]*item.Aggregate, error) {
+	mods := []qm.QueryMod{
+		sqlboiler.StockItemWhere.Deleted.EQ(false),
+	}
+
+	if q.Sku != nil {
+		mods = append(mods, sqlboiler.StockItemWhere.Sku.EQ(q.Sku.String()))
+	}
+
+	if q.Barcode != nil {
+		mods = append(mods, qm.Where("\"barcodes\" @> jsonb_build_array(?::text)", q.Barcode.String()))
+	}
+
+	if q.After != nil {
+		mods = append(mods, sqlboiler.StockItemWhere.Sku.GT(q.After.String()))
+	}
+
+	mods = append(mods,
+		qm.OrderBy("\"sku\""),
+		qm.Limit(q.Limit),
+	)
+
+	data, err := sqlboiler.StockItems(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]*item.Aggregate, 0, len(data))
+	for _, d := range data {
+		a, err := restore(d)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+// packSize is how a pack size is stored in the JSON array of the pack_sizes column.
+type packSize struct {
+	Unit     string `json:"unit"`
+	Quantity int64  `json:"quantity"`
+}
+
+// encode returns the barcodes and pack_sizes columns of a.
+func encode(a *item.Aggregate) (types.JSON, string, error) {
+	barcodes := []string{}
+	for _, b := range a.Barcodes() {
+		barcodes = append(barcodes, b.String())
+	}
+
+	packSizes := []packSize{}
+	for _, p := range a.PackSizes() {
+		packSizes = append(packSizes, packSize{Unit: p.Unit().String(), Quantity: p.Quantity()})
+	}
+
+	rawBarcodes, err := json.Marshal(barcodes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	rawPackSizes, err := json.Marshal(packSizes)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return types.JSON(rawBarcodes), string(rawPackSizes), nil
+}
+
+// decode restores the barcodes and pack sizes from their columns.
+func decode(rawBarcodes types.JSON, rawPackSizes string) ([]item.Barcode, []item.PackSize, error) {
+	var storedBarcodes []string
+	if err := rawBarcodes.Unmarshal(&storedBarcodes); err != nil {
+		return nil, nil, err
+	}
+
+	barcodes := []item.Barcode{}
+	for _, v := range storedBarcodes {
+		b, err := item.NewBarcode(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		barcodes = append(barcodes, b)
+	}
+
+	var storedPackSizes []packSize
+	if err := json.Unmarshal([]byte(rawPackSizes), &storedPackSizes); err != nil {
+		return nil, nil, err
+	}
+
+	packSizes := []item.PackSize{}
+	for _, v := range storedPackSizes {
+		unit, err := item.NewUnit(v.Unit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		p, err := item.NewPackSize(unit, v.Quantity)
+		if err != nil {
+			return nil, nil, err
+		}
+		packSizes = append(packSizes, p)
+	}
+
+	return barcodes, packSizes, nil
+}
+
+func restore(data *sqlboiler.StockItem) (*item.Aggregate, error) {
+	v, err := uuid.Parse(data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := item.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	sku, err := item.NewSku(data.Sku)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := item.NewName(data.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	baseUnit, err := item.NewUnit(data.BaseUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	barcodes, packSizes, err := decode(data.Barcodes, data.PackSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	unitVolume, err := measure.NewVolume(data.UnitVolume)
+	if err != nil {
+		return nil, err
+	}
+
+	unitWeight, err := measure.NewWeight(data.UnitWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.RestoreAggregate(id, sku, name, baseUnit, barcodes, packSizes, unitVolume, unitWeight, data.LotTracked, data.Serialized, data.Deleted, data.Version), nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "stock_item_sku_active_key"
+}
@@ -0,0 +1,526 @@
+package item_test
+
+import (
+	"context"
+	"errors"
+
+	"reflect"
+	"testing"
+	"time"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/repository/repositorytest"
+	sut "openapi/internal/infra/repository/sqlboiler/stock/item"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// When
+	r, err := sut.NewRepository(db)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r == nil {
+		t.Fatal("repository must not be nil")
+	}
+}
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestSaveFailInvalidDb(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	// When
+	err = r.Save(context.Background(), a)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestCreate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	currentDateTime := time.Now().UTC()
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	// When
+	before, err := r.Get(context.Background(), a.Id)
+	if err == nil {
+		t.Fatalf("expected error but returned nil, %+v", before)
+	}
+
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := r.Get(context.Background(), a.Id)
+	if err != nil {
+		t.Fatalf("expected error but returned nil, %+v", err)
+	}
+
+	// Then
+	if reflect.DeepEqual(after, before) {
+		t.Errorf("%T %+v want %+v", after, after, before)
+	}
+
+	data, err := sqlboiler.FindStockItem(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data.ID != id.String() {
+		t.Errorf("%T %+v want %+v", data.ID, data.ID, id)
+	}
+
+	if data.Name != name.String() {
+		t.Errorf("%T %+v want %+v", data.Name, data.Name, name)
+	}
+
+	if data.Deleted != false {
+		t.Errorf("%T %+v want %+v", data.Deleted, data.Deleted, false)
+	}
+
+	if data.CreatedAt.Before(currentDateTime) == true {
+		t.Errorf("expected %s, got %s", currentDateTime, data.CreatedAt)
+	}
+
+	if data.UpdatedAt.Equal(data.CreatedAt) != true {
+		t.Errorf("expected %s, got %s", data.CreatedAt, data.UpdatedAt)
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("before")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	before := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	currentDateTime := time.Now().UTC()
+	dataFormat := "2006-01-02 15:04:05.000000 +09:00"
+
+	if err = r.Save(context.Background(), before); err != nil {
+		t.Fatal(err)
+	}
+
+	beforeData, err := sqlboiler.FindStockItem(context.Background(), db, before.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	after, err := r.Get(context.Background(), before.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	changedName, err := item.NewName("after")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after.Name = changedName
+	after.Delete()
+
+	if err = r.Save(context.Background(), after); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	afterData, err := sqlboiler.FindStockItem(context.Background(), db, after.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if afterData.ID != after.Id.String() {
+		t.Errorf("%T %+v want %+v", afterData.ID, afterData.ID, after.Id.String())
+	}
+
+	if afterData.Name != after.Name.String() {
+		t.Errorf("%T %+v want %+v", afterData.Name, afterData.Name, after.Name.String())
+	}
+
+	if afterData.Deleted != after.IsDeleted() {
+		t.Errorf("%T %+v want %+v", afterData.Deleted, afterData.Deleted, after.IsDeleted())
+	}
+
+	if afterData.CreatedAt.Format(dataFormat) != beforeData.CreatedAt.Format(dataFormat) {
+		t.Errorf("%T %+v want %+v", afterData.CreatedAt, afterData.CreatedAt, beforeData.CreatedAt.Format(dataFormat))
+	}
+
+	if afterData.UpdatedAt.Before(currentDateTime) == true {
+		t.Errorf("%T %+v want greater than %+v ", afterData.UpdatedAt, afterData.UpdatedAt, currentDateTime)
+	}
+}
+
+func TestGetFailInvalidData(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	if err := r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	data := &sqlboiler.StockItem{
+		ID:      a.Id.String(),
+		Name:    "",
+		Deleted: a.IsDeleted(),
+	}
+
+	if err := data.Upsert(
+		context.Background(),
+		db,
+		true,
+		[]string{"id"},
+		boil.Whitelist("name", "deleted"),
+		boil.Infer(),
+	); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.Get(context.Background(), id)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+
+	if !failure.IsInternal(err) {
+		t.Errorf("%T %+v want a fault of the server", err, err)
+	}
+}
+
+func TestGetFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.Get(context.Background(), id)
+
+	// Then
+	if !errors.Is(err, item.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, item.ErrNotFound)
+	}
+}
+
+func TestFind(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	// When
+	notFound, err := r.Find(context.Background(), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	found, err := r.Find(context.Background(), a.Id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if notFound != false {
+		t.Errorf("%T %+v want %+v", notFound, notFound, false)
+	}
+
+	if found != true {
+		t.Errorf("%T %+v want %+v", found, found, true)
+	}
+}
+
+func TestFindFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.Find(context.Background(), id)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestSaveFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := item.NewName("test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := item.NewAggregate(id, newSku(t), name, item.Each)
+
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := r.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := r.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	if err = r.Save(context.Background(), first); err != nil {
+		t.Fatal(err)
+	}
+
+	err = r.Save(context.Background(), second)
+
+	// Then
+	if !errors.Is(err, item.ErrVersionMismatch) {
+		t.Fatalf("%T %+v want %+v", err, err, item.ErrVersionMismatch)
+	}
+
+	if a.Version() != 1 {
+		t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 1)
+	}
+
+	if first.Version() != 2 {
+		t.Errorf("%T %+v want %+v", first.Version(), first.Version(), 2)
+	}
+
+	data, err := sqlboiler.FindStockItem(context.Background(), db, id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data.Version != 2 {
+		t.Errorf("%T %+v want %+v", data.Version, data.Version, 2)
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestItemRepository(t, func(t *testing.T) item.IRepository {
+		db, err := database.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		r, err := sut.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
+
+func newSku(t *testing.T) item.Sku {
+	t.Helper()
+
+	sku, err := item.NewSku(uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sku
+}
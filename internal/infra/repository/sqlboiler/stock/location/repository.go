@@ -3,20 +3,29 @@ package location
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"openapi/internal/infra/sqlboiler"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 
+	"openapi/internal/domain/failure"
 	"openapi/internal/domain/stock/location"
 )
 
 type Repository struct {
 	location.IRepository
-	db *sql.DB
+	db boil.ContextExecutor
 }
 
-func NewRepository(db *sql.DB) (*Repository, error) {
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
 	if db == nil {
 		return nil, fmt.Errorf("NewRepository: db is nil")
 	}
@@ -25,49 +34,315 @@ func NewRepository(db *sql.DB) (*Repository, error) {
 	}, nil
 }
 
-func (r *Repository) Save(a *location.Aggregate) error {
-	data := &sqlboiler.StockLocation{
-		ID:      a.Id.String(),
-		Name:    a.Name.String(),
-		Deleted: a.IsDeleted(),
+func (r *Repository) Save(ctx context.Context, a *location.Aggregate) error {
+	now := time.Now().In(boil.GetLocation())
+
+	if a.Version() == 0 {
+		data := &sqlboiler.StockLocation{
+			ID:                 a.Id.String(),
+			Name:               a.Name.String(),
+			Type:               a.Type.String(),
+			MaxUnits:           maxUnits(a),
+			MaxVolume:          maxVolume(a),
+			MaxWeight:          maxWeight(a),
+			Deleted:            a.IsDeleted(),
+			AllowNegativeStock: a.AllowNegativeStock,
+			Version:            1,
+			ParentID:           parentId(a),
+		}
+		if a.IsDeleted() {
+			data.DeletedAt = null.TimeFrom(now)
+		}
+
+		err := data.Insert(ctx, r.db, boil.Infer())
+		if isUniqueViolation(err) {
+			return location.ErrNameTaken
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		columns := sqlboiler.M{
+			sqlboiler.StockLocationColumns.Name:               a.Name.String(),
+			sqlboiler.StockLocationColumns.Type:               a.Type.String(),
+			sqlboiler.StockLocationColumns.MaxUnits:           maxUnits(a),
+			sqlboiler.StockLocationColumns.MaxVolume:          maxVolume(a),
+			sqlboiler.StockLocationColumns.MaxWeight:          maxWeight(a),
+			sqlboiler.StockLocationColumns.ParentID:           parentId(a),
+			sqlboiler.StockLocationColumns.Deleted:            a.IsDeleted(),
+			sqlboiler.StockLocationColumns.AllowNegativeStock: a.AllowNegativeStock,
+			sqlboiler.StockLocationColumns.Version:            a.Version() + 1,
+			sqlboiler.StockLocationColumns.UpdatedAt:          now,
+		}
+		if !a.IsDeleted() {
+			columns[sqlboiler.StockLocationColumns.DeletedAt] = null.Time{}
+		}
+
+		// compare-and-swap on the version read by Get
+		updated, err := sqlboiler.StockLocations(
+			sqlboiler.StockLocationWhere.ID.EQ(a.Id.String()),
+			sqlboiler.StockLocationWhere.Version.EQ(a.Version()),
+		).UpdateAll(ctx, r.db, columns)
+		if isUniqueViolation(err) {
+			return location.ErrNameTaken
+		}
+		if err != nil {
+			return err
+		}
+
+		if updated == 0 {
+			return location.ErrVersionMismatch
+		}
+
+		// a location deleted again keeps the time it was deleted first
+		if a.IsDeleted() {
+			_, err := sqlboiler.StockLocations(
+				sqlboiler.StockLocationWhere.ID.EQ(a.Id.String()),
+				sqlboiler.StockLocationWhere.DeletedAt.IsNull(),
+			).UpdateAll(ctx, r.db, sqlboiler.M{
+				sqlboiler.StockLocationColumns.DeletedAt: now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, id location.Id) (*location.Aggregate, error) {
+	data, err := sqlboiler.FindStockLocation(ctx, r.db, id.UUID().String())
+	if errors.Is(err, sql.ErrNoRows) {
+		return &location.Aggregate{}, location.ErrNotFound
+	}
+	if err != nil {
+		return &location.Aggregate{}, err
+	}
+
+	a, err := restore(data)
+	if err != nil {
+		return &location.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) Find(ctx context.Context, id location.Id) (bool, error) {
+	found, err := sqlboiler.StockLocationExists(ctx, r.db, id.String())
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func (r *Repository) FindByName(ctx context.Context, name location.Name) (*location.Aggregate, error) {
+	data, err := sqlboiler.StockLocations(
+		sqlboiler.StockLocationWhere.Name.EQ(name.String()),
+		sqlboiler.StockLocationWhere.Deleted.EQ(false),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, location.ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := restore(data)
+	if err != nil {
+		return nil, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+	mods := []qm.QueryMod{}
+
+	if q.DeletedBefore != nil {
+		mods = append(mods,
+			sqlboiler.StockLocationWhere.Deleted.EQ(true),
+			sqlboiler.StockLocationWhere.DeletedAt.LT(null.TimeFrom(q.DeletedBefore.In(boil.GetLocation()))),
+		)
+	} else if !q.IncludeDeleted {
+		mods = append(mods, sqlboiler.StockLocationWhere.Deleted.EQ(false))
+	}
+
+	if q.ParentId != nil {
+		mods = append(mods, sqlboiler.StockLocationWhere.ParentID.EQ(null.StringFrom(q.ParentId.String())))
+	}
+
+	if q.Type != nil {
+		mods = append(mods, sqlboiler.StockLocationWhere.Type.EQ(q.Type.String()))
+	}
+
+	if q.NamePrefix != "" {
+		mods = append(mods, qm.Where("\"name\" LIKE ?", escapeLike(q.NamePrefix)+"%"))
+	}
+
+	direction, comparison := "ASC", ">"
+	if q.Order == location.OrderDesc {
+		direction, comparison = "DESC", "<"
 	}
 
-	err := data.Upsert(
-		context.Background(),
-		r.db,
-		true,
-		[]string{"id"},
-		boil.Whitelist("name", "deleted"),
-		boil.Infer(),
+	if q.After != nil {
+		mods = append(mods, qm.Where(
+			fmt.Sprintf("(\"name\", \"id\") %s (?, ?)", comparison),
+			q.After.Name.String(),
+			q.After.Id.String(),
+		))
+	}
+
+	mods = append(mods,
+		qm.OrderBy(fmt.Sprintf("\"name\" %s, \"id\" %s", direction, direction)),
+		qm.Limit(q.Limit),
+	)
+
+	return r.all(ctx, mods...)
+}
+
+func (r *Repository) Ancestors(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	return r.all(ctx,
+		qm.With(`RECURSIVE "ancestor" ("id", "depth") AS (
+			SELECT "parent_id", 1 FROM "stock_location" WHERE "id" = ? AND "parent_id" IS NOT NULL
+			UNION
+			SELECT "l"."parent_id", "a"."depth" + 1 FROM "stock_location" "l" JOIN "ancestor" "a" ON "l"."id" = "a"."id"
+			WHERE "l"."parent_id" IS NOT NULL
+		)`, id.String()),
+		qm.InnerJoin(`"ancestor" ON "ancestor"."id" = "stock_location"."id"`),
+		qm.OrderBy(`"ancestor"."depth" DESC`),
 	)
+}
+
+func (r *Repository) Descendants(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	return r.all(ctx,
+		qm.With(`RECURSIVE "descendant" ("id") AS (
+			SELECT "id" FROM "stock_location" WHERE "parent_id" = ?
+			UNION
+			SELECT "l"."id" FROM "stock_location" "l" JOIN "descendant" "d" ON "l"."parent_id" = "d"."id"
+		)`, id.String()),
+		qm.InnerJoin(`"descendant" ON "descendant"."id" = "stock_location"."id"`),
+	)
+}
+
+func (r *Repository) all(ctx context.Context, mods ...qm.QueryMod) ([]*location.Aggregate, error) {
+	data, err := sqlboiler.StockLocations(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]*location.Aggregate, 0, len(data))
+	for _, d := range data {
+		a, err := restore(d)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+func (r *Repository) Purge(ctx context.Context, id location.Id) error {
+	deleted, err := sqlboiler.StockLocations(
+		sqlboiler.StockLocationWhere.ID.EQ(id.String()),
+	).DeleteAll(ctx, r.db)
 	if err != nil {
 		return err
 	}
 
+	if deleted == 0 {
+		return location.ErrNotFound
+	}
+
 	return nil
 }
 
-func (r *Repository) Get(id location.Id) (*location.Aggregate, error) {
-	data, err := sqlboiler.FindStockLocation(context.Background(), r.db, id.UUID().String())
+func restore(data *sqlboiler.StockLocation) (*location.Aggregate, error) {
+	v, err := uuid.Parse(data.ID)
 	if err != nil {
-		return &location.Aggregate{}, err
+		return nil, err
+	}
+
+	id, err := location.NewId(v)
+	if err != nil {
+		return nil, err
 	}
 
 	name, err := location.NewName(data.Name)
 	if err != nil {
-		return &location.Aggregate{}, err
+		return nil, err
 	}
 
-	a := location.RestoreAggregate(id, name, data.Deleted)
+	typ, err := location.NewType(data.Type)
+	if err != nil {
+		return nil, err
+	}
 
-	return a, nil
+	capacity, err := location.NewCapacity(data.MaxUnits.Ptr(), data.MaxVolume.Ptr(), data.MaxWeight.Ptr())
+	if err != nil {
+		return nil, err
+	}
+
+	var parentId *location.Id
+	if data.ParentID.Valid {
+		v, err := uuid.Parse(data.ParentID.String)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := location.NewId(v)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &p
+	}
+
+	return location.RestoreAggregate(id, name, typ, capacity, parentId, data.Deleted, data.AllowNegativeStock, data.Version), nil
 }
 
-func (r *Repository) Find(id location.Id) (bool, error) {
-	found, err := sqlboiler.StockLocationExists(context.Background(), r.db, id.String())
-	if err != nil {
-		return false, err
+// parentId returns the parent_id of a, NULL for a root location.
+func parentId(a *location.Aggregate) null.String {
+	p := a.ParentId()
+	if p == nil {
+		return null.String{}
 	}
+	return null.StringFrom(p.String())
+}
 
-	return found, nil
+// maxUnits returns the max_units of a, NULL when the units are unlimited.
+func maxUnits(a *location.Aggregate) null.Int64 {
+	return null.Int64FromPtr(a.Capacity.Units())
+}
+
+// maxVolume returns the max_volume of a, NULL when the volume is unlimited.
+func maxVolume(a *location.Aggregate) null.Int64 {
+	v := a.Capacity.Volume()
+	if v == nil {
+		return null.Int64{}
+	}
+	return null.Int64From(v.Int64())
+}
+
+// maxWeight returns the max_weight of a, NULL when the weight is unlimited.
+func maxWeight(a *location.Aggregate) null.Int64 {
+	v := a.Capacity.Weight()
+	if v == nil {
+		return null.Int64{}
+	}
+	return null.Int64From(v.Int64())
+}
+
+// isUniqueViolation reports whether err breaks the unique index on the names of active locations.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "stock_location_name_active_key"
+}
+
+// escapeLike escapes the LIKE wildcards so that the prefix is matched literally.
+func escapeLike(v string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(v)
 }
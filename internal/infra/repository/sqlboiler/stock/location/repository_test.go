@@ -2,13 +2,16 @@ package location_test
 
 import (
 	"context"
+	"errors"
 
 	"reflect"
 	"testing"
 	"time"
 
+	"openapi/internal/domain/failure"
 	"openapi/internal/domain/stock/location"
 	"openapi/internal/infra/database"
+	"openapi/internal/infra/repository/repositorytest"
 	sut "openapi/internal/infra/repository/sqlboiler/stock/location"
 	"openapi/internal/infra/sqlboiler"
 
@@ -72,7 +75,7 @@ func TestSaveFailInvalidDb(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	name, err := location.NewName("test")
+	name, err := location.NewName("test_" + uuid.NewString())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -80,7 +83,7 @@ func TestSaveFailInvalidDb(t *testing.T) {
 	a := location.NewAggregate(id, name)
 
 	// When
-	err = r.Save(a)
+	err = r.Save(context.Background(), a)
 
 	// Then
 	if err == nil {
@@ -111,7 +114,7 @@ func TestCreate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	name, err := location.NewName("test")
+	name, err := location.NewName("test_" + uuid.NewString())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -119,16 +122,16 @@ func TestCreate(t *testing.T) {
 	a := location.NewAggregate(id, name)
 
 	// When
-	before, err := r.Get(a.Id)
+	before, err := r.Get(context.Background(), a.Id)
 	if err == nil {
 		t.Fatalf("expected error but returned nil, %+v", before)
 	}
 
-	if err = r.Save(a); err != nil {
+	if err = r.Save(context.Background(), a); err != nil {
 		t.Fatal(err)
 	}
 
-	after, err := r.Get(a.Id)
+	after, err := r.Get(context.Background(), a.Id)
 	if err != nil {
 		t.Fatalf("expected error but returned nil, %+v", err)
 	}
@@ -185,7 +188,7 @@ func TestUpdate(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	name, err := location.NewName("before")
+	name, err := location.NewName("before_" + uuid.NewString())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -195,7 +198,7 @@ func TestUpdate(t *testing.T) {
 	currentDateTime := time.Now().UTC()
 	dataFormat := "2006-01-02 15:04:05.000000 +09:00"
 
-	if err = r.Save(before); err != nil {
+	if err = r.Save(context.Background(), before); err != nil {
 		t.Fatal(err)
 	}
 
@@ -205,12 +208,12 @@ func TestUpdate(t *testing.T) {
 	}
 
 	// When
-	after, err := r.Get(before.Id)
+	after, err := r.Get(context.Background(), before.Id)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	changedName, err := location.NewName("after")
+	changedName, err := location.NewName("after_" + uuid.NewString())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -218,7 +221,7 @@ func TestUpdate(t *testing.T) {
 	after.Name = changedName
 	after.Delete()
 
-	if err = r.Save(after); err != nil {
+	if err = r.Save(context.Background(), after); err != nil {
 		t.Fatal(err)
 	}
 
@@ -269,14 +272,14 @@ func TestGetFailInvalidData(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	name, err := location.NewName("test")
+	name, err := location.NewName("test_" + uuid.NewString())
 	if err != nil {
 		t.Fatal(err)
 	}
 
 	a := location.NewAggregate(id, name)
 
-	if err := r.Save(a); err != nil {
+	if err := r.Save(context.Background(), a); err != nil {
 		t.Fatal(err)
 	}
 
@@ -298,12 +301,45 @@ func TestGetFailInvalidData(t *testing.T) {
 	}
 
 	// When
-	_, err = r.Get(id)
+	_, err = r.Get(context.Background(), id)
 
 	// Then
 	if err == nil {
 		t.Fatalf("error must not be nil")
 	}
+
+	if !failure.IsInternal(err) {
+		t.Errorf("%T %+v want a fault of the server", err, err)
+	}
+}
+
+func TestGetFailNotFound(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.Get(context.Background(), id)
+
+	// Then
+	if !errors.Is(err, location.ErrNotFound) {
+		t.Fatalf("%T = %v, want %v", err, err, location.ErrNotFound)
+	}
 }
 
 func TestFind(t *testing.T) {
@@ -327,7 +363,7 @@ func TestFind(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	name, err := location.NewName("test")
+	name, err := location.NewName("test_" + uuid.NewString())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -335,16 +371,16 @@ func TestFind(t *testing.T) {
 	a := location.NewAggregate(id, name)
 
 	// When
-	notFound, err := r.Find(a.Id)
+	notFound, err := r.Find(context.Background(), a.Id)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	if err = r.Save(a); err != nil {
+	if err = r.Save(context.Background(), a); err != nil {
 		t.Fatal(err)
 	}
 
-	found, err := r.Find(a.Id)
+	found, err := r.Find(context.Background(), a.Id)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -381,10 +417,201 @@ func TestFindFail(t *testing.T) {
 	}
 
 	// When
-	_, err = r.Find(id)
+	_, err = r.Find(context.Background(), id)
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
+
+func TestList(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	prefix := "list_%" + uuid.NewString()
+	as := []*location.Aggregate{}
+	for _, suffix := range []string{"a", "b", "c"} {
+		id, err := location.NewId(uuid.New())
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		name, err := location.NewName(prefix + suffix)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a := location.NewAggregate(id, name)
+		if err := r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+		as = append(as, a)
+	}
+
+	as[1].Delete()
+	if err := r.Save(context.Background(), as[1]); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	active, err := r.List(context.Background(), location.ListQuery{NamePrefix: prefix, Order: location.OrderAsc, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	all, err := r.List(context.Background(), location.ListQuery{NamePrefix: prefix, IncludeDeleted: true, Order: location.OrderDesc, Limit: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	after, err := r.List(context.Background(), location.ListQuery{
+		NamePrefix:     prefix,
+		IncludeDeleted: true,
+		Order:          location.OrderAsc,
+		After:          &location.Cursor{Name: as[0].Name, Id: as[0].Id},
+		Limit:          1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(active) != 2 || active[0].Id != as[0].Id || active[1].Id != as[2].Id {
+		t.Errorf("%T %+v want %+v and %+v", active, active, as[0], as[2])
+	}
+
+	if len(all) != 3 || all[0].Id != as[2].Id || all[2].Id != as[0].Id {
+		t.Errorf("%T %+v want descending %+v", all, all, as)
+	}
+
+	if len(after) != 1 || after[0].Id != as[1].Id || !after[0].IsDeleted() {
+		t.Errorf("%T %+v want %+v", after, after, as[1])
+	}
+}
+
+func TestListFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.List(context.Background(), location.ListQuery{Order: location.OrderAsc, Limit: 1})
 
 	// Then
 	if err == nil {
 		t.Fatalf("error must not be nil")
 	}
 }
+
+func TestSaveFailVersionMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test_" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := location.NewAggregate(id, name)
+
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := r.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, err := r.Get(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	if err = r.Save(context.Background(), first); err != nil {
+		t.Fatal(err)
+	}
+
+	err = r.Save(context.Background(), second)
+
+	// Then
+	if !errors.Is(err, location.ErrVersionMismatch) {
+		t.Fatalf("%T %+v want %+v", err, err, location.ErrVersionMismatch)
+	}
+
+	if a.Version() != 1 {
+		t.Errorf("%T %+v want %+v", a.Version(), a.Version(), 1)
+	}
+
+	if first.Version() != 2 {
+		t.Errorf("%T %+v want %+v", first.Version(), first.Version(), 2)
+	}
+
+	data, err := sqlboiler.FindStockLocation(context.Background(), db, id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data.Version != 2 {
+		t.Errorf("%T %+v want %+v", data.Version, data.Version, 2)
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestLocationRepository(t, func(t *testing.T) location.IRepository {
+		db, err := database.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		r, err := sut.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,153 @@
+package lot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+)
+
+type Repository struct {
+	lot.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+// Save records the lot. Recorded lots are never updated.
+func (r *Repository) Save(ctx context.Context, a *lot.Aggregate) error {
+	data := &sqlboiler.StockLot{
+		ID:             a.Id.String(),
+		ItemID:         a.ItemId.String(),
+		Number:         a.Number.String(),
+		ManufacturedOn: nullDate(a.ManufacturedOn),
+		ExpiresOn:      nullDate(a.ExpiresOn),
+	}
+
+	err := data.Insert(ctx, r.db, boil.Infer())
+	if isUniqueViolation(err) {
+		return lot.ErrNumberTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, itemId item.Id, number lot.Number) (*lot.Aggregate, error) {
+	data, err := sqlboiler.StockLots(
+		sqlboiler.StockLotWhere.ItemID.EQ(itemId.String()),
+		sqlboiler.StockLotWhere.Number.EQ(number.String()),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &lot.Aggregate{}, lot.ErrNotFound
+	}
+	if err != nil {
+		return &lot.Aggregate{}, err
+	}
+
+	a, err := restore(data)
+	if err != nil {
+		return &lot.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q lot.ListQuery) ([]*lot.Aggregate, error) {
+	mods := []qm.QueryMod{}
+
+	if q.ItemId != nil {
+		mods = append(mods, sqlboiler.StockLotWhere.ItemID.EQ(q.ItemId.String()))
+	}
+
+	if q.ExpiresBy != nil {
+		mods = append(mods, sqlboiler.StockLotWhere.ExpiresOn.LTE(nullDate(*q.ExpiresBy)))
+	}
+
+	mods = append(mods,
+		qm.OrderBy("\"expires_on\" ASC NULLS LAST, \"number\" COLLATE \"C\" ASC, \"item_id\" ASC"),
+	)
+
+	data, err := sqlboiler.StockLots(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]*lot.Aggregate, 0, len(data))
+	for _, d := range data {
+		a, err := restore(d)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+// nullDate returns the column of a date, NULL when it is unknown.
+func nullDate(d lot.Date) null.Time {
+	return null.NewTime(d.Time(), !d.IsZero())
+}
+
+func restoreDate(v null.Time) lot.Date {
+	if !v.Valid {
+		return lot.Date{}
+	}
+	return lot.DateOf(v.Time)
+}
+
+func restore(data *sqlboiler.StockLot) (*lot.Aggregate, error) {
+	v, err := uuid.Parse(data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := lot.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err = uuid.Parse(data.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemId, err := item.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := lot.NewNumber(data.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	return lot.RestoreAggregate(id, itemId, number, restoreDate(data.ManufacturedOn), restoreDate(data.ExpiresOn)), nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "stock_lot_item_id_number_key"
+}
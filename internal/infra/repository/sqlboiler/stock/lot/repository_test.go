@@ -0,0 +1,97 @@
+package lot_test
+
+import (
+	"context"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/repository/repositorytest"
+	sut "openapi/internal/infra/repository/sqlboiler/stock/lot"
+
+	"github.com/google/uuid"
+)
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// When
+	r, err := sut.NewRepository(db)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r == nil {
+		t.Fatal("repository must not be nil")
+	}
+}
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestListFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.List(context.Background(), lot.ListQuery{ItemId: &itemId})
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestLotRepository(t, func(t *testing.T) lot.IRepository {
+		db, err := database.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		r, err := sut.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,279 @@
+package movement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+)
+
+type Repository struct {
+	movement.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+// Save appends the movement to the ledger. Recorded movements are never updated.
+func (r *Repository) Save(ctx context.Context, a *movement.Aggregate) error {
+	serials, err := encodeSerials(a.Serials)
+	if err != nil {
+		return err
+	}
+
+	data := &sqlboiler.StockMovement{
+		ID:         a.Id.String(),
+		ItemID:     a.ItemId.String(),
+		LocationID: a.LocationId.String(),
+		Kind:       a.Kind.String(),
+		Quantity:   a.Quantity.Int64(),
+		LotNumber:  nullLot(a.Lot),
+		Serials:    serials,
+	}
+
+	err = data.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetBalance(ctx context.Context, itemId item.Id, locationId location.Id, lotNumber lot.Number) (movement.Balance, error) {
+	lotMod := sqlboiler.StockMovementWhere.LotNumber.IsNull()
+	if !lotNumber.IsZero() {
+		lotMod = sqlboiler.StockMovementWhere.LotNumber.EQ(null.StringFrom(lotNumber.String()))
+	}
+
+	var quantity int64
+	err := sqlboiler.StockMovements(
+		qm.Select("COALESCE(SUM(\"quantity\"), 0)"),
+		sqlboiler.StockMovementWhere.ItemID.EQ(itemId.String()),
+		sqlboiler.StockMovementWhere.LocationID.EQ(locationId.String()),
+		lotMod,
+	).QueryRowContext(ctx, r.db).Scan(&quantity)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	return movement.Balance{
+		ItemId:     itemId,
+		LocationId: locationId,
+		Lot:        lotNumber,
+		Quantity:   quantity,
+	}, nil
+}
+
+type balance struct {
+	ItemID     string      `boil:"item_id"`
+	LocationID string      `boil:"location_id"`
+	LotNumber  null.String `boil:"lot_number"`
+	Quantity   int64       `boil:"quantity"`
+}
+
+func (r *Repository) ListBalances(ctx context.Context, q movement.BalanceQuery) ([]movement.Balance, error) {
+	mods := []qm.QueryMod{
+		qm.Select("\"item_id\"", "\"location_id\"", "\"lot_number\"", "SUM(\"quantity\") AS \"quantity\""),
+	}
+
+	if q.ItemId != nil {
+		mods = append(mods, sqlboiler.StockMovementWhere.ItemID.EQ(q.ItemId.String()))
+	}
+
+	if q.LocationId != nil {
+		mods = append(mods, sqlboiler.StockMovementWhere.LocationID.EQ(q.LocationId.String()))
+	}
+
+	if q.LocationIds != nil {
+		ids := make([]string, 0, len(q.LocationIds))
+		for _, id := range q.LocationIds {
+			ids = append(ids, id.String())
+		}
+		if len(ids) == 0 {
+			mods = append(mods, qm.Where("FALSE"))
+		} else {
+			mods = append(mods, sqlboiler.StockMovementWhere.LocationID.IN(ids))
+		}
+	}
+
+	if q.After != nil {
+		mods = append(mods, qm.Where(
+			"(\"item_id\", \"location_id\", COALESCE(\"lot_number\", '') COLLATE \"C\") > (?, ?, ?)",
+			q.After.ItemId.String(),
+			q.After.LocationId.String(),
+			q.After.Lot.String(),
+		))
+	}
+
+	mods = append(mods,
+		qm.GroupBy("\"item_id\", \"location_id\", \"lot_number\""),
+		qm.Having("SUM(\"quantity\") <> 0"),
+		qm.OrderBy("\"item_id\" ASC, \"location_id\" ASC, \"lot_number\" COLLATE \"C\" ASC NULLS FIRST"),
+	)
+
+	if q.Limit > 0 {
+		mods = append(mods, qm.Limit(q.Limit))
+	}
+
+	var data []*balance
+	err := sqlboiler.StockMovements(mods...).Bind(ctx, r.db, &data)
+	if err != nil {
+		return nil, err
+	}
+
+	bs := make([]movement.Balance, 0, len(data))
+	for _, d := range data {
+		b, err := restoreBalance(d)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		bs = append(bs, b)
+	}
+
+	return bs, nil
+}
+
+// ListBySerial returns the movements in the order of their creation. The two movements of a transfer are
+// created together, so the one taking the serial out comes first.
+func (r *Repository) ListBySerial(ctx context.Context, itemId item.Id, number serial.Number) ([]*movement.Aggregate, error) {
+	data, err := sqlboiler.StockMovements(
+		sqlboiler.StockMovementWhere.ItemID.EQ(itemId.String()),
+		qm.Where("\"serials\"::jsonb @> jsonb_build_array(?::text)", number.String()),
+		qm.OrderBy("\"created_at\" ASC, \"quantity\" ASC"),
+	).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]*movement.Aggregate, 0, len(data))
+	for _, d := range data {
+		a, err := restore(d)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	found, err := sqlboiler.StockMovements(
+		sqlboiler.StockMovementWhere.LocationID.EQ(locationId.String()),
+	).Exists(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// encodeSerials returns the serials column of a movement, a JSON array of the serial numbers.
+func encodeSerials(ns []serial.Number) (string, error) {
+	vs := make([]string, 0, len(ns))
+	for _, n := range ns {
+		vs = append(vs, n.String())
+	}
+
+	b, err := json.Marshal(vs)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// nullLot returns the lot_number column of a movement, NULL when it has no lot.
+func nullLot(n lot.Number) null.String {
+	return null.NewString(n.String(), !n.IsZero())
+}
+
+func restoreBalance(data *balance) (movement.Balance, error) {
+	itemUuid, err := uuid.Parse(data.ItemID)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	itemId, err := item.NewId(itemUuid)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	locationUuid, err := uuid.Parse(data.LocationID)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	locationId, err := location.NewId(locationUuid)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	var lotNumber lot.Number
+	if data.LotNumber.Valid {
+		lotNumber, err = lot.NewNumber(data.LotNumber.String)
+		if err != nil {
+			return movement.Balance{}, err
+		}
+	}
+
+	return movement.Balance{
+		ItemId:     itemId,
+		LocationId: locationId,
+		Lot:        lotNumber,
+		Quantity:   data.Quantity,
+	}, nil
+}
+
+func restore(data *sqlboiler.StockMovement) (*movement.Aggregate, error) {
+	v, err := uuid.Parse(data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := movement.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := movement.NewKind(data.Kind)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := restoreBalance(&balance{ItemID: data.ItemID, LocationID: data.LocationID, LotNumber: data.LotNumber, Quantity: data.Quantity})
+	if err != nil {
+		return nil, failure.Internal(err)
+	}
+
+	var vs []string
+	if err := json.Unmarshal([]byte(data.Serials), &vs); err != nil {
+		return nil, err
+	}
+
+	serials, err := serial.NewNumbers(vs)
+	if err != nil {
+		return nil, err
+	}
+
+	return movement.RestoreAggregate(id, b.ItemId, b.LocationId, kind, movement.RestoreQuantity(data.Quantity), b.Lot, serials), nil
+}
@@ -0,0 +1,357 @@
+package movement_test
+
+import (
+	"context"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/repository/repositorytest"
+	sut "openapi/internal/infra/repository/sqlboiler/stock/movement"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+)
+
+func newMovement(t *testing.T, itemId item.Id, locationId location.Id, kind movement.Kind, value int64) *movement.Aggregate {
+	t.Helper()
+
+	id, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	quantity, err := movement.NewQuantity(kind, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return movement.NewAggregate(id, itemId, locationId, kind, quantity)
+}
+
+func newIds(t *testing.T) (item.Id, location.Id) {
+	t.Helper()
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationId, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return itemId, locationId
+}
+
+func TestNewRepository(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// When
+	r, err := sut.NewRepository(db)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if r == nil {
+		t.Fatal("repository must not be nil")
+	}
+}
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	itemId, locationId := newIds(t)
+	a := newMovement(t, itemId, locationId, movement.Issue, 3)
+
+	// When
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	data, err := sqlboiler.FindStockMovement(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data.ItemID != itemId.String() {
+		t.Errorf("%T %+v want %+v", data.ItemID, data.ItemID, itemId)
+	}
+
+	if data.LocationID != locationId.String() {
+		t.Errorf("%T %+v want %+v", data.LocationID, data.LocationID, locationId)
+	}
+
+	if data.Kind != movement.Issue.String() {
+		t.Errorf("%T %+v want %+v", data.Kind, data.Kind, movement.Issue)
+	}
+
+	if data.Quantity != -3 {
+		t.Errorf("%T %+v want %+v", data.Quantity, data.Quantity, -3)
+	}
+}
+
+func TestSaveFailAppendOnly(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	itemId, locationId := newIds(t)
+	a := newMovement(t, itemId, locationId, movement.Receipt, 3)
+
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := sqlboiler.FindStockMovement(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, deleteErr := data.Delete(context.Background(), db)
+	saveErr := r.Save(context.Background(), a)
+
+	// Then
+	if deleteErr == nil {
+		t.Error("delete must be rejected")
+	}
+
+	if saveErr == nil {
+		t.Error("saving the same movement twice must be rejected")
+	}
+}
+
+func TestGetBalance(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	itemId, locationId := newIds(t)
+
+	// When
+	empty, err := r.GetBalance(context.Background(), itemId, locationId, lot.Number{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, a := range []*movement.Aggregate{
+		newMovement(t, itemId, locationId, movement.Receipt, 10),
+		newMovement(t, itemId, locationId, movement.Issue, 4),
+		newMovement(t, itemId, locationId, movement.Adjustment, -1),
+	} {
+		if err = r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	b, err := r.GetBalance(context.Background(), itemId, locationId, lot.Number{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if empty.Quantity != 0 {
+		t.Errorf("%T %+v want %+v", empty.Quantity, empty.Quantity, 0)
+	}
+
+	if b.ItemId != itemId || b.LocationId != locationId {
+		t.Errorf("%T %+v want %+v %+v", b, b, itemId, locationId)
+	}
+
+	if b.Quantity != 5 {
+		t.Errorf("%T %+v want %+v", b.Quantity, b.Quantity, 5)
+	}
+}
+
+func TestGetBalanceFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	itemId, locationId := newIds(t)
+
+	// When
+	_, err = r.GetBalance(context.Background(), itemId, locationId, lot.Number{})
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestListBalances(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	itemId, locationId := newIds(t)
+	_, otherLocationId := newIds(t)
+	_, emptyLocationId := newIds(t)
+
+	for _, a := range []*movement.Aggregate{
+		newMovement(t, itemId, locationId, movement.Receipt, 10),
+		newMovement(t, itemId, otherLocationId, movement.Receipt, 2),
+		newMovement(t, itemId, emptyLocationId, movement.Receipt, 2),
+		newMovement(t, itemId, emptyLocationId, movement.Issue, 2),
+	} {
+		if err = r.Save(context.Background(), a); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	byItem, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	byLocation, err := r.ListBalances(context.Background(), movement.BalanceQuery{ItemId: &itemId, LocationId: &locationId})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(byItem) != 2 {
+		t.Fatalf("%T %+v want %+v", len(byItem), len(byItem), 2)
+	}
+
+	for _, b := range byItem {
+		if b.LocationId == emptyLocationId {
+			t.Errorf("zero balance must be omitted, %+v", b)
+		}
+	}
+
+	if len(byLocation) != 1 {
+		t.Fatalf("%T %+v want %+v", len(byLocation), len(byLocation), 1)
+	}
+
+	if byLocation[0].Quantity != 10 {
+		t.Errorf("%T %+v want %+v", byLocation[0].Quantity, byLocation[0].Quantity, 10)
+	}
+}
+
+func TestListBalancesFail(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = r.ListBalances(context.Background(), movement.BalanceQuery{})
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestMovementRepository(t, func(t *testing.T) movement.IRepository {
+		db, err := database.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		r, err := sut.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,176 @@
+package serial
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+	"github.com/lib/pq"
+	"github.com/volatiletech/null/v8"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/serial"
+)
+
+type Repository struct {
+	serial.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+// Save inserts the serial when it is new, and otherwise updates where it is.
+func (r *Repository) Save(ctx context.Context, a *serial.Aggregate) error {
+	data := &sqlboiler.StockSerial{
+		ID:         a.Id.String(),
+		ItemID:     a.ItemId.String(),
+		Number:     a.Number.String(),
+		LocationID: nullLocation(a),
+	}
+
+	err := data.Upsert(ctx, r.db, true,
+		[]string{sqlboiler.StockSerialColumns.ID},
+		boil.Whitelist(sqlboiler.StockSerialColumns.LocationID),
+		boil.Infer(),
+	)
+	if isUniqueViolation(err) {
+		return serial.ErrNumberTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, itemId item.Id, number serial.Number) (*serial.Aggregate, error) {
+	data, err := sqlboiler.StockSerials(
+		sqlboiler.StockSerialWhere.ItemID.EQ(itemId.String()),
+		sqlboiler.StockSerialWhere.Number.EQ(number.String()),
+	).One(ctx, r.db)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &serial.Aggregate{}, serial.ErrNotFound
+	}
+	if err != nil {
+		return &serial.Aggregate{}, err
+	}
+
+	a, err := restore(data)
+	if err != nil {
+		return &serial.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q serial.ListQuery) ([]*serial.Aggregate, error) {
+	mods := []qm.QueryMod{}
+
+	if q.Number != nil {
+		mods = append(mods, sqlboiler.StockSerialWhere.Number.EQ(q.Number.String()))
+	}
+
+	if q.ItemId != nil {
+		mods = append(mods, sqlboiler.StockSerialWhere.ItemID.EQ(q.ItemId.String()))
+	}
+
+	mods = append(mods,
+		qm.OrderBy("\"item_id\" ASC, \"number\" COLLATE \"C\" ASC"),
+	)
+
+	data, err := sqlboiler.StockSerials(mods...).All(ctx, r.db)
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]*serial.Aggregate, 0, len(data))
+	for _, d := range data {
+		a, err := restore(d)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	return as, nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	found, err := sqlboiler.StockSerials(
+		sqlboiler.StockSerialWhere.LocationID.EQ(null.StringFrom(locationId.String())),
+	).Exists(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// nullLocation returns the location_id column of a serial, NULL while it is not in stock.
+func nullLocation(a *serial.Aggregate) null.String {
+	l, ok := a.LocationId()
+	return null.NewString(l.String(), ok)
+}
+
+func restore(data *sqlboiler.StockSerial) (*serial.Aggregate, error) {
+	v, err := uuid.Parse(data.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := serial.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err = uuid.Parse(data.ItemID)
+	if err != nil {
+		return nil, err
+	}
+
+	itemId, err := item.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := serial.NewNumber(data.Number)
+	if err != nil {
+		return nil, err
+	}
+
+	var locationId *location.Id
+	if data.LocationID.Valid {
+		v, err = uuid.Parse(data.LocationID.String)
+		if err != nil {
+			return nil, err
+		}
+
+		l, err := location.NewId(v)
+		if err != nil {
+			return nil, err
+		}
+		locationId = &l
+	}
+
+	return serial.RestoreAggregate(id, itemId, number, locationId), nil
+}
+
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+	return errors.As(err, &pqErr) && pqErr.Code == "23505" && pqErr.Constraint == "stock_serial_item_id_number_key"
+}
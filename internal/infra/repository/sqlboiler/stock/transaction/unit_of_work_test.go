@@ -0,0 +1,259 @@
+package transaction_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/repository/repositorytest"
+	sut "openapi/internal/infra/repository/sqlboiler/stock/transaction"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+)
+
+func newLocation(t *testing.T) *location.Aggregate {
+	t.Helper()
+
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name, err := location.NewName("test_" + uuid.NewString())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.NewAggregate(id, name)
+}
+
+func TestNewUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	// When
+	u, err := sut.NewUnitOfWork(db)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if u == nil {
+		t.Fatal("unit of work must not be nil")
+	}
+}
+
+func TestNewUnitOfWorkFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewUnitOfWork(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestDoCommit(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	u, err := sut.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	a := newLocation(t)
+
+	// When
+	err = u.Do(context.Background(), func(r transaction.IRepositories) error {
+		if err := r.Location().Save(context.Background(), a); err != nil {
+			return err
+		}
+
+		// the transaction sees its own writes
+		found, err := r.Location().Find(context.Background(), a.Id)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return fmt.Errorf("saved location not found in the transaction")
+		}
+
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	found, err := sqlboiler.StockLocationExists(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !found {
+		t.Errorf("location must be committed")
+	}
+}
+
+func TestDoRollback(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	u, err := sut.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	a := newLocation(t)
+
+	// When
+	err = u.Do(context.Background(), func(r transaction.IRepositories) error {
+		if err := r.Location().Save(context.Background(), a); err != nil {
+			return err
+		}
+		return fmt.Errorf("fail after save")
+	})
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+
+	found, err := sqlboiler.StockLocationExists(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if found {
+		t.Errorf("location must be rolled back")
+	}
+}
+
+func TestDoRollbackOnPanic(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	u, err := sut.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	a := newLocation(t)
+
+	// When
+	func() {
+		defer func() {
+			if p := recover(); p == nil {
+				t.Error("panic must be propagated")
+			}
+		}()
+
+		u.Do(context.Background(), func(r transaction.IRepositories) error {
+			if err := r.Location().Save(context.Background(), a); err != nil {
+				return err
+			}
+			panic("fail after save")
+		})
+	}()
+
+	// Then
+	found, err := sqlboiler.StockLocationExists(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if found {
+		t.Errorf("location must be rolled back")
+	}
+}
+
+func TestDoFailInvalidDb(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	db.Close()
+
+	u, err := sut.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	called := false
+	err = u.Do(context.Background(), func(r transaction.IRepositories) error {
+		called = true
+		return nil
+	})
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+
+	if called {
+		t.Errorf("fn must not be called without a transaction")
+	}
+}
+
+func TestUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestUnitOfWork(t, func(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories) {
+		db, err := database.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		u, err := sut.NewUnitOfWork(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := sut.NewRepositories(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return u, r
+	})
+}
@@ -0,0 +1,77 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transfer"
+	movementInfra "openapi/internal/infra/repository/sqlboiler/stock/movement"
+)
+
+type Repository struct {
+	transfer.IRepository
+	db        boil.ContextExecutor
+	movements *movementInfra.Repository
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx.
+// Save writes several rows, so db should be a *sql.Tx to keep them atomic.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+
+	movements, err := movementInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:        db,
+		movements: movements,
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *transfer.Aggregate) error {
+	if err := r.movements.Save(ctx, a.Out); err != nil {
+		return err
+	}
+
+	if err := r.movements.Save(ctx, a.In); err != nil {
+		return err
+	}
+
+	data := &sqlboiler.StockTransfer{
+		ID:             a.Id.String(),
+		ItemID:         a.ItemId.String(),
+		FromLocationID: a.From.String(),
+		ToLocationID:   a.To.String(),
+		Quantity:       a.Quantity(),
+		OutMovementID:  a.Out.Id.String(),
+		InMovementID:   a.In.Id.String(),
+	}
+
+	err := data.Insert(ctx, r.db, boil.Infer())
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	found, err := sqlboiler.StockTransfers(
+		sqlboiler.StockTransferWhere.FromLocationID.EQ(locationId.String()),
+		qm.Or2(sqlboiler.StockTransferWhere.ToLocationID.EQ(locationId.String())),
+	).Exists(ctx, r.db)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
@@ -0,0 +1,151 @@
+package transfer_test
+
+import (
+	"context"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/transfer"
+	"openapi/internal/infra/database"
+	"openapi/internal/infra/repository/repositorytest"
+	movementInfra "openapi/internal/infra/repository/sqlboiler/stock/movement"
+	sut "openapi/internal/infra/repository/sqlboiler/stock/transfer"
+	"openapi/internal/infra/sqlboiler"
+
+	"github.com/google/uuid"
+)
+
+func newTransfer(t *testing.T, quantity int64) *transfer.Aggregate {
+	t.Helper()
+
+	id, err := transfer.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	from, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	to, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	outId, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	inId, err := movement.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := transfer.NewAggregate(id, itemId, from, to, quantity, outId, inId)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return a
+}
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestSave(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db, err := database.Open()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	a := newTransfer(t, 3)
+
+	// When
+	if err = r.Save(context.Background(), a); err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	data, err := sqlboiler.FindStockTransfer(context.Background(), db, a.Id.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if data.FromLocationID != a.From.String() || data.ToLocationID != a.To.String() {
+		t.Errorf("%T %+v want %+v", data, data, a)
+	}
+
+	if data.Quantity != 3 {
+		t.Errorf("%T %+v want %+v", data.Quantity, data.Quantity, 3)
+	}
+
+	out, err := sqlboiler.FindStockMovement(context.Background(), db, data.OutMovementID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out.LocationID != a.From.String() || out.Quantity != -3 {
+		t.Errorf("%T %+v want %+v", out, out, a.Out)
+	}
+
+	in, err := sqlboiler.FindStockMovement(context.Background(), db, data.InMovementID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if in.LocationID != a.To.String() || in.Quantity != 3 {
+		t.Errorf("%T %+v want %+v", in, in, a.In)
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestTransferRepository(t, func(t *testing.T) (transfer.IRepository, movement.IRepository) {
+		db, err := database.Open()
+		if err != nil {
+			t.Fatal(err)
+		}
+		t.Cleanup(func() { db.Close() })
+
+		r, err := sut.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		movements, err := movementInfra.NewRepository(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return r, movements
+	})
+}
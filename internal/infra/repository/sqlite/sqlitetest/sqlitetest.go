@@ -0,0 +1,37 @@
+// Package sqlitetest opens SQLite databases for the tests of the SQLite repositories.
+package sqlitetest
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+
+	_ "modernc.org/sqlite"
+
+	"openapi/internal/infra/database/migration"
+)
+
+// Open returns a database of its own for the test, migrated to the latest schema
+// and closed when the test is over.
+func Open(t *testing.T) *sql.DB {
+	t.Helper()
+
+	dsn := "file:" + filepath.Join(t.TempDir(), "test.db") + "?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)&_txlock=immediate"
+	db, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+
+	m, err := migration.New(db, "sqlite")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := m.Up(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
@@ -0,0 +1,300 @@
+package item
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/measure"
+)
+
+// columns are what restore scans.
+const columns = `"id", "sku", "name", "base_unit", "barcodes", "pack_sizes", "unit_volume", "unit_weight", "lot_tracked", "serialized", "deleted", "version"`
+
+type Repository struct {
+	item.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx
+// of a SQLite database.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *item.Aggregate) error {
+	barcodes, packSizes, err := encode(a)
+	if err != nil {
+		return err
+	}
+
+	if a.Version() == 0 {
+		_, err := r.db.ExecContext(ctx,
+			`INSERT INTO "stock_item" ("id", "sku", "name", "base_unit", "barcodes", "pack_sizes", "unit_volume", "unit_weight", "lot_tracked", "serialized", "deleted", "version")
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 1)`,
+			a.Id.String(), a.Sku.String(), a.Name.String(), a.BaseUnit().String(), barcodes, packSizes,
+			a.UnitVolume.Int64(), a.UnitWeight.Int64(), a.LotTracked, a.Serialized, a.IsDeleted(),
+		)
+		if isUniqueViolation(err) {
+			return item.ErrSkuTaken
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		// compare-and-swap on the version read by Get
+		res, err := r.db.ExecContext(ctx,
+			`UPDATE "stock_item" SET "sku" = ?, "name" = ?, "barcodes" = ?, "pack_sizes" = ?,
+				"unit_volume" = ?, "unit_weight" = ?, "lot_tracked" = ?, "serialized" = ?, "deleted" = ?, "version" = ?,
+				"updated_at" = strftime('%Y-%m-%d %H:%M:%f', 'now')
+			WHERE "id" = ? AND "version" = ?`,
+			a.Sku.String(), a.Name.String(), barcodes, packSizes,
+			a.UnitVolume.Int64(), a.UnitWeight.Int64(), a.LotTracked, a.Serialized, a.IsDeleted(), a.Version()+1, a.Id.String(), a.Version(),
+		)
+		if isUniqueViolation(err) {
+			return item.ErrSkuTaken
+		}
+		if err != nil {
+			return err
+		}
+
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if updated == 0 {
+			return item.ErrVersionMismatch
+		}
+	}
+
+	*a = *item.RestoreAggregate(a.Id, a.Sku, a.Name, a.BaseUnit(), a.Barcodes(), a.PackSizes(), a.UnitVolume, a.UnitWeight, a.LotTracked, a.Serialized, a.IsDeleted(), a.Version()+1)
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, id item.Id) (*item.Aggregate, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+columns+` FROM "stock_item" WHERE "id" = ?`,
+		id.String(),
+	)
+
+	a, err := restore(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &item.Aggregate{}, item.ErrNotFound
+	}
+	if err != nil {
+		return &item.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) Find(ctx context.Context, id item.Id) (bool, error) {
+	var found bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM "stock_item" WHERE "id" = ?)`,
+		id.String(),
+	).Scan(&found)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func (r *Repository) List(ctx context.Context, q item.ListQuery) ([]*item.Aggregate, error) {
+	query := `SELECT ` + columns + ` FROM "stock_item" WHERE "deleted" = FALSE`
+	args := []any{}
+
+	if q.Sku != nil {
+		query += ` AND "sku" = ?`
+		args = append(args, q.Sku.String())
+	}
+
+	if q.Barcode != nil {
+		query += ` AND EXISTS (SELECT 1 FROM json_each("barcodes") WHERE "value" = ?)`
+		args = append(args, q.Barcode.String())
+	}
+
+	if q.After != nil {
+		query += ` AND "sku" > ?`
+		args = append(args, q.After.String())
+	}
+
+	query += ` ORDER BY "sku" LIMIT ?`
+	args = append(args, q.Limit)
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	as := []*item.Aggregate{}
+	for rows.Next() {
+		a, err := restore(rows)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return as, nil
+}
+
+// packSize is how a pack size is stored in the JSON array of the pack_sizes column.
+type packSize struct {
+	Unit     string `json:"unit"`
+	Quantity int64  `json:"quantity"`
+}
+
+// encode returns the barcodes and pack_sizes columns of a.
+func encode(a *item.Aggregate) (string, string, error) {
+	barcodes := []string{}
+	for _, b := range a.Barcodes() {
+		barcodes = append(barcodes, b.String())
+	}
+
+	packSizes := []packSize{}
+	for _, p := range a.PackSizes() {
+		packSizes = append(packSizes, packSize{Unit: p.Unit().String(), Quantity: p.Quantity()})
+	}
+
+	rawBarcodes, err := json.Marshal(barcodes)
+	if err != nil {
+		return "", "", err
+	}
+
+	rawPackSizes, err := json.Marshal(packSizes)
+	if err != nil {
+		return "", "", err
+	}
+
+	return string(rawBarcodes), string(rawPackSizes), nil
+}
+
+// decode restores the barcodes and pack sizes from their columns.
+func decode(rawBarcodes string, rawPackSizes string) ([]item.Barcode, []item.PackSize, error) {
+	var storedBarcodes []string
+	if err := json.Unmarshal([]byte(rawBarcodes), &storedBarcodes); err != nil {
+		return nil, nil, err
+	}
+
+	barcodes := []item.Barcode{}
+	for _, v := range storedBarcodes {
+		b, err := item.NewBarcode(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		barcodes = append(barcodes, b)
+	}
+
+	var storedPackSizes []packSize
+	if err := json.Unmarshal([]byte(rawPackSizes), &storedPackSizes); err != nil {
+		return nil, nil, err
+	}
+
+	packSizes := []item.PackSize{}
+	for _, v := range storedPackSizes {
+		unit, err := item.NewUnit(v.Unit)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		p, err := item.NewPackSize(unit, v.Quantity)
+		if err != nil {
+			return nil, nil, err
+		}
+		packSizes = append(packSizes, p)
+	}
+
+	return barcodes, packSizes, nil
+}
+
+func restore(row interface{ Scan(dest ...any) error }) (*item.Aggregate, error) {
+	var (
+		data          string
+		rawSku        string
+		rawName       string
+		rawBaseUnit   string
+		rawBarcodes   string
+		rawPackSizes  string
+		rawUnitVolume int64
+		rawUnitWeight int64
+		lotTracked    bool
+		serialized    bool
+		deleted       bool
+		version       int64
+	)
+	if err := row.Scan(&data, &rawSku, &rawName, &rawBaseUnit, &rawBarcodes, &rawPackSizes, &rawUnitVolume, &rawUnitWeight, &lotTracked, &serialized, &deleted, &version); err != nil {
+		return nil, err
+	}
+
+	v, err := uuid.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := item.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	sku, err := item.NewSku(rawSku)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := item.NewName(rawName)
+	if err != nil {
+		return nil, err
+	}
+
+	baseUnit, err := item.NewUnit(rawBaseUnit)
+	if err != nil {
+		return nil, err
+	}
+
+	barcodes, packSizes, err := decode(rawBarcodes, rawPackSizes)
+	if err != nil {
+		return nil, err
+	}
+
+	unitVolume, err := measure.NewVolume(rawUnitVolume)
+	if err != nil {
+		return nil, err
+	}
+
+	unitWeight, err := measure.NewWeight(rawUnitWeight)
+	if err != nil {
+		return nil, err
+	}
+
+	return item.RestoreAggregate(id, sku, name, baseUnit, barcodes, packSizes, unitVolume, unitWeight, lotTracked, serialized, deleted, version), nil
+}
+
+// isUniqueViolation reports whether err breaks the unique index on the SKUs of active items,
+// the only unique constraint of the table besides its primary key.
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
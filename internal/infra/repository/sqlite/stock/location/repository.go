@@ -0,0 +1,351 @@
+package location
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/location"
+)
+
+// timeFormat is how strftime('%Y-%m-%d %H:%M:%f', 'now') writes the times, so that they compare as text.
+const timeFormat = "2006-01-02 15:04:05.000"
+
+// columns are what restore scans.
+const columns = `"id", "name", "type", "max_units", "max_volume", "max_weight", "parent_id", "deleted", "allow_negative_stock", "version"`
+
+type Repository struct {
+	location.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx
+// of a SQLite database.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *location.Aggregate) error {
+	if a.Version() == 0 {
+		_, err := r.db.ExecContext(ctx,
+			`INSERT INTO "stock_location" ("id", "name", "type", "max_units", "max_volume", "max_weight", "parent_id", "deleted", "allow_negative_stock", "version", "deleted_at")
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, 1, CASE WHEN ? THEN strftime('%Y-%m-%d %H:%M:%f', 'now') END)`,
+			a.Id.String(), a.Name.String(), a.Type.String(), maxUnits(a), maxVolume(a), maxWeight(a), parentId(a), a.IsDeleted(), a.AllowNegativeStock, a.IsDeleted(),
+		)
+		if isUniqueViolation(err) {
+			return location.ErrNameTaken
+		}
+		if err != nil {
+			return err
+		}
+	} else {
+		// compare-and-swap on the version read by Get,
+		// and a location deleted again keeps the time it was deleted first
+		res, err := r.db.ExecContext(ctx,
+			`UPDATE "stock_location" SET "name" = ?, "type" = ?, "max_units" = ?, "max_volume" = ?, "max_weight" = ?,
+				"parent_id" = ?, "deleted" = ?, "allow_negative_stock" = ?, "version" = ?,
+				"updated_at" = strftime('%Y-%m-%d %H:%M:%f', 'now'),
+				"deleted_at" = CASE WHEN ? THEN COALESCE("deleted_at", strftime('%Y-%m-%d %H:%M:%f', 'now')) END
+			WHERE "id" = ? AND "version" = ?`,
+			a.Name.String(), a.Type.String(), maxUnits(a), maxVolume(a), maxWeight(a),
+			parentId(a), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1, a.IsDeleted(), a.Id.String(), a.Version(),
+		)
+		if isUniqueViolation(err) {
+			return location.ErrNameTaken
+		}
+		if err != nil {
+			return err
+		}
+
+		updated, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if updated == 0 {
+			return location.ErrVersionMismatch
+		}
+	}
+
+	*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, id location.Id) (*location.Aggregate, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+columns+` FROM "stock_location" WHERE "id" = ?`,
+		id.String(),
+	)
+
+	a, err := restore(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &location.Aggregate{}, location.ErrNotFound
+	}
+	if err != nil {
+		return &location.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) Find(ctx context.Context, id location.Id) (bool, error) {
+	var found bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM "stock_location" WHERE "id" = ?)`,
+		id.String(),
+	).Scan(&found)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+func (r *Repository) FindByName(ctx context.Context, name location.Name) (*location.Aggregate, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+columns+` FROM "stock_location" WHERE "name" = ? AND "deleted" = FALSE`,
+		name.String(),
+	)
+
+	a, err := restore(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return nil, location.ErrNotFound
+	}
+	if err != nil {
+		return nil, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+	query := `SELECT ` + columns + ` FROM "stock_location" WHERE TRUE`
+	args := []any{}
+
+	if q.DeletedBefore != nil {
+		query += ` AND "deleted" = TRUE AND "deleted_at" < ?`
+		args = append(args, q.DeletedBefore.UTC().Format(timeFormat))
+	} else if !q.IncludeDeleted {
+		query += ` AND "deleted" = FALSE`
+	}
+
+	if q.ParentId != nil {
+		query += ` AND "parent_id" = ?`
+		args = append(args, q.ParentId.String())
+	}
+
+	if q.Type != nil {
+		query += ` AND "type" = ?`
+		args = append(args, q.Type.String())
+	}
+
+	if q.NamePrefix != "" {
+		// LIKE ignores the case of ASCII letters in SQLite, so the prefix is compared as it is
+		query += ` AND substr("name", 1, length(?)) = ?`
+		args = append(args, q.NamePrefix, q.NamePrefix)
+	}
+
+	direction, comparison := "ASC", ">"
+	if q.Order == location.OrderDesc {
+		direction, comparison = "DESC", "<"
+	}
+
+	if q.After != nil {
+		query += fmt.Sprintf(` AND ("name", "id") %s (?, ?)`, comparison)
+		args = append(args, q.After.Name.String(), q.After.Id.String())
+	}
+
+	query += fmt.Sprintf(` ORDER BY "name" %s, "id" %s LIMIT ?`, direction, direction)
+	args = append(args, q.Limit)
+
+	return r.query(ctx, query, args...)
+}
+
+func (r *Repository) Ancestors(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	return r.query(ctx,
+		`WITH RECURSIVE "ancestor" ("id", "depth") AS (
+			SELECT "parent_id", 1 FROM "stock_location" WHERE "id" = ? AND "parent_id" IS NOT NULL
+			UNION
+			SELECT "l"."parent_id", "a"."depth" + 1 FROM "stock_location" "l" JOIN "ancestor" "a" ON "l"."id" = "a"."id"
+			WHERE "l"."parent_id" IS NOT NULL
+		)
+		SELECT `+columns+` FROM "stock_location" JOIN "ancestor" USING ("id") ORDER BY "depth" DESC`,
+		id.String(),
+	)
+}
+
+func (r *Repository) Descendants(ctx context.Context, id location.Id) ([]*location.Aggregate, error) {
+	return r.query(ctx,
+		`WITH RECURSIVE "descendant" ("id") AS (
+			SELECT "id" FROM "stock_location" WHERE "parent_id" = ?
+			UNION
+			SELECT "l"."id" FROM "stock_location" "l" JOIN "descendant" "d" ON "l"."parent_id" = "d"."id"
+		)
+		SELECT `+columns+` FROM "stock_location" JOIN "descendant" USING ("id")`,
+		id.String(),
+	)
+}
+
+// query returns the locations the query selects with columns.
+func (r *Repository) query(ctx context.Context, query string, args ...any) ([]*location.Aggregate, error) {
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	as := []*location.Aggregate{}
+	for rows.Next() {
+		a, err := restore(rows)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return as, nil
+}
+
+func (r *Repository) Purge(ctx context.Context, id location.Id) error {
+	res, err := r.db.ExecContext(ctx, `DELETE FROM "stock_location" WHERE "id" = ?`, id.String())
+	if err != nil {
+		return err
+	}
+
+	deleted, err := res.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if deleted == 0 {
+		return location.ErrNotFound
+	}
+
+	return nil
+}
+
+func restore(row interface{ Scan(dest ...any) error }) (*location.Aggregate, error) {
+	var (
+		data               string
+		rawName            string
+		rawType            string
+		rawMaxUnits        sql.NullInt64
+		rawMaxVolume       sql.NullInt64
+		rawMaxWeight       sql.NullInt64
+		rawParentId        sql.NullString
+		deleted            bool
+		allowNegativeStock bool
+		version            int64
+	)
+	if err := row.Scan(&data, &rawName, &rawType, &rawMaxUnits, &rawMaxVolume, &rawMaxWeight, &rawParentId, &deleted, &allowNegativeStock, &version); err != nil {
+		return nil, err
+	}
+
+	v, err := uuid.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := location.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	name, err := location.NewName(rawName)
+	if err != nil {
+		return nil, err
+	}
+
+	typ, err := location.NewType(rawType)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity, err := location.NewCapacity(nullInt64(rawMaxUnits), nullInt64(rawMaxVolume), nullInt64(rawMaxWeight))
+	if err != nil {
+		return nil, err
+	}
+
+	var parentId *location.Id
+	if rawParentId.Valid {
+		v, err := uuid.Parse(rawParentId.String)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := location.NewId(v)
+		if err != nil {
+			return nil, err
+		}
+		parentId = &p
+	}
+
+	return location.RestoreAggregate(id, name, typ, capacity, parentId, deleted, allowNegativeStock, version), nil
+}
+
+// parentId returns the parent_id of a, NULL for a root location.
+func parentId(a *location.Aggregate) sql.NullString {
+	p := a.ParentId()
+	if p == nil {
+		return sql.NullString{}
+	}
+	return sql.NullString{String: p.String(), Valid: true}
+}
+
+// maxUnits returns the max_units of a, NULL when the units are unlimited.
+func maxUnits(a *location.Aggregate) sql.NullInt64 {
+	v := a.Capacity.Units()
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: *v, Valid: true}
+}
+
+// maxVolume returns the max_volume of a, NULL when the volume is unlimited.
+func maxVolume(a *location.Aggregate) sql.NullInt64 {
+	v := a.Capacity.Volume()
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: v.Int64(), Valid: true}
+}
+
+// maxWeight returns the max_weight of a, NULL when the weight is unlimited.
+func maxWeight(a *location.Aggregate) sql.NullInt64 {
+	v := a.Capacity.Weight()
+	if v == nil {
+		return sql.NullInt64{}
+	}
+	return sql.NullInt64{Int64: v.Int64(), Valid: true}
+}
+
+func nullInt64(v sql.NullInt64) *int64 {
+	if !v.Valid {
+		return nil
+	}
+	return &v.Int64
+}
+
+// isUniqueViolation reports whether err breaks the unique index on the names of active locations,
+// the only unique constraint of the table besides its primary key.
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
@@ -0,0 +1,68 @@
+package location_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/infra/repository/repositorytest"
+	"openapi/internal/infra/repository/sqlite/sqlitetest"
+	sut "openapi/internal/infra/repository/sqlite/stock/location"
+)
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestLocationRepository(t, func(t *testing.T) location.IRepository {
+		r, err := sut.NewRepository(sqlitetest.Open(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
+
+func TestGetFailInvalidData(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	db := sqlitetest.Open(t)
+	r, err := sut.NewRepository(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	id, err := location.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := db.Exec(`INSERT INTO "stock_location" ("id", "name") VALUES (?, ?)`, id.String(), "Legacy\tBay"); err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, getErr := r.Get(context.Background(), id)
+	_, listErr := r.List(context.Background(), location.ListQuery{Limit: 10})
+
+	// Then
+	if !failure.IsInternal(getErr) || !failure.IsInternal(listErr) {
+		t.Errorf("%v %v want faults of the server", getErr, listErr)
+	}
+}
@@ -0,0 +1,176 @@
+package lot
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/lot"
+)
+
+// columns are what restore scans.
+const columns = `"id", "item_id", "number", "manufactured_on", "expires_on"`
+
+type Repository struct {
+	lot.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx
+// of a SQLite database.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+// Save records the lot. Recorded lots are never updated.
+func (r *Repository) Save(ctx context.Context, a *lot.Aggregate) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO "stock_lot" ("id", "item_id", "number", "manufactured_on", "expires_on") VALUES (?, ?, ?, ?, ?)`,
+		a.Id.String(), a.ItemId.String(), a.Number.String(), nullDate(a.ManufacturedOn), nullDate(a.ExpiresOn),
+	)
+	if isUniqueViolation(err) {
+		return lot.ErrNumberTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, itemId item.Id, number lot.Number) (*lot.Aggregate, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+columns+` FROM "stock_lot" WHERE "item_id" = ? AND "number" = ?`,
+		itemId.String(), number.String(),
+	)
+
+	a, err := restore(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &lot.Aggregate{}, lot.ErrNotFound
+	}
+	if err != nil {
+		return &lot.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q lot.ListQuery) ([]*lot.Aggregate, error) {
+	query := `SELECT ` + columns + ` FROM "stock_lot" WHERE TRUE`
+	args := []any{}
+
+	if q.ItemId != nil {
+		query += ` AND "item_id" = ?`
+		args = append(args, q.ItemId.String())
+	}
+
+	if q.ExpiresBy != nil {
+		query += ` AND "expires_on" <= ?`
+		args = append(args, q.ExpiresBy.String())
+	}
+
+	query += ` ORDER BY "expires_on" IS NULL, "expires_on", "number", "item_id"`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	as := []*lot.Aggregate{}
+	for rows.Next() {
+		a, err := restore(rows)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return as, nil
+}
+
+// nullDate returns the column of a date, NULL when it is unknown.
+func nullDate(d lot.Date) sql.NullString {
+	return sql.NullString{String: d.String(), Valid: !d.IsZero()}
+}
+
+func restoreDate(v sql.NullString) (lot.Date, error) {
+	if !v.Valid {
+		return lot.Date{}, nil
+	}
+	return lot.ParseDate(v.String)
+}
+
+func restore(row interface{ Scan(dest ...any) error }) (*lot.Aggregate, error) {
+	var (
+		rawId             string
+		rawItemId         string
+		rawNumber         string
+		rawManufacturedOn sql.NullString
+		rawExpiresOn      sql.NullString
+	)
+	if err := row.Scan(&rawId, &rawItemId, &rawNumber, &rawManufacturedOn, &rawExpiresOn); err != nil {
+		return nil, err
+	}
+
+	v, err := uuid.Parse(rawId)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := lot.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err = uuid.Parse(rawItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	itemId, err := item.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := lot.NewNumber(rawNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	manufacturedOn, err := restoreDate(rawManufacturedOn)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresOn, err := restoreDate(rawExpiresOn)
+	if err != nil {
+		return nil, err
+	}
+
+	return lot.RestoreAggregate(id, itemId, number, manufacturedOn, expiresOn), nil
+}
+
+// isUniqueViolation reports whether err breaks the unique constraint on the numbers of the lots of an item,
+// the only unique constraint of the table besides its primary key.
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
@@ -0,0 +1,277 @@
+package movement
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+)
+
+type Repository struct {
+	movement.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx
+// of a SQLite database.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+// Save appends the movement to the ledger. Recorded movements are never updated.
+func (r *Repository) Save(ctx context.Context, a *movement.Aggregate) error {
+	serials, err := encodeSerials(a.Serials)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.db.ExecContext(ctx,
+		`INSERT INTO "stock_movement" ("id", "item_id", "location_id", "kind", "quantity", "lot_number", "serials") VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		a.Id.String(), a.ItemId.String(), a.LocationId.String(), a.Kind.String(), a.Quantity.Int64(), nullLot(a.Lot), serials,
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) GetBalance(ctx context.Context, itemId item.Id, locationId location.Id, lotNumber lot.Number) (movement.Balance, error) {
+	var quantity int64
+	err := r.db.QueryRowContext(ctx,
+		`SELECT COALESCE(SUM("quantity"), 0) FROM "stock_movement" WHERE "item_id" = ? AND "location_id" = ? AND "lot_number" IS ?`,
+		itemId.String(), locationId.String(), nullLot(lotNumber),
+	).Scan(&quantity)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	return movement.Balance{
+		ItemId:     itemId,
+		LocationId: locationId,
+		Lot:        lotNumber,
+		Quantity:   quantity,
+	}, nil
+}
+
+func (r *Repository) ListBalances(ctx context.Context, q movement.BalanceQuery) ([]movement.Balance, error) {
+	query := `SELECT "item_id", "location_id", "lot_number", SUM("quantity") FROM "stock_movement" WHERE TRUE`
+	args := []any{}
+
+	if q.ItemId != nil {
+		query += ` AND "item_id" = ?`
+		args = append(args, q.ItemId.String())
+	}
+
+	if q.LocationId != nil {
+		query += ` AND "location_id" = ?`
+		args = append(args, q.LocationId.String())
+	}
+
+	if q.LocationIds != nil {
+		if len(q.LocationIds) == 0 {
+			query += ` AND FALSE`
+		} else {
+			query += ` AND "location_id" IN (?` + strings.Repeat(`, ?`, len(q.LocationIds)-1) + `)`
+		}
+		for _, id := range q.LocationIds {
+			args = append(args, id.String())
+		}
+	}
+
+	if q.After != nil {
+		query += ` AND ("item_id", "location_id", COALESCE("lot_number", '')) > (?, ?, ?)`
+		args = append(args, q.After.ItemId.String(), q.After.LocationId.String(), q.After.Lot.String())
+	}
+
+	query += ` GROUP BY "item_id", "location_id", "lot_number" HAVING SUM("quantity") <> 0
+		ORDER BY "item_id" ASC, "location_id" ASC, "lot_number" ASC`
+
+	if q.Limit > 0 {
+		query += ` LIMIT ?`
+		args = append(args, q.Limit)
+	}
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	bs := []movement.Balance{}
+	for rows.Next() {
+		var itemId, locationId string
+		var rawLot sql.NullString
+		var quantity int64
+		if err := rows.Scan(&itemId, &locationId, &rawLot, &quantity); err != nil {
+			return nil, err
+		}
+
+		b, err := restoreBalance(itemId, locationId, rawLot, quantity)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		bs = append(bs, b)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return bs, nil
+}
+
+// ListBySerial returns the movements in the order of their rowid, which is the order they were inserted in.
+func (r *Repository) ListBySerial(ctx context.Context, itemId item.Id, number serial.Number) ([]*movement.Aggregate, error) {
+	rows, err := r.db.QueryContext(ctx,
+		`SELECT "id", "item_id", "location_id", "kind", "quantity", "lot_number", "serials" FROM "stock_movement"
+		WHERE "item_id" = ? AND EXISTS (SELECT 1 FROM json_each("stock_movement"."serials") WHERE "value" = ?)
+		ORDER BY "rowid" ASC`,
+		itemId.String(), number.String(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	as := []*movement.Aggregate{}
+	for rows.Next() {
+		var rawId, rawItemId, rawLocationId, rawKind, rawSerials string
+		var rawLot sql.NullString
+		var quantity int64
+		if err := rows.Scan(&rawId, &rawItemId, &rawLocationId, &rawKind, &quantity, &rawLot, &rawSerials); err != nil {
+			return nil, err
+		}
+
+		a, err := restore(rawId, rawItemId, rawLocationId, rawKind, quantity, rawLot, rawSerials)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return as, nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	var found bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM "stock_movement" WHERE "location_id" = ?)`,
+		locationId.String(),
+	).Scan(&found)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// encodeSerials returns the serials column of a movement, a JSON array of the serial numbers.
+func encodeSerials(ns []serial.Number) (string, error) {
+	vs := make([]string, 0, len(ns))
+	for _, n := range ns {
+		vs = append(vs, n.String())
+	}
+
+	b, err := json.Marshal(vs)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// nullLot returns the lot_number column of a movement, NULL when it has no lot.
+func nullLot(n lot.Number) sql.NullString {
+	return sql.NullString{String: n.String(), Valid: !n.IsZero()}
+}
+
+func restoreBalance(rawItemId string, rawLocationId string, rawLot sql.NullString, quantity int64) (movement.Balance, error) {
+	itemUuid, err := uuid.Parse(rawItemId)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	itemId, err := item.NewId(itemUuid)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	locationUuid, err := uuid.Parse(rawLocationId)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	locationId, err := location.NewId(locationUuid)
+	if err != nil {
+		return movement.Balance{}, err
+	}
+
+	var lotNumber lot.Number
+	if rawLot.Valid {
+		lotNumber, err = lot.NewNumber(rawLot.String)
+		if err != nil {
+			return movement.Balance{}, err
+		}
+	}
+
+	return movement.Balance{
+		ItemId:     itemId,
+		LocationId: locationId,
+		Lot:        lotNumber,
+		Quantity:   quantity,
+	}, nil
+}
+
+func restore(rawId string, rawItemId string, rawLocationId string, rawKind string, quantity int64, rawLot sql.NullString, rawSerials string) (*movement.Aggregate, error) {
+	v, err := uuid.Parse(rawId)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := movement.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	kind, err := movement.NewKind(rawKind)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := restoreBalance(rawItemId, rawLocationId, rawLot, quantity)
+	if err != nil {
+		return nil, failure.Internal(err)
+	}
+
+	var vs []string
+	if err := json.Unmarshal([]byte(rawSerials), &vs); err != nil {
+		return nil, err
+	}
+
+	serials, err := serial.NewNumbers(vs)
+	if err != nil {
+		return nil, err
+	}
+
+	return movement.RestoreAggregate(id, b.ItemId, b.LocationId, kind, movement.RestoreQuantity(quantity), b.Lot, serials), nil
+}
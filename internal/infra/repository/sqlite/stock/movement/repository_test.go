@@ -0,0 +1,34 @@
+package movement_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/infra/repository/repositorytest"
+	"openapi/internal/infra/repository/sqlite/sqlitetest"
+	sut "openapi/internal/infra/repository/sqlite/stock/movement"
+)
+
+func TestNewRepositoryFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewRepository(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestRepository(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestMovementRepository(t, func(t *testing.T) movement.IRepository {
+		r, err := sut.NewRepository(sqlitetest.Open(t))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return r
+	})
+}
@@ -0,0 +1,188 @@
+package serial
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/serial"
+)
+
+// columns are what restore scans.
+const columns = `"id", "item_id", "number", "location_id"`
+
+type Repository struct {
+	serial.IRepository
+	db boil.ContextExecutor
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx
+// of a SQLite database.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+	return &Repository{
+		db: db,
+	}, nil
+}
+
+// Save inserts the serial when it is new, and otherwise updates where it is.
+func (r *Repository) Save(ctx context.Context, a *serial.Aggregate) error {
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO "stock_serial" ("id", "item_id", "number", "location_id") VALUES (?, ?, ?, ?)
+		ON CONFLICT ("id") DO UPDATE SET "location_id" = "excluded"."location_id"`,
+		a.Id.String(), a.ItemId.String(), a.Number.String(), nullLocation(a),
+	)
+	if isUniqueViolation(err) {
+		return serial.ErrNumberTaken
+	}
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) Get(ctx context.Context, itemId item.Id, number serial.Number) (*serial.Aggregate, error) {
+	row := r.db.QueryRowContext(ctx,
+		`SELECT `+columns+` FROM "stock_serial" WHERE "item_id" = ? AND "number" = ?`,
+		itemId.String(), number.String(),
+	)
+
+	a, err := restore(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return &serial.Aggregate{}, serial.ErrNotFound
+	}
+	if err != nil {
+		return &serial.Aggregate{}, failure.Internal(err)
+	}
+
+	return a, nil
+}
+
+func (r *Repository) List(ctx context.Context, q serial.ListQuery) ([]*serial.Aggregate, error) {
+	query := `SELECT ` + columns + ` FROM "stock_serial" WHERE TRUE`
+	args := []any{}
+
+	if q.Number != nil {
+		query += ` AND "number" = ?`
+		args = append(args, q.Number.String())
+	}
+
+	if q.ItemId != nil {
+		query += ` AND "item_id" = ?`
+		args = append(args, q.ItemId.String())
+	}
+
+	query += ` ORDER BY "item_id", "number"`
+
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	as := []*serial.Aggregate{}
+	for rows.Next() {
+		a, err := restore(rows)
+		if err != nil {
+			return nil, failure.Internal(err)
+		}
+		as = append(as, a)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return as, nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	var found bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM "stock_serial" WHERE "location_id" = ?)`,
+		locationId.String(),
+	).Scan(&found)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// nullLocation returns the location_id column of a serial, NULL while it is not in stock.
+func nullLocation(a *serial.Aggregate) sql.NullString {
+	l, ok := a.LocationId()
+	return sql.NullString{String: l.String(), Valid: ok}
+}
+
+func restore(row interface{ Scan(dest ...any) error }) (*serial.Aggregate, error) {
+	var (
+		rawId         string
+		rawItemId     string
+		rawNumber     string
+		rawLocationId sql.NullString
+	)
+	if err := row.Scan(&rawId, &rawItemId, &rawNumber, &rawLocationId); err != nil {
+		return nil, err
+	}
+
+	v, err := uuid.Parse(rawId)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := serial.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	v, err = uuid.Parse(rawItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	itemId, err := item.NewId(v)
+	if err != nil {
+		return nil, err
+	}
+
+	number, err := serial.NewNumber(rawNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	var locationId *location.Id
+	if rawLocationId.Valid {
+		v, err = uuid.Parse(rawLocationId.String)
+		if err != nil {
+			return nil, err
+		}
+
+		l, err := location.NewId(v)
+		if err != nil {
+			return nil, err
+		}
+		locationId = &l
+	}
+
+	return serial.RestoreAggregate(id, itemId, number, locationId), nil
+}
+
+// isUniqueViolation reports whether err breaks the unique constraint on the numbers of the serials of an item,
+// the only unique constraint of the table besides its primary key, which Save upserts on.
+func isUniqueViolation(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE
+}
@@ -0,0 +1,165 @@
+package transaction
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/lot"
+	"openapi/internal/domain/stock/movement"
+	"openapi/internal/domain/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/domain/stock/transfer"
+	itemInfra "openapi/internal/infra/repository/sqlite/stock/item"
+	locationInfra "openapi/internal/infra/repository/sqlite/stock/location"
+	lotInfra "openapi/internal/infra/repository/sqlite/stock/lot"
+	movementInfra "openapi/internal/infra/repository/sqlite/stock/movement"
+	serialInfra "openapi/internal/infra/repository/sqlite/stock/serial"
+	transferInfra "openapi/internal/infra/repository/sqlite/stock/transfer"
+)
+
+// maxAttempts bounds how often a transaction is retried after finding the database locked.
+const maxAttempts = 3
+
+type UnitOfWork struct {
+	transaction.IUnitOfWork
+	db *sql.DB
+}
+
+func NewUnitOfWork(db *sql.DB) (*UnitOfWork, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewUnitOfWork: db is nil")
+	}
+	return &UnitOfWork{
+		db: db,
+	}, nil
+}
+
+// Do runs fn in a transaction. SQLite transactions are serializable, so what fn reads cannot change before it commits.
+// Transactions that find the database locked by a concurrent one after its busy timeout are retried,
+// so fn must not have side effects outside the repositories.
+// The transaction is rolled back by database/sql when ctx is done before it commits.
+func (u *UnitOfWork) Do(ctx context.Context, fn func(r transaction.IRepositories) error) error {
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		err = u.do(ctx, fn)
+		if !isBusy(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (u *UnitOfWork) do(ctx context.Context, fn func(r transaction.IRepositories) error) (err error) {
+	tx, err := u.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	r, err := NewRepositories(tx)
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := fn(r); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func isBusy(err error) bool {
+	var sqliteErr *sqlite.Error
+	return errors.As(err, &sqliteErr) && sqliteErr.Code()&0xff == sqlite3.SQLITE_BUSY
+}
+
+type Repositories struct {
+	item     *itemInfra.Repository
+	location *locationInfra.Repository
+	lot      *lotInfra.Repository
+	movement *movementInfra.Repository
+	serial   *serialInfra.Repository
+	transfer *transferInfra.Repository
+}
+
+// NewRepositories returns the repositories that run their queries on db, which is either a *sql.DB or a *sql.Tx.
+func NewRepositories(db boil.ContextExecutor) (*Repositories, error) {
+	i, err := itemInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := locationInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	lt, err := lotInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	m, err := movementInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := serialInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := transferInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repositories{
+		item:     i,
+		location: l,
+		lot:      lt,
+		movement: m,
+		serial:   s,
+		transfer: t,
+	}, nil
+}
+
+func (r *Repositories) Item() item.IRepository {
+	return r.item
+}
+
+func (r *Repositories) Location() location.IRepository {
+	return r.location
+}
+
+func (r *Repositories) Lot() lot.IRepository {
+	return r.lot
+}
+
+func (r *Repositories) Movement() movement.IRepository {
+	return r.movement
+}
+
+func (r *Repositories) Serial() serial.IRepository {
+	return r.serial
+}
+
+func (r *Repositories) Transfer() transfer.IRepository {
+	return r.transfer
+}
@@ -0,0 +1,42 @@
+package transaction_test
+
+import (
+	"testing"
+
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/repository/repositorytest"
+	"openapi/internal/infra/repository/sqlite/sqlitetest"
+	sut "openapi/internal/infra/repository/sqlite/stock/transaction"
+)
+
+func TestNewUnitOfWorkFail(t *testing.T) {
+	t.Parallel()
+
+	// When
+	_, err := sut.NewUnitOfWork(nil)
+
+	// Then
+	if err == nil {
+		t.Fatal("error must not be nil")
+	}
+}
+
+func TestUnitOfWork(t *testing.T) {
+	t.Parallel()
+
+	repositorytest.TestUnitOfWork(t, func(t *testing.T) (transaction.IUnitOfWork, transaction.IRepositories) {
+		db := sqlitetest.Open(t)
+
+		u, err := sut.NewUnitOfWork(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		r, err := sut.NewRepositories(db)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return u, r
+	})
+}
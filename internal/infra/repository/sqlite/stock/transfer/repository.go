@@ -0,0 +1,70 @@
+package transfer
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/volatiletech/sqlboiler/v4/boil"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transfer"
+	movementInfra "openapi/internal/infra/repository/sqlite/stock/movement"
+)
+
+type Repository struct {
+	transfer.IRepository
+	db        boil.ContextExecutor
+	movements *movementInfra.Repository
+}
+
+// NewRepository returns a repository that runs its queries on db, which is either a *sql.DB or a *sql.Tx
+// of a SQLite database. Save writes several rows, so db should be a *sql.Tx to keep them atomic.
+func NewRepository(db boil.ContextExecutor) (*Repository, error) {
+	if db == nil {
+		return nil, fmt.Errorf("NewRepository: db is nil")
+	}
+
+	movements, err := movementInfra.NewRepository(db)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Repository{
+		db:        db,
+		movements: movements,
+	}, nil
+}
+
+func (r *Repository) Save(ctx context.Context, a *transfer.Aggregate) error {
+	if err := r.movements.Save(ctx, a.Out); err != nil {
+		return err
+	}
+
+	if err := r.movements.Save(ctx, a.In); err != nil {
+		return err
+	}
+
+	_, err := r.db.ExecContext(ctx,
+		`INSERT INTO "stock_transfer" ("id", "item_id", "from_location_id", "to_location_id", "quantity", "out_movement_id", "in_movement_id")
+		VALUES (?, ?, ?, ?, ?, ?, ?)`,
+		a.Id.String(), a.ItemId.String(), a.From.String(), a.To.String(), a.Quantity(), a.Out.Id.String(), a.In.Id.String(),
+	)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *Repository) FindByLocation(ctx context.Context, locationId location.Id) (bool, error) {
+	var found bool
+	err := r.db.QueryRowContext(ctx,
+		`SELECT EXISTS (SELECT 1 FROM "stock_transfer" WHERE "from_location_id" = ? OR "to_location_id" = ?)`,
+		locationId.String(), locationId.String(),
+	).Scan(&found)
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
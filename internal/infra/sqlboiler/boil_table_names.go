@@ -6,7 +6,15 @@ package sqlboiler
 var TableNames = struct {
 	StockItem     string
 	StockLocation string
+	StockLot      string
+	StockMovement string
+	StockSerial   string
+	StockTransfer string
 }{
 	StockItem:     "stock_item",
 	StockLocation: "stock_location",
+	StockLot:      "stock_lot",
+	StockMovement: "stock_movement",
+	StockSerial:   "stock_serial",
+	StockTransfer: "stock_transfer",
 }
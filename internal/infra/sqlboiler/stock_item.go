@@ -18,33 +18,61 @@ import (
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
 	"github.com/volatiletech/sqlboiler/v4/queries/qmhelper"
+	"github.com/volatiletech/sqlboiler/v4/types"
 	"github.com/volatiletech/strmangle"
 )
 
 // StockItem is an object representing the database table.
 type StockItem struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Deleted   bool      `boil:"deleted" json:"deleted" toml:"deleted" yaml:"deleted"`
+	ID         string     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name       string     `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt  time.Time  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt  time.Time  `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Deleted    bool       `boil:"deleted" json:"deleted" toml:"deleted" yaml:"deleted"`
+	Version    int64      `boil:"version" json:"version" toml:"version" yaml:"version"`
+	UnitVolume int64      `boil:"unit_volume" json:"unit_volume" toml:"unit_volume" yaml:"unit_volume"`
+	UnitWeight int64      `boil:"unit_weight" json:"unit_weight" toml:"unit_weight" yaml:"unit_weight"`
+	Sku        string     `boil:"sku" json:"sku" toml:"sku" yaml:"sku"`
+	BaseUnit   string     `boil:"base_unit" json:"base_unit" toml:"base_unit" yaml:"base_unit"`
+	Barcodes   types.JSON `boil:"barcodes" json:"barcodes" toml:"barcodes" yaml:"barcodes"`
+	PackSizes  string     `boil:"pack_sizes" json:"pack_sizes" toml:"pack_sizes" yaml:"pack_sizes"`
+	LotTracked bool       `boil:"lot_tracked" json:"lot_tracked" toml:"lot_tracked" yaml:"lot_tracked"`
+	Serialized bool       `boil:"serialized" json:"serialized" toml:"serialized" yaml:"serialized"`
 
 	R *stockItemR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L stockItemL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var StockItemColumns = struct {
-	ID        string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
-	Deleted   string
+	ID         string
+	Name       string
+	CreatedAt  string
+	UpdatedAt  string
+	Deleted    string
+	Version    string
+	UnitVolume string
+	UnitWeight string
+	Sku        string
+	BaseUnit   string
+	Barcodes   string
+	PackSizes  string
+	LotTracked string
+	Serialized string
 }{
-	ID:        "id",
-	Name:      "name",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	Deleted:   "deleted",
+	ID:         "id",
+	Name:       "name",
+	CreatedAt:  "created_at",
+	UpdatedAt:  "updated_at",
+	Deleted:    "deleted",
+	Version:    "version",
+	UnitVolume: "unit_volume",
+	UnitWeight: "unit_weight",
+	Sku:        "sku",
+	BaseUnit:   "base_unit",
+	Barcodes:   "barcodes",
+	PackSizes:  "pack_sizes",
+	LotTracked: "lot_tracked",
+	Serialized: "serialized",
 }
 
 // Generated where
@@ -102,18 +130,80 @@ func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field
 func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
 func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
 
+type whereHelperint64 struct{ field string }
+
+func (w whereHelperint64) EQ(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperint64) NEQ(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperint64) LT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperint64) LTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperint64) GT(x int64) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperint64) GTE(x int64) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+func (w whereHelperint64) IN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s IN ?", w.field), values...)
+}
+func (w whereHelperint64) NIN(slice []int64) qm.QueryMod {
+	values := make([]interface{}, 0, len(slice))
+	for _, value := range slice {
+		values = append(values, value)
+	}
+	return qm.WhereIn(fmt.Sprintf("%s NOT IN ?", w.field), values...)
+}
+
+type whereHelpertypes_JSON struct{ field string }
+
+func (w whereHelpertypes_JSON) EQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.EQ, x)
+}
+func (w whereHelpertypes_JSON) NEQ(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.NEQ, x)
+}
+func (w whereHelpertypes_JSON) LT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpertypes_JSON) LTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpertypes_JSON) GT(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpertypes_JSON) GTE(x types.JSON) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
 var StockItemWhere = struct {
-	ID        whereHelperstring
-	Name      whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	Deleted   whereHelperbool
+	ID         whereHelperstring
+	Name       whereHelperstring
+	CreatedAt  whereHelpertime_Time
+	UpdatedAt  whereHelpertime_Time
+	Deleted    whereHelperbool
+	Version    whereHelperint64
+	UnitVolume whereHelperint64
+	UnitWeight whereHelperint64
+	Sku        whereHelperstring
+	BaseUnit   whereHelperstring
+	Barcodes   whereHelpertypes_JSON
+	PackSizes  whereHelperstring
+	LotTracked whereHelperbool
+	Serialized whereHelperbool
 }{
-	ID:        whereHelperstring{field: "\"stock_item\".\"id\""},
-	Name:      whereHelperstring{field: "\"stock_item\".\"name\""},
-	CreatedAt: whereHelpertime_Time{field: "\"stock_item\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"stock_item\".\"updated_at\""},
-	Deleted:   whereHelperbool{field: "\"stock_item\".\"deleted\""},
+	ID:         whereHelperstring{field: "\"stock_item\".\"id\""},
+	Name:       whereHelperstring{field: "\"stock_item\".\"name\""},
+	CreatedAt:  whereHelpertime_Time{field: "\"stock_item\".\"created_at\""},
+	UpdatedAt:  whereHelpertime_Time{field: "\"stock_item\".\"updated_at\""},
+	Deleted:    whereHelperbool{field: "\"stock_item\".\"deleted\""},
+	Version:    whereHelperint64{field: "\"stock_item\".\"version\""},
+	UnitVolume: whereHelperint64{field: "\"stock_item\".\"unit_volume\""},
+	UnitWeight: whereHelperint64{field: "\"stock_item\".\"unit_weight\""},
+	Sku:        whereHelperstring{field: "\"stock_item\".\"sku\""},
+	BaseUnit:   whereHelperstring{field: "\"stock_item\".\"base_unit\""},
+	Barcodes:   whereHelpertypes_JSON{field: "\"stock_item\".\"barcodes\""},
+	PackSizes:  whereHelperstring{field: "\"stock_item\".\"pack_sizes\""},
+	LotTracked: whereHelperbool{field: "\"stock_item\".\"lot_tracked\""},
+	Serialized: whereHelperbool{field: "\"stock_item\".\"serialized\""},
 }
 
 // StockItemRels is where relationship names are stored.
@@ -133,9 +223,9 @@ func (*stockItemR) NewStruct() *stockItemR {
 type stockItemL struct{}
 
 var (
-	stockItemAllColumns            = []string{"id", "name", "created_at", "updated_at", "deleted"}
-	stockItemColumnsWithoutDefault = []string{"id", "name", "updated_at"}
-	stockItemColumnsWithDefault    = []string{"created_at", "deleted"}
+	stockItemAllColumns            = []string{"id", "name", "created_at", "updated_at", "deleted", "version", "unit_volume", "unit_weight", "sku", "base_unit", "barcodes", "pack_sizes", "lot_tracked", "serialized"}
+	stockItemColumnsWithoutDefault = []string{"id", "name", "updated_at", "sku"}
+	stockItemColumnsWithDefault    = []string{"created_at", "deleted", "version", "unit_volume", "unit_weight", "base_unit", "barcodes", "pack_sizes", "lot_tracked", "serialized"}
 	stockItemPrimaryKeyColumns     = []string{"id"}
 )
 
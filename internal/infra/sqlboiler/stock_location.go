@@ -14,6 +14,7 @@ import (
 	"time"
 
 	"github.com/friendsofgo/errors"
+	"github.com/volatiletech/null/v8"
 	"github.com/volatiletech/sqlboiler/v4/boil"
 	"github.com/volatiletech/sqlboiler/v4/queries"
 	"github.com/volatiletech/sqlboiler/v4/queries/qm"
@@ -23,44 +24,156 @@ import (
 
 // StockLocation is an object representing the database table.
 type StockLocation struct {
-	ID        string    `boil:"id" json:"id" toml:"id" yaml:"id"`
-	Name      string    `boil:"name" json:"name" toml:"name" yaml:"name"`
-	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
-	UpdatedAt time.Time `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
-	Deleted   bool      `boil:"deleted" json:"deleted" toml:"deleted" yaml:"deleted"`
+	ID                 string      `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Name               string      `boil:"name" json:"name" toml:"name" yaml:"name"`
+	CreatedAt          time.Time   `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+	UpdatedAt          time.Time   `boil:"updated_at" json:"updated_at" toml:"updated_at" yaml:"updated_at"`
+	Deleted            bool        `boil:"deleted" json:"deleted" toml:"deleted" yaml:"deleted"`
+	AllowNegativeStock bool        `boil:"allow_negative_stock" json:"allow_negative_stock" toml:"allow_negative_stock" yaml:"allow_negative_stock"`
+	Version            int64       `boil:"version" json:"version" toml:"version" yaml:"version"`
+	DeletedAt          null.Time   `boil:"deleted_at" json:"deleted_at,omitempty" toml:"deleted_at" yaml:"deleted_at,omitempty"`
+	ParentID           null.String `boil:"parent_id" json:"parent_id,omitempty" toml:"parent_id" yaml:"parent_id,omitempty"`
+	Type               string      `boil:"type" json:"type" toml:"type" yaml:"type"`
+	MaxUnits           null.Int64  `boil:"max_units" json:"max_units,omitempty" toml:"max_units" yaml:"max_units,omitempty"`
+	MaxVolume          null.Int64  `boil:"max_volume" json:"max_volume,omitempty" toml:"max_volume" yaml:"max_volume,omitempty"`
+	MaxWeight          null.Int64  `boil:"max_weight" json:"max_weight,omitempty" toml:"max_weight" yaml:"max_weight,omitempty"`
 
 	R *stockLocationR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L stockLocationL  `boil:"-" json:"-" toml:"-" yaml:"-"`
 }
 
 var StockLocationColumns = struct {
-	ID        string
-	Name      string
-	CreatedAt string
-	UpdatedAt string
-	Deleted   string
+	ID                 string
+	Name               string
+	CreatedAt          string
+	UpdatedAt          string
+	Deleted            string
+	AllowNegativeStock string
+	Version            string
+	DeletedAt          string
+	ParentID           string
+	Type               string
+	MaxUnits           string
+	MaxVolume          string
+	MaxWeight          string
 }{
-	ID:        "id",
-	Name:      "name",
-	CreatedAt: "created_at",
-	UpdatedAt: "updated_at",
-	Deleted:   "deleted",
+	ID:                 "id",
+	Name:               "name",
+	CreatedAt:          "created_at",
+	UpdatedAt:          "updated_at",
+	Deleted:            "deleted",
+	AllowNegativeStock: "allow_negative_stock",
+	Version:            "version",
+	DeletedAt:          "deleted_at",
+	ParentID:           "parent_id",
+	Type:               "type",
+	MaxUnits:           "max_units",
+	MaxVolume:          "max_volume",
+	MaxWeight:          "max_weight",
 }
 
 // Generated where
 
+type whereHelpernull_Time struct{ field string }
+
+func (w whereHelpernull_Time) EQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Time) NEQ(x null.Time) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Time) LT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Time) LTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Time) GT(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Time) GTE(x null.Time) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Time) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Time) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_String struct{ field string }
+
+func (w whereHelpernull_String) EQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_String) NEQ(x null.String) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_String) LT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_String) LTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_String) GT(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_String) GTE(x null.String) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_String) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_String) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
+type whereHelpernull_Int64 struct{ field string }
+
+func (w whereHelpernull_Int64) EQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, false, x)
+}
+func (w whereHelpernull_Int64) NEQ(x null.Int64) qm.QueryMod {
+	return qmhelper.WhereNullEQ(w.field, true, x)
+}
+func (w whereHelpernull_Int64) LT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LT, x)
+}
+func (w whereHelpernull_Int64) LTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.LTE, x)
+}
+func (w whereHelpernull_Int64) GT(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GT, x)
+}
+func (w whereHelpernull_Int64) GTE(x null.Int64) qm.QueryMod {
+	return qmhelper.Where(w.field, qmhelper.GTE, x)
+}
+
+func (w whereHelpernull_Int64) IsNull() qm.QueryMod    { return qmhelper.WhereIsNull(w.field) }
+func (w whereHelpernull_Int64) IsNotNull() qm.QueryMod { return qmhelper.WhereIsNotNull(w.field) }
+
 var StockLocationWhere = struct {
-	ID        whereHelperstring
-	Name      whereHelperstring
-	CreatedAt whereHelpertime_Time
-	UpdatedAt whereHelpertime_Time
-	Deleted   whereHelperbool
+	ID                 whereHelperstring
+	Name               whereHelperstring
+	CreatedAt          whereHelpertime_Time
+	UpdatedAt          whereHelpertime_Time
+	Deleted            whereHelperbool
+	AllowNegativeStock whereHelperbool
+	Version            whereHelperint64
+	DeletedAt          whereHelpernull_Time
+	ParentID           whereHelpernull_String
+	Type               whereHelperstring
+	MaxUnits           whereHelpernull_Int64
+	MaxVolume          whereHelpernull_Int64
+	MaxWeight          whereHelpernull_Int64
 }{
-	ID:        whereHelperstring{field: "\"stock_location\".\"id\""},
-	Name:      whereHelperstring{field: "\"stock_location\".\"name\""},
-	CreatedAt: whereHelpertime_Time{field: "\"stock_location\".\"created_at\""},
-	UpdatedAt: whereHelpertime_Time{field: "\"stock_location\".\"updated_at\""},
-	Deleted:   whereHelperbool{field: "\"stock_location\".\"deleted\""},
+	ID:                 whereHelperstring{field: "\"stock_location\".\"id\""},
+	Name:               whereHelperstring{field: "\"stock_location\".\"name\""},
+	CreatedAt:          whereHelpertime_Time{field: "\"stock_location\".\"created_at\""},
+	UpdatedAt:          whereHelpertime_Time{field: "\"stock_location\".\"updated_at\""},
+	Deleted:            whereHelperbool{field: "\"stock_location\".\"deleted\""},
+	AllowNegativeStock: whereHelperbool{field: "\"stock_location\".\"allow_negative_stock\""},
+	Version:            whereHelperint64{field: "\"stock_location\".\"version\""},
+	DeletedAt:          whereHelpernull_Time{field: "\"stock_location\".\"deleted_at\""},
+	ParentID:           whereHelpernull_String{field: "\"stock_location\".\"parent_id\""},
+	Type:               whereHelperstring{field: "\"stock_location\".\"type\""},
+	MaxUnits:           whereHelpernull_Int64{field: "\"stock_location\".\"max_units\""},
+	MaxVolume:          whereHelpernull_Int64{field: "\"stock_location\".\"max_volume\""},
+	MaxWeight:          whereHelpernull_Int64{field: "\"stock_location\".\"max_weight\""},
 }
 
 // StockLocationRels is where relationship names are stored.
@@ -80,9 +193,9 @@ func (*stockLocationR) NewStruct() *stockLocationR {
 type stockLocationL struct{}
 
 var (
-	stockLocationAllColumns            = []string{"id", "name", "created_at", "updated_at", "deleted"}
-	stockLocationColumnsWithoutDefault = []string{"id", "name", "updated_at"}
-	stockLocationColumnsWithDefault    = []string{"created_at", "deleted"}
+	stockLocationAllColumns            = []string{"id", "name", "created_at", "updated_at", "deleted", "allow_negative_stock", "version", "deleted_at", "parent_id", "type", "max_units", "max_volume", "max_weight"}
+	stockLocationColumnsWithoutDefault = []string{"id", "name", "updated_at", "deleted_at", "parent_id", "max_units", "max_volume", "max_weight"}
+	stockLocationColumnsWithDefault    = []string{"created_at", "deleted", "allow_negative_stock", "version", "type"}
 	stockLocationPrimaryKeyColumns     = []string{"id"}
 )
 
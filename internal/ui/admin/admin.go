@@ -0,0 +1,40 @@
+// Package admin guards the operations only administrators may call with a bearer token
+// shared with the server.
+package admin
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+)
+
+const tokenKey = "admin.token"
+
+// Middleware makes token the admin token of the requests. An empty token disables
+// the admin operations, since no request can present it.
+func Middleware(token string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			ctx.Set(tokenKey, token)
+			return next(ctx)
+		}
+	}
+}
+
+// Require fails with 401 when the request carries no bearer token
+// and with 403 when the token is not the admin token.
+func Require(ctx echo.Context) error {
+	scheme, credentials, ok := strings.Cut(ctx.Request().Header.Get(echo.HeaderAuthorization), " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") || credentials == "" {
+		ctx.Response().Header().Set(echo.HeaderWWWAuthenticate, "Bearer")
+		return echo.NewHTTPError(http.StatusUnauthorized, "admin token required")
+	}
+
+	token, _ := ctx.Get(tokenKey).(string)
+	if token == "" || subtle.ConstantTimeCompare([]byte(credentials), []byte(token)) != 1 {
+		return echo.NewHTTPError(http.StatusForbidden, "invalid admin token")
+	}
+	return nil
+}
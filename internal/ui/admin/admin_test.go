@@ -0,0 +1,70 @@
+package admin_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/ui/admin"
+)
+
+func TestRequire(t *testing.T) {
+	for _, tt := range []struct {
+		token         string
+		authorization string
+		status        int
+	}{
+		{"secret", "Bearer secret", 0},
+		{"secret", "bearer secret", 0},
+		{"secret", "", http.StatusUnauthorized},
+		{"secret", "Basic c2VjcmV0", http.StatusUnauthorized},
+		{"secret", "Bearer other", http.StatusForbidden},
+		{"", "Bearer secret", http.StatusForbidden},
+	} {
+		// Setup
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		if tt.authorization != "" {
+			req.Header.Set(echo.HeaderAuthorization, tt.authorization)
+		}
+		ctx := e.NewContext(req, httptest.NewRecorder())
+
+		// Given
+		handler := admin.Middleware(tt.token)(admin.Require)
+
+		// When
+		err := handler(ctx)
+
+		// Then
+		status := 0
+		var he *echo.HTTPError
+		if errors.As(err, &he) {
+			status = he.Code
+		} else if err != nil {
+			t.Fatalf("%T = %v", err, err)
+		}
+		if status != tt.status {
+			t.Errorf("%q %q: status = %d, want %d", tt.token, tt.authorization, status, tt.status)
+		}
+	}
+}
+
+func TestRequireWithoutMiddleware(t *testing.T) {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodPost, "/", nil)
+	req.Header.Set(echo.HeaderAuthorization, "Bearer secret")
+	ctx := e.NewContext(req, httptest.NewRecorder())
+
+	// When
+	err := admin.Require(ctx)
+
+	// Then
+	var he *echo.HTTPError
+	if !errors.As(err, &he) || he.Code != http.StatusForbidden {
+		t.Errorf("%T = %v, want %d", err, err, http.StatusForbidden)
+	}
+}
@@ -0,0 +1,154 @@
+// Renders the OpenAPI document chosen in #document without any third-party code.
+(function () {
+  "use strict";
+
+  var methods = ["get", "post", "put", "patch", "delete"];
+
+  function el(tag, attrs, children) {
+    var e = document.createElement(tag);
+    Object.keys(attrs || {}).forEach(function (k) {
+      e.setAttribute(k, attrs[k]);
+    });
+    (children || []).forEach(function (c) {
+      e.appendChild(typeof c === "string" ? document.createTextNode(c) : c);
+    });
+    return e;
+  }
+
+  // resolve follows a local $ref such as #/components/schemas/Problem.
+  function resolve(doc, obj) {
+    if (!obj || !obj.$ref) {
+      return obj;
+    }
+    return obj.$ref.replace(/^#\//, "").split("/").reduce(function (o, k) {
+      return o && o[k];
+    }, doc);
+  }
+
+  function refName(obj) {
+    return obj && obj.$ref ? obj.$ref.split("/").pop() : "";
+  }
+
+  function schemaText(doc, schema) {
+    var name = refName(schema);
+    var s = resolve(doc, schema) || {};
+    var text = JSON.stringify(s, null, 2);
+    return name ? name + " " + text : text;
+  }
+
+  function parameters(doc, op) {
+    var ps = (op.parameters || []).map(function (p) {
+      return resolve(doc, p);
+    });
+    if (ps.length === 0) {
+      return null;
+    }
+    return el("table", {}, [
+      el("tr", {}, [el("th", {}, ["Name"]), el("th", {}, ["In"]), el("th", {}, ["Required"]), el("th", {}, ["Schema"])])
+    ].concat(ps.map(function (p) {
+      return el("tr", {}, [
+        el("td", {}, [p.name]),
+        el("td", {}, [p.in]),
+        el("td", {}, [p.required ? "yes" : "no"]),
+        el("td", {}, [el("code", {}, [JSON.stringify(resolve(doc, p.schema) || {})])])
+      ]);
+    })));
+  }
+
+  function content(doc, c) {
+    return Object.keys(c || {}).map(function (type) {
+      return el("div", {}, [el("p", {}, [type]), el("pre", {}, [schemaText(doc, c[type].schema)])]);
+    });
+  }
+
+  function operation(doc, path, method, op) {
+    var body = [el("p", {}, [op.description || ""])];
+
+    var ps = parameters(doc, op);
+    if (ps) {
+      body.push(el("h4", {}, ["Parameters"]), ps);
+    }
+
+    var rb = resolve(doc, op.requestBody);
+    if (rb) {
+      body.push(el("h4", {}, ["Request body"]));
+      body = body.concat(content(doc, rb.content));
+    }
+
+    body.push(el("h4", {}, ["Responses"]));
+    Object.keys(op.responses || {}).sort().forEach(function (status) {
+      var r = resolve(doc, op.responses[status]) || {};
+      body.push(el("p", {}, [el("strong", {}, [status]), " " + (r.description || "")]));
+      body = body.concat(content(doc, r.content));
+    });
+
+    return el("details", {}, [
+      el("summary", {}, [
+        el("span", { "class": "method " + method }, [method]),
+        el("span", { "class": "path" }, [path]),
+        " " + (op.summary || "")
+      ]),
+      el("div", {}, body)
+    ]);
+  }
+
+  function render(doc) {
+    document.getElementById("title").textContent = doc.info.title + " " + doc.info.version;
+    document.getElementById("description").textContent = doc.info.description || "";
+    document.getElementById("servers").textContent = "Server: " + (doc.servers || []).map(function (s) {
+      return s.url;
+    }).join(", ");
+
+    var ops = document.getElementById("operations");
+    ops.replaceChildren(el("h2", {}, ["Operations"]));
+    Object.keys(doc.paths || {}).sort().forEach(function (path) {
+      methods.forEach(function (method) {
+        var op = doc.paths[path][method];
+        if (op) {
+          ops.appendChild(operation(doc, path, method, op));
+        }
+      });
+    });
+
+    var schemas = (doc.components || {}).schemas || {};
+    var section = document.getElementById("schemas");
+    section.replaceChildren(el("h2", {}, ["Schemas"]));
+    Object.keys(schemas).sort().forEach(function (name) {
+      section.appendChild(el("details", {}, [
+        el("summary", {}, [name]),
+        el("div", {}, [el("pre", {}, [JSON.stringify(schemas[name], null, 2)])])
+      ]));
+    });
+  }
+
+  function load(url) {
+    document.getElementById("download-json").href = url;
+    document.getElementById("download-yaml").href = url.replace(/\.json$/, ".yaml");
+    fetch(url)
+      .then(function (res) {
+        if (!res.ok) {
+          throw new Error(url + " responded " + res.status);
+        }
+        return res.json();
+      })
+      .then(render)
+      .catch(function (err) {
+        document.getElementById("description").textContent = err.message;
+      });
+  }
+
+  var select = document.getElementById("document");
+  select.addEventListener("change", function () {
+    load(select.value);
+  });
+  fetch("/docs/documents.json")
+    .then(function (res) {
+      return res.json();
+    })
+    .then(function (documents) {
+      documents.forEach(function (d) {
+        select.appendChild(el("option", { value: d.url }, [d.name]));
+      });
+      load(select.value);
+    });
+})();
@@ -0,0 +1,180 @@
+package docs
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"sort"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed assets
+var assets embed.FS
+
+const mimeApplicationYAML = "application/yaml"
+
+// document is an entry of the list the docs page offers to browse.
+type document struct {
+	Name string `json:"name"`
+	Url  string `json:"url"`
+}
+
+// RegisterHandlers serves each document of apis at /openapi/{name}.json and /openapi/{name}.yaml,
+// all of them merged into one at /openapi.json and /openapi.yaml, and a page browsing them at /docs/,
+// which finds them listed at /docs/documents.json.
+// The servers of every document are replaced by serviceUrl, where the clients reach this service.
+func RegisterHandlers(e *echo.Echo, serviceUrl string, apis map[string]*openapi3.T) error {
+	names := make([]string, 0, len(apis))
+	for name := range apis {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	documents := []document{{Name: "All APIs", Url: "/openapi.json"}}
+	docs := make([]*openapi3.T, 0, len(names))
+	for _, name := range names {
+		documents = append(documents, document{Name: name, Url: "/openapi/" + name + ".json"})
+		doc := withServer(apis[name], serviceUrl)
+		if err := registerDocument(e, "/openapi/"+name, doc); err != nil {
+			return err
+		}
+		docs = append(docs, doc)
+	}
+
+	merged, err := merge(docs, serviceUrl)
+	if err != nil {
+		return err
+	}
+	if err := registerDocument(e, "/openapi", merged); err != nil {
+		return err
+	}
+
+	page, err := fs.Sub(assets, "assets")
+	if err != nil {
+		return err
+	}
+	e.GET("/docs/documents.json", func(ctx echo.Context) error {
+		return ctx.JSON(http.StatusOK, documents)
+	})
+	e.StaticFS("/docs", page)
+
+	return nil
+}
+
+// registerDocument serves doc as JSON and YAML from path with the respective extension.
+// Both are encoded once, as the document does not change while the service runs.
+func registerDocument(e *echo.Echo, path string, doc *openapi3.T) error {
+	j, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("registerDocument %s: %w", path, err)
+	}
+
+	y, err := jsonToYAML(j)
+	if err != nil {
+		return fmt.Errorf("registerDocument %s: %w", path, err)
+	}
+
+	e.GET(path+".json", func(ctx echo.Context) error {
+		return ctx.JSONBlob(http.StatusOK, j)
+	})
+	e.GET(path+".yaml", func(ctx echo.Context) error {
+		return ctx.Blob(http.StatusOK, mimeApplicationYAML, y)
+	})
+	return nil
+}
+
+// withServer returns a copy of doc served from serviceUrl, leaving doc as is for the validators.
+func withServer(doc *openapi3.T, serviceUrl string) *openapi3.T {
+	d := *doc
+	d.Servers = openapi3.Servers{{URL: serviceUrl}}
+	return &d
+}
+
+// merge returns one document with the paths and components of docs.
+// A path or component defined by more than one document is an error, since one would hide the other.
+func merge(docs []*openapi3.T, serviceUrl string) (*openapi3.T, error) {
+	merged := &openapi3.T{
+		OpenAPI: "3.0.3",
+		Info: &openapi3.Info{
+			Title:       "openapi-golang",
+			Version:     "1.0.0",
+			Description: "Every API served by this service",
+		},
+		Servers:    openapi3.Servers{{URL: serviceUrl}},
+		Paths:      openapi3.NewPaths(),
+		Components: &openapi3.Components{},
+	}
+
+	c := merged.Components
+	for _, doc := range docs {
+		for path, item := range doc.Paths.Map() {
+			if merged.Paths.Value(path) != nil {
+				return nil, fmt.Errorf("merge: path %s is defined twice", path)
+			}
+			merged.Paths.Set(path, item)
+		}
+
+		if doc.Components == nil {
+			continue
+		}
+
+		if err := mergeComponents(&c.Schemas, doc.Components.Schemas, "schema"); err != nil {
+			return nil, err
+		}
+		if err := mergeComponents(&c.Parameters, doc.Components.Parameters, "parameter"); err != nil {
+			return nil, err
+		}
+		if err := mergeComponents(&c.Headers, doc.Components.Headers, "header"); err != nil {
+			return nil, err
+		}
+		if err := mergeComponents(&c.RequestBodies, doc.Components.RequestBodies, "request body"); err != nil {
+			return nil, err
+		}
+		if err := mergeComponents(&c.Responses, doc.Components.Responses, "response"); err != nil {
+			return nil, err
+		}
+		if err := mergeComponents(&c.SecuritySchemes, doc.Components.SecuritySchemes, "security scheme"); err != nil {
+			return nil, err
+		}
+		if err := mergeComponents(&c.Examples, doc.Components.Examples, "example"); err != nil {
+			return nil, err
+		}
+	}
+
+	return merged, nil
+}
+
+func mergeComponents[M ~map[string]V, V any](dst *M, src M, kind string) error {
+	for name, v := range src {
+		if *dst == nil {
+			*dst = make(M)
+		}
+		if _, ok := (*dst)[name]; ok {
+			return fmt.Errorf("merge: %s %s is defined twice", kind, name)
+		}
+		(*dst)[name] = v
+	}
+	return nil
+}
+
+// jsonToYAML re-encodes j in block style, keeping the order of its keys.
+func jsonToYAML(j []byte) ([]byte, error) {
+	var node yaml.Node
+	if err := yaml.Unmarshal(j, &node); err != nil {
+		return nil, err
+	}
+	blockStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+func blockStyle(n *yaml.Node) {
+	n.Style = 0
+	for _, c := range n.Content {
+		blockStyle(c)
+	}
+}
@@ -0,0 +1,181 @@
+package docs_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"gopkg.in/yaml.v3"
+
+	helloOapi "openapi/internal/infra/oapicodegen/hello"
+	stockOapi "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/docs"
+)
+
+const serviceUrl = "https://stock.example.com"
+
+func newServer(t *testing.T) *echo.Echo {
+	t.Helper()
+
+	hello, err := helloOapi.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stock, err := stockOapi.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	if err := docs.RegisterHandlers(e, serviceUrl, map[string]*openapi3.T{"hello": hello, "stock": stock}); err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func get(t *testing.T, e *echo.Echo, target string) (*http.Response, []byte) {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, target, nil))
+	res := rec.Result()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res, body
+}
+
+func load(t *testing.T, data []byte) *openapi3.T {
+	t.Helper()
+
+	doc, err := openapi3.NewLoader().LoadFromData(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return doc
+}
+
+func TestDocuments(t *testing.T) {
+	// Setup
+	e := newServer(t)
+
+	tests := []struct {
+		target string
+		paths  []string
+	}{
+		{"/openapi/stock.json", []string{"/stock/locations", "/stock/balances"}},
+		{"/openapi/hello.json", []string{"/hello"}},
+		{"/openapi.json", []string{"/hello", "/stock/locations", "/stock/balances"}},
+	}
+
+	for _, tt := range tests {
+		// When
+		res, body := get(t, e, tt.target)
+
+		// Then
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("%s: want %d, got %d", tt.target, http.StatusOK, res.StatusCode)
+		}
+
+		doc := load(t, body)
+		if err := doc.Validate(context.Background()); err != nil {
+			t.Errorf("%s: %v", tt.target, err)
+		}
+
+		if len(doc.Servers) != 1 || doc.Servers[0].URL != serviceUrl {
+			t.Errorf("%s: %T %+v want %s", tt.target, doc.Servers, doc.Servers, serviceUrl)
+		}
+
+		for _, path := range tt.paths {
+			if doc.Paths.Value(path) == nil {
+				t.Errorf("%s: path %s is missing", tt.target, path)
+			}
+		}
+	}
+}
+
+func TestDocumentYAML(t *testing.T) {
+	// Setup
+	e := newServer(t)
+
+	// When
+	res, body := get(t, e, "/openapi/stock.yaml")
+
+	// Then
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, res.StatusCode)
+	}
+	if !strings.HasPrefix(res.Header.Get(echo.HeaderContentType), "application/yaml") {
+		t.Errorf("%s, want application/yaml", res.Header.Get(echo.HeaderContentType))
+	}
+
+	var v map[string]interface{}
+	if err := yaml.Unmarshal(body, &v); err != nil {
+		t.Fatal(err)
+	}
+	if strings.HasPrefix(string(body), "{") {
+		t.Errorf("YAML must be in block style")
+	}
+
+	doc := load(t, body)
+	if doc.Paths.Value("/stock/locations") == nil || doc.Servers[0].URL != serviceUrl {
+		t.Errorf("%T %+v want the stock document served from %s", doc, doc, serviceUrl)
+	}
+}
+
+func TestPage(t *testing.T) {
+	// Setup
+	e := newServer(t)
+
+	for _, target := range []string{"/docs/", "/docs/docs.js", "/docs/docs.css"} {
+		// When
+		res, body := get(t, e, target)
+
+		// Then
+		if res.StatusCode != http.StatusOK {
+			t.Errorf("%s: want %d, got %d", target, http.StatusOK, res.StatusCode)
+		}
+		if strings.Contains(string(body), "https://") {
+			t.Errorf("%s: page must not load anything from elsewhere", target)
+		}
+	}
+
+	// When
+	res, body := get(t, e, "/docs/documents.json")
+
+	// Then
+	var documents []struct {
+		Name string `json:"name"`
+		Url  string `json:"url"`
+	}
+	if err := json.Unmarshal(body, &documents); err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK || len(documents) != 3 || documents[0].Url != "/openapi.json" {
+		t.Errorf("%d %+v want the merged document then each API", res.StatusCode, documents)
+	}
+}
+
+func TestRegisterHandlersFailDuplicatePath(t *testing.T) {
+	// Setup
+	stock, err := stockOapi.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	err = docs.RegisterHandlers(echo.New(), serviceUrl, map[string]*openapi3.T{"a": stock, "b": stock})
+
+	// Then
+	if err == nil {
+		t.Fatalf("error must not be nil")
+	}
+}
@@ -0,0 +1,39 @@
+package etag
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Format returns the strong entity tag of a version.
+func Format(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// ParseIfMatch returns the versions listed in an If-Match header value.
+// It returns nil when the header is absent or "*", which any version satisfies.
+// If-Match uses the strong comparison, so weak and malformed entity tags are left out
+// and a header made up of them only returns an empty slice that no version satisfies.
+func ParseIfMatch(header string) []int64 {
+	header = strings.TrimSpace(header)
+	if header == "" || header == "*" {
+		return nil
+	}
+
+	versions := []int64{}
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+		if len(tag) < 2 || !strings.HasPrefix(tag, `"`) || !strings.HasSuffix(tag, `"`) {
+			continue
+		}
+
+		version, err := strconv.ParseInt(tag[1:len(tag)-1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions
+}
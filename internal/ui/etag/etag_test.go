@@ -0,0 +1,46 @@
+package etag_test
+
+import (
+	"reflect"
+	"testing"
+
+	"openapi/internal/ui/etag"
+)
+
+func TestFormat(t *testing.T) {
+	t.Parallel()
+
+	// When
+	actual := etag.Format(3)
+
+	// Then
+	if actual != `"3"` {
+		t.Errorf("%T %+v want %+v", actual, actual, `"3"`)
+	}
+}
+
+func TestParseIfMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		header string
+		expect []int64
+	}{
+		{``, nil},
+		{`*`, nil},
+		{`"3"`, []int64{3}},
+		{`"1", "3"`, []int64{1, 3}},
+		{`W/"3"`, []int64{}},
+		{`"abc", 3`, []int64{}},
+	}
+
+	for _, tt := range tests {
+		// When
+		actual := etag.ParseIfMatch(tt.header)
+
+		// Then
+		if !reflect.DeepEqual(actual, tt.expect) {
+			t.Errorf("%s %T %+v want %+v", tt.header, actual, actual, tt.expect)
+		}
+	}
+}
@@ -1,39 +1,38 @@
 package hello_test
 
 import (
-	"openapi/internal/infra/env"
-	oapicodegen "openapi/internal/infra/oapicodegen/hello"
+	"context"
+	"net/http"
 	"testing"
 
 	cmp "github.com/google/go-cmp/cmp"
 
-	"encoding/json"
-	"io"
-	"net/http"
+	"openapi/internal/infra/env"
+	helloClient "openapi/pkg/client/hello"
 )
 
 func TestGetSuccess(t *testing.T) {
 	t.Parallel()
 
-	res, err := http.Get(env.GetServiceUrl() + "/hello")
+	c, err := helloClient.New(env.GetServiceUrl())
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer res.Body.Close()
 
-	if res.StatusCode != http.StatusOK {
-		t.Errorf("want %d, got %d", http.StatusOK, res.StatusCode)
+	res, err := c.GetHelloWithResponse(context.Background())
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	resBodyByte, _ := io.ReadAll(res.Body)
-	var actual = &oapicodegen.Hello{}
-	json.Unmarshal(resBodyByte, &actual)
+	if res.StatusCode() != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, res.StatusCode())
+	}
 
-	var expect = &oapicodegen.Hello{
+	var expect = &helloClient.Hello{
 		Message: "Hello, World!",
 	}
 
-	if !cmp.Equal(actual, expect) {
-		t.Errorf("expected %s, actual %s", expect, actual)
+	if !cmp.Equal(res.JSON200, expect) {
+		t.Errorf("expected %s, actual %s", expect, res.JSON200)
 	}
 }
@@ -3,6 +3,7 @@ package hello
 import (
 	oapicodegen "openapi/internal/infra/oapicodegen/hello"
 
+	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
 )
 
@@ -12,6 +13,11 @@ func New() oapicodegen.ServerInterface {
 	return &Api{}
 }
 
+// GetSwagger returns the OpenAPI document the hello API implements.
+func GetSwagger() (*openapi3.T, error) {
+	return oapicodegen.GetSwagger()
+}
+
 func RegisterHandlers(e *echo.Echo, si oapicodegen.ServerInterface) {
 	oapicodegen.RegisterHandlers(e, si)
 }
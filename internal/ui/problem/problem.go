@@ -0,0 +1,180 @@
+package problem
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/failure"
+)
+
+// ContentType is the media type of problem details defined by RFC 7807.
+const ContentType = "application/problem+json"
+
+// Problem is the body of every error response.
+// Type is always about:blank, so Title is the HTTP status text and Code tells problems apart.
+// Extensions are written as members of their own next to the others, as RFC 7807 defines extension members.
+type Problem struct {
+	Type       string         `json:"type"`
+	Title      string         `json:"title"`
+	Status     int            `json:"status"`
+	Detail     string         `json:"detail,omitempty"`
+	Instance   string         `json:"instance,omitempty"`
+	Code       string         `json:"code"`
+	Errors     []Field        `json:"errors,omitempty"`
+	Extensions map[string]any `json:"-"`
+}
+
+func (p *Problem) MarshalJSON() ([]byte, error) {
+	type problem Problem
+	b, err := json.Marshal((*problem)(p))
+	if err != nil || len(p.Extensions) == 0 {
+		return b, err
+	}
+
+	names := make([]string, 0, len(p.Extensions))
+	for name := range p.Extensions {
+		if !members[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	b = b[:len(b)-1]
+	for _, name := range names {
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(p.Extensions[name])
+		if err != nil {
+			return nil, err
+		}
+		b = append(append(append(append(b, ','), key...), ':'), value...)
+	}
+	return append(b, '}'), nil
+}
+
+type Field struct {
+	Field  string `json:"field"`
+	Reason string `json:"reason"`
+}
+
+// members are the names of the members of a problem, which no extension takes over.
+var members = map[string]bool{"type": true, "title": true, "status": true, "detail": true, "instance": true, "code": true, "errors": true}
+
+// New returns the problem details of err.
+// Failures and echo errors below 500 are described to the client, requests past their deadline
+// are reported as 503 whatever else their error holds, anything else is a fault of the server whose message might
+// leak internals and is left out.
+// A failure is described by its own message and extensions only, as the errors wrapping it are written for the log,
+// and one marked by failure.Internal is a fault of the server like any other.
+func New(err error) *Problem {
+	// a request cut short by its deadline fails with whatever the handler was doing at the time,
+	// which says nothing about the request, so the timeout goes first
+	if errors.Is(err, context.DeadlineExceeded) {
+		p := newProblem(http.StatusServiceUnavailable, "timeout")
+		p.Detail = "request did not complete in time"
+		return p
+	}
+
+	var f *failure.Error
+	if errors.As(err, &f) && !failure.IsInternal(err) {
+		p := newProblem(statusOf(f.Kind), f.Code)
+		p.Detail = f.Message
+		for _, field := range f.Fields {
+			p.Errors = append(p.Errors, Field{Field: field.Name, Reason: field.Reason})
+		}
+		for _, x := range f.Extensions {
+			if p.Extensions == nil {
+				p.Extensions = map[string]any{}
+			}
+			p.Extensions[x.Name] = x.Value
+		}
+		return p
+	}
+
+	var he *echo.HTTPError
+	if errors.As(err, &he) && he.Code < http.StatusInternalServerError {
+		p := newProblem(he.Code, codeOf(he.Code))
+		if message, ok := he.Message.(string); ok {
+			p.Detail = message
+		}
+		return p
+	}
+
+	return newProblem(http.StatusInternalServerError, "internal_error")
+}
+
+// HTTPErrorHandler writes the problem details of err in place of echo's default error body.
+func HTTPErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+
+	p := New(err)
+	p.Instance = ctx.Request().URL.Path
+	if p.Status >= http.StatusInternalServerError {
+		ctx.Logger().Error(err)
+	}
+
+	if ctx.Request().Method == http.MethodHead {
+		err = ctx.NoContent(p.Status)
+	} else {
+		ctx.Response().Header().Set(echo.HeaderContentType, ContentType)
+		err = ctx.JSON(p.Status, p)
+	}
+	if err != nil {
+		ctx.Logger().Error(err)
+	}
+}
+
+func newProblem(status int, code string) *Problem {
+	return &Problem{
+		Type:   "about:blank",
+		Title:  http.StatusText(status),
+		Status: status,
+		Code:   code,
+	}
+}
+
+func statusOf(kind failure.Kind) int {
+	switch kind {
+	case failure.KindValidation:
+		return http.StatusBadRequest
+	case failure.KindNotFound:
+		return http.StatusNotFound
+	case failure.KindConflict:
+		return http.StatusConflict
+	case failure.KindPreconditionFailed:
+		return http.StatusPreconditionFailed
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// codeOf returns the code of errors that carry no more than an HTTP status.
+func codeOf(status int) string {
+	switch status {
+	case http.StatusUnauthorized:
+		return "unauthorized"
+	case http.StatusForbidden:
+		return "forbidden"
+	case http.StatusNotFound:
+		return "not_found"
+	case http.StatusMethodNotAllowed:
+		return "method_not_allowed"
+	case http.StatusConflict:
+		return "conflict"
+	case http.StatusPreconditionFailed:
+		return "precondition_failed"
+	case http.StatusUnsupportedMediaType:
+		return "unsupported_media_type"
+	default:
+		return "bad_request"
+	}
+}
@@ -0,0 +1,194 @@
+package problem_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/failure"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/problem"
+)
+
+func TestNew(t *testing.T) {
+	conflict := failure.Conflict("insufficient_quantity", "insufficient quantity on hand")
+
+	for _, tt := range []struct {
+		err    error
+		status int
+		code   string
+		detail string
+	}{
+		{failure.Validation("invalid_cursor", "invalid cursor"), http.StatusBadRequest, "invalid_cursor", "invalid cursor"},
+		{failure.NotFound("not_found", "gone"), http.StatusNotFound, "not_found", "gone"},
+		{fmt.Errorf("%w: 5 more needed", conflict), http.StatusConflict, "insufficient_quantity", "insufficient quantity on hand"},
+		{fmt.Errorf("NewName: %w %+v", failure.Validation("invalid_stock_location_name", "invalid stock location name"), "a\tb"), http.StatusBadRequest, "invalid_stock_location_name", "invalid stock location name"},
+		{failure.Internal(fmt.Errorf("NewName: %w", failure.Validation("invalid_stock_location_name", "invalid stock location name"))), http.StatusInternalServerError, "internal_error", ""},
+		{failure.PreconditionFailed("stock_location_version_mismatch", "modified"), http.StatusPreconditionFailed, "stock_location_version_mismatch", "modified"},
+		{echo.NewHTTPError(http.StatusNotFound, "stock location not found"), http.StatusNotFound, "not_found", "stock location not found"},
+		{echo.NewHTTPError(http.StatusUnauthorized, "admin token required"), http.StatusUnauthorized, "unauthorized", "admin token required"},
+		{echo.NewHTTPError(http.StatusForbidden, "invalid admin token"), http.StatusForbidden, "forbidden", "invalid admin token"},
+		{echo.ErrMethodNotAllowed, http.StatusMethodNotAllowed, "method_not_allowed", "Method Not Allowed"},
+		{echo.NewHTTPError(http.StatusInternalServerError, "pq: password authentication failed"), http.StatusInternalServerError, "internal_error", ""},
+		{fmt.Errorf("%w: pq: canceling statement due to user request", context.DeadlineExceeded), http.StatusServiceUnavailable, "timeout", "request did not complete in time"},
+		{fmt.Errorf("%w: %w", context.DeadlineExceeded, conflict), http.StatusServiceUnavailable, "timeout", "request did not complete in time"},
+		{errors.New("dial tcp 127.0.0.1:5432: connect: connection refused"), http.StatusInternalServerError, "internal_error", ""},
+	} {
+		// When
+		p := problem.New(tt.err)
+
+		// Then
+		if p.Status != tt.status || p.Code != tt.code || p.Detail != tt.detail {
+			t.Errorf("%v: %T %+v want %d %s %q", tt.err, p, p, tt.status, tt.code, tt.detail)
+		}
+
+		if p.Type != "about:blank" || p.Title != http.StatusText(tt.status) {
+			t.Errorf("%v: %T %+v want about:blank %s", tt.err, p, p, http.StatusText(tt.status))
+		}
+	}
+}
+
+func TestNewFields(t *testing.T) {
+	// Given
+	err := failure.Validation("validation_failed", "invalid", failure.Field{Name: "name", Reason: "required"})
+
+	// When
+	p := problem.New(err)
+
+	// Then
+	if len(p.Errors) != 1 || p.Errors[0].Field != "name" || p.Errors[0].Reason != "required" {
+		t.Errorf("%T %+v want name required", p.Errors, p.Errors)
+	}
+}
+
+func TestNewExtensions(t *testing.T) {
+	// Given
+	inStock := failure.Conflict("stock_serial_in_stock", "stock serial is already in a stock location")
+	err := fmt.Errorf("Receive: %w in %s", inStock.With("serial", "S1"), "a6a0c1a4-3f7b-4a4c-9e25-8d5e4a1c7b10")
+
+	// When
+	p := problem.New(err)
+	b, marshalErr := json.Marshal(p)
+	if marshalErr != nil {
+		t.Fatal(marshalErr)
+	}
+
+	// Then
+	if p.Detail != "stock serial is already in a stock location" {
+		t.Errorf("%T %+v want the message of the failure", p.Detail, p.Detail)
+	}
+
+	members := map[string]any{}
+	if err := json.Unmarshal(b, &members); err != nil {
+		t.Fatal(err)
+	}
+
+	if members["serial"] != "S1" || members["code"] != "stock_serial_in_stock" {
+		t.Errorf("%T %+v want the serial S1 next to the code", members, members)
+	}
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	// Setup
+	e := echo.New()
+	req := httptest.NewRequest(http.MethodGet, "/stock/locations", nil)
+	rec := httptest.NewRecorder()
+	ctx := e.NewContext(req, rec)
+
+	// When
+	problem.HTTPErrorHandler(failure.Validation("invalid_cursor", "invalid cursor"), ctx)
+
+	// Then
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, rec.Code)
+	}
+
+	if contentType := rec.Header().Get(echo.HeaderContentType); contentType != problem.ContentType {
+		t.Errorf("want %s, got %s", problem.ContentType, contentType)
+	}
+
+	p := &problem.Problem{}
+	if err := json.Unmarshal(rec.Body.Bytes(), p); err != nil {
+		t.Fatal(err)
+	}
+
+	if p.Instance != "/stock/locations" || p.Code != "invalid_cursor" {
+		t.Errorf("%T %+v want /stock/locations invalid_cursor", p, p)
+	}
+}
+
+// TestCodesInSpec keeps the codes of the failures declared under internal in the code enum of the Problem
+// of the stock API, which strict response validation holds the responses to.
+func TestCodesInSpec(t *testing.T) {
+	// Given
+	swagger, err := oapicodegen.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enum := map[string]bool{}
+	for _, v := range swagger.Components.Schemas["Problem"].Value.Properties["code"].Value.Enum {
+		enum[fmt.Sprint(v)] = true
+	}
+
+	// When
+	fset := token.NewFileSet()
+	codes := 0
+	err = filepath.WalkDir("../..", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || !strings.HasSuffix(path, ".go") || strings.HasSuffix(path, "_test.go") {
+			return err
+		}
+
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			return err
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			call, ok := n.(*ast.CallExpr)
+			if !ok || len(call.Args) == 0 {
+				return true
+			}
+			sel, ok := call.Fun.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if pkg, ok := sel.X.(*ast.Ident); !ok || pkg.Name != "failure" {
+				return true
+			}
+			lit, ok := call.Args[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.STRING {
+				return true
+			}
+
+			// Then
+			code, _ := strconv.Unquote(lit.Value)
+			codes++
+			if !enum[code] {
+				t.Errorf("%s: code %s is not in the code enum of Problem", fset.Position(lit.Pos()), code)
+			}
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if codes == 0 {
+		t.Errorf("no failure codes found")
+	}
+}
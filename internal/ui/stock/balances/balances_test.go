@@ -0,0 +1,81 @@
+package balances_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+)
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over an
+// in-memory database of its own.
+type RequestHelper struct {
+	client *stockClient.ClientWithResponses
+}
+
+func newRequestHelper(t *testing.T) *RequestHelper {
+	t.Helper()
+
+	return &RequestHelper{client: stocktest.NewMemoryClient(t)}
+}
+
+// List sends query as is, so that tests can send values the typed parameters cannot hold.
+func (h *RequestHelper) List(query url.Values) (*http.Response, error) {
+	return h.client.ClientInterface.GetStockBalances(context.Background(), &stockClient.GetStockBalancesParams{}, withQuery(query))
+}
+
+func (h *RequestHelper) PostMovement(reqBody *stockClient.PostStockMovementJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockMovement(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) PostItem(reqBody *stockClient.PostStockItemJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockItem(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) PostLocation(reqBody *stockClient.PostStockLocationJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockLocation(context.Background(), *reqBody)
+}
+
+func withQuery(query url.Values) stockClient.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+type ResponseConvertHelper struct{}
+
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Created{}
+	json.Unmarshal(resBodyByte, &resBody)
+	if resBody.Id == uuid.Nil {
+		return nil, fmt.Errorf("expected not empty, actual empty")
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsStockBalances(res *http.Response) (*stockClient.StockBalances, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockBalances{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
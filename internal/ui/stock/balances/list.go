@@ -0,0 +1,59 @@
+package balances
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/movement"
+	"openapi/internal/domain/stock/movement"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// GetStockBalances is a function that handles the HTTP GET request for listing quantities on hand page by page.
+func GetStockBalances(ctx echo.Context, repository movement.IRepository, params oapicodegen.GetStockBalancesParams) error {
+	// Precondition
+	reqDto := &app.ListBalancesRequestDto{
+		Limit:      app.DefaultListLimit,
+		ItemId:     params.ItemId,
+		LocationId: params.LocationId,
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > app.MaxListLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and 100")
+		}
+		reqDto.Limit = *params.Limit
+	}
+
+	if params.After != nil {
+		reqDto.After = *params.After
+	}
+
+	// Main Process
+	resDto, err := app.ListBalances(ctx.Request().Context(), reqDto, repository)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.StockBalances{
+		Items: make([]oapicodegen.StockBalance, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		b := oapicodegen.StockBalance{
+			ItemId:     item.ItemId,
+			LocationId: item.LocationId,
+			Quantity:   item.Quantity,
+		}
+		if item.Lot != "" {
+			b.Lot = &item.Lot
+		}
+		res.Items = append(res.Items, b)
+	}
+	if resDto.NextCursor != "" {
+		res.NextCursor = &resDto.NextCursor
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
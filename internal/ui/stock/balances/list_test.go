@@ -0,0 +1,199 @@
+package balances_test
+
+import (
+	"net/http"
+	"net/url"
+	stockClient "openapi/pkg/client/stock"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestListOK(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemRes, err := rh.PostItem(&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer itemRes.Body.Close()
+
+	item, err := rch.AsCreated(itemRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationRes, err := rh.PostLocation(&stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer locationRes.Body.Close()
+
+	location, err := rch.AsCreated(locationRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	for _, m := range []*stockClient.PostStockMovementJSONRequestBody{
+		{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Receipt, Quantity: 10},
+		{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Issue, Quantity: 3},
+	} {
+		movementRes, err := rh.PostMovement(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		movementRes.Body.Close()
+
+		if movementRes.StatusCode != http.StatusCreated {
+			t.Fatalf("want %d, got %d", http.StatusCreated, movementRes.StatusCode)
+		}
+	}
+
+	// When
+	listRes, err := rh.List(url.Values{
+		"item_id":     {item.Id.String()},
+		"location_id": {location.Id.String()},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listRes.Body.Close()
+
+	// Then
+	if listRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, listRes.StatusCode)
+	}
+
+	listResBody, err := rch.AsStockBalances(listRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(listResBody.Items) != 1 {
+		t.Fatalf("want %d, got %d", 1, len(listResBody.Items))
+	}
+
+	if listResBody.Items[0].Quantity != 7 {
+		t.Errorf("want %d, got %d", 7, listResBody.Items[0].Quantity)
+	}
+}
+
+func TestListBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+
+	// When
+	listRes, err := rh.List(url.Values{"item_id": {"invalid"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listRes.Body.Close()
+
+	// Then
+	if listRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, listRes.StatusCode)
+	}
+}
+
+func TestListPages(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemRes, err := rh.PostItem(&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer itemRes.Body.Close()
+
+	item, err := rch.AsCreated(itemRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	for i := 0; i < 3; i++ {
+		locationRes, err := rh.PostLocation(&stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer locationRes.Body.Close()
+
+		location, err := rch.AsCreated(locationRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		movementRes, err := rh.PostMovement(&stockClient.PostStockMovementJSONRequestBody{
+			ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Receipt, Quantity: 1,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		movementRes.Body.Close()
+
+		if movementRes.StatusCode != http.StatusCreated {
+			t.Fatalf("want %d, got %d", http.StatusCreated, movementRes.StatusCode)
+		}
+	}
+
+	// When
+	firstRes, err := rh.List(url.Values{"item_id": {item.Id.String()}, "limit": {"2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer firstRes.Body.Close()
+
+	first, err := rch.AsStockBalances(firstRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.NextCursor == nil {
+		t.Fatalf("next_cursor must not be nil")
+	}
+
+	secondRes, err := rh.List(url.Values{"item_id": {item.Id.String()}, "limit": {"2"}, "after": {*first.NextCursor}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer secondRes.Body.Close()
+
+	second, err := rch.AsStockBalances(secondRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if len(first.Items) != 2 || len(second.Items) != 1 {
+		t.Fatalf("want %d and %d, got %d and %d", 2, 1, len(first.Items), len(second.Items))
+	}
+
+	if second.NextCursor != nil {
+		t.Errorf("want no next_cursor, got %s", *second.NextCursor)
+	}
+
+	if first.Items[1].LocationId.String() >= second.Items[0].LocationId.String() {
+		t.Errorf("want ordered by location, got %s then %s", first.Items[1].LocationId, second.Items[0].LocationId)
+	}
+}
+
+func TestListBadRequestCursor(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+
+	// When
+	listRes, err := rh.List(url.Values{"after": {"invalid"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listRes.Body.Close()
+
+	// Then
+	if listRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, listRes.StatusCode)
+	}
+}
@@ -0,0 +1,43 @@
+package items
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/item"
+	domain "openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/etag"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// DeleteStockItem is a function that handles the HTTP DELETE request for deleting an existing stock item.
+func DeleteStockItem(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockItemId openapi_types.UUID, params oapicodegen.DeleteStockItemParams) error {
+	// Prepcondition
+	if stockItemId == uuid.Nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "invalid stock item id")
+	}
+
+	if _, err := domain.NewId(stockItemId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Main Process
+	reqDto := &app.DeleteRequestDto{
+		Id: stockItemId,
+	}
+	if params.IfMatch != nil {
+		reqDto.Versions = etag.ParseIfMatch(*params.IfMatch)
+	}
+	err := app.Delete(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	return ctx.NoContent(http.StatusOK)
+}
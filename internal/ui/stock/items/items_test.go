@@ -0,0 +1,85 @@
+package items_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+)
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over an
+// in-memory database of its own.
+type RequestHelper struct {
+	client *stockClient.ClientWithResponses
+}
+
+func newRequestHelper(t *testing.T) *RequestHelper {
+	t.Helper()
+
+	return &RequestHelper{client: stocktest.NewMemoryClient(t)}
+}
+
+func (h *RequestHelper) Post(reqBody *stockClient.PostStockItemJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockItem(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) Put(stockItemsId uuid.UUID, reqBody *stockClient.PutStockItemJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PutStockItem(context.Background(), stockItemsId, &stockClient.PutStockItemParams{}, *reqBody)
+}
+
+func (h *RequestHelper) Delete(stockItemsId uuid.UUID) (*http.Response, error) {
+	return h.client.ClientInterface.DeleteStockItem(context.Background(), stockItemsId, &stockClient.DeleteStockItemParams{})
+}
+
+func (h *RequestHelper) List(params *stockClient.GetStockItemsParams) (*http.Response, error) {
+	return h.client.ClientInterface.GetStockItems(context.Background(), params)
+}
+
+type ResponseConvertHelper struct{}
+
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Created{}
+	json.Unmarshal(resBodyByte, &resBody)
+	if resBody.Id == uuid.Nil {
+		return nil, fmt.Errorf("expected not empty, actual empty")
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsProblem(res *http.Response) (*stockClient.Problem, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Problem{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsStockItems(res *http.Response) (*stockClient.StockItems, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockItems{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
@@ -0,0 +1,87 @@
+package items
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/item"
+	"openapi/internal/domain/stock/item"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// GetStockItems is a function that handles the HTTP GET request for listing stock items page by page.
+func GetStockItems(ctx echo.Context, repository item.IRepository, params oapicodegen.GetStockItemsParams) error {
+	// Precondition
+	reqDto := &app.ListRequestDto{
+		Limit: app.DefaultListLimit,
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > app.MaxListLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and 100")
+		}
+		reqDto.Limit = *params.Limit
+	}
+
+	if params.After != nil {
+		reqDto.After = *params.After
+	}
+
+	if params.Sku != nil {
+		reqDto.Sku = *params.Sku
+	}
+
+	if params.Barcode != nil {
+		reqDto.Barcode = *params.Barcode
+	}
+
+	// Main Process
+	resDto, err := app.List(ctx.Request().Context(), reqDto, repository)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	return ctx.JSON(http.StatusOK, newStockItems(resDto))
+}
+
+func newStockItems(resDto *app.ListResponseDto) *oapicodegen.StockItems {
+	res := &oapicodegen.StockItems{
+		Items: make([]oapicodegen.StockItem, 0, len(resDto.Items)),
+	}
+	for _, dto := range resDto.Items {
+		res.Items = append(res.Items, oapicodegen.StockItem{
+			Id:         dto.Id,
+			Sku:        dto.Sku,
+			Name:       dto.Name,
+			BaseUnit:   dto.BaseUnit,
+			Barcodes:   dto.Barcodes,
+			PackSizes:  newStockItemPackSizes(dto.PackSizes),
+			UnitVolume: dto.UnitVolume,
+			UnitWeight: dto.UnitWeight,
+			LotTracked: dto.LotTracked,
+			Serialized: dto.Serialized,
+		})
+	}
+	if resDto.NextCursor != "" {
+		res.NextCursor = &resDto.NextCursor
+	}
+	return res
+}
+
+func newStockItemPackSizes(dtos []app.PackSizeDto) []oapicodegen.StockItemPackSize {
+	res := make([]oapicodegen.StockItemPackSize, 0, len(dtos))
+	for _, dto := range dtos {
+		res = append(res, oapicodegen.StockItemPackSize{Unit: dto.Unit, Quantity: dto.Quantity})
+	}
+	return res
+}
+
+func newPackSizeDtos(req []oapicodegen.StockItemPackSize) []app.PackSizeDto {
+	dtos := make([]app.PackSizeDto, 0, len(req))
+	for _, p := range req {
+		dtos = append(dtos, app.PackSizeDto{Unit: p.Unit, Quantity: p.Quantity})
+	}
+	return dtos
+}
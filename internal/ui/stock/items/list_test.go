@@ -0,0 +1,137 @@
+package items_test
+
+import (
+	stockClient "openapi/pkg/client/stock"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"net/http"
+)
+
+func TestListOkBySkuAndBarcode(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	sku := "ui-" + uuid.NewString()
+	barcodes := []string{"4006381333931"}
+	packSizes := []stockClient.StockItemPackSize{{Unit: "case", Quantity: 12}}
+
+	// Given
+	postRes, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:       sku,
+			Name:      uuid.NewString(),
+			Barcodes:  &barcodes,
+			PackSizes: &packSizes,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	postResBody, err := rch.AsCreated(postRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	lowerSku := strings.ToLower(sku)
+	bySkuRes, err := rh.List(&stockClient.GetStockItemsParams{Sku: &lowerSku})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer bySkuRes.Body.Close()
+
+	byBarcodeRes, err := rh.List(&stockClient.GetStockItemsParams{Barcode: &barcodes[0]})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer byBarcodeRes.Body.Close()
+
+	// Then
+	if bySkuRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, bySkuRes.StatusCode)
+	}
+
+	bySku, err := rch.AsStockItems(bySkuRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(bySku.Items) != 1 {
+		t.Fatalf("want 1 item, got %+v", bySku.Items)
+	}
+
+	got := bySku.Items[0]
+	if got.Id != postResBody.Id || got.Sku != strings.ToUpper(sku) || got.BaseUnit != "each" {
+		t.Errorf("want %s %s each, got %+v", postResBody.Id, strings.ToUpper(sku), got)
+	}
+
+	if len(got.Barcodes) != 1 || got.Barcodes[0] != barcodes[0] || len(got.PackSizes) != 1 || got.PackSizes[0] != packSizes[0] {
+		t.Errorf("want %v and %v, got %+v", barcodes, packSizes, got)
+	}
+
+	if byBarcodeRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, byBarcodeRes.StatusCode)
+	}
+
+	byBarcode, err := rch.AsStockItems(byBarcodeRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	found := false
+	for _, item := range byBarcode.Items {
+		found = found || item.Id == postResBody.Id
+	}
+	if !found {
+		t.Errorf("want %s among %+v", postResBody.Id, byBarcode.Items)
+	}
+}
+
+func TestListBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	invalidBarcode := "4006381333932"
+	invalidSku := "a b"
+	invalidAfter := "!"
+	invalidLimit := 101
+
+	tests := []struct {
+		params *stockClient.GetStockItemsParams
+		code   stockClient.ProblemCode
+	}{
+		{&stockClient.GetStockItemsParams{Barcode: &invalidBarcode}, stockClient.ProblemCodeInvalidStockItemBarcode},
+		{&stockClient.GetStockItemsParams{Sku: &invalidSku}, stockClient.ProblemCodeInvalidStockItemSku},
+		{&stockClient.GetStockItemsParams{After: &invalidAfter}, stockClient.ProblemCodeInvalidCursor},
+		{&stockClient.GetStockItemsParams{Limit: &invalidLimit}, stockClient.ProblemCodeValidationFailed},
+	}
+
+	for _, tt := range tests {
+		// When
+		res, err := rh.List(tt.params)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+
+		// Then
+		if res.StatusCode != http.StatusBadRequest {
+			t.Errorf("%+v want %d, got %d", tt.params, http.StatusBadRequest, res.StatusCode)
+		}
+
+		problem, err := rch.AsProblem(res)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if problem.Code != tt.code {
+			t.Errorf("%+v want %s, got %s", tt.params, tt.code, problem.Code)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package items
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/item"
+	"openapi/internal/domain/stock/item"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// PostStockItem is a function that handles the HTTP POST request for creating a new stock item.
+func PostStockItem(ctx echo.Context, repository item.IRepository, newId func() uuid.UUID) error {
+	// Binding
+	req := &oapicodegen.PostStockItemJSONRequestBody{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	// Precondition
+	if err := ctx.Validate(req); err != nil {
+		return err
+	}
+
+	// Main Process
+	reqDto := &app.CreateRequestDto{
+		Sku:  req.Sku,
+		Name: req.Name,
+	}
+	if req.BaseUnit != nil {
+		reqDto.BaseUnit = *req.BaseUnit
+	}
+	if req.Barcodes != nil {
+		reqDto.Barcodes = *req.Barcodes
+	}
+	if req.PackSizes != nil {
+		reqDto.PackSizes = newPackSizeDtos(*req.PackSizes)
+	}
+	if req.UnitVolume != nil {
+		reqDto.UnitVolume = *req.UnitVolume
+	}
+	if req.UnitWeight != nil {
+		reqDto.UnitWeight = *req.UnitWeight
+	}
+	if req.LotTracked != nil {
+		reqDto.LotTracked = *req.LotTracked
+	}
+	if req.Serialized != nil {
+		reqDto.Serialized = *req.Serialized
+	}
+	resDto, err := app.Create(ctx.Request().Context(), reqDto, repository, newId())
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.Created{Id: resDto.Id}
+
+	// Postcondition
+	if err := ctx.Validate(res); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+	return ctx.JSON(http.StatusCreated, res)
+}
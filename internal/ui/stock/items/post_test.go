@@ -0,0 +1,191 @@
+package items_test
+
+import (
+	stockClient "openapi/pkg/client/stock"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"net/http"
+)
+
+func TestPostCreated(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	name := uuid.NewString()
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  uuid.NewString(),
+			Name: name,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusCreated {
+		t.Errorf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	}
+
+	postResBody, err := rch.AsCreated(postRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if postResBody.Id == uuid.Nil {
+		t.Errorf("expected not empty, actual empty")
+	}
+}
+
+func TestPostBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	zeroLenName := ""
+	overLenName := strings.Repeat("a", 101)
+
+	// When
+	postResZeroLen, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  uuid.NewString(),
+			Name: zeroLenName,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postResZeroLen.Body.Close()
+
+	postResOverLen, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  uuid.NewString(),
+			Name: overLenName,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postResOverLen.Body.Close()
+
+	// Then
+	if postResZeroLen.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, postResZeroLen.StatusCode)
+	}
+
+	postResZeroLenBody, err := rch.AsProblem(postResZeroLen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if postResZeroLenBody.Code != stockClient.ProblemCodeValidationFailed {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, postResZeroLenBody.Code)
+	}
+
+	if postResOverLen.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, postResOverLen.StatusCode)
+	}
+
+	postResOverLenBody, err := rch.AsProblem(postResOverLen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if postResOverLenBody.Code != stockClient.ProblemCodeValidationFailed {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, postResOverLenBody.Code)
+	}
+}
+
+func TestPostBadRequestCatalog(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	invalidBarcodes := []string{"4006381333932"}
+	duplicateUnits := []stockClient.StockItemPackSize{{Unit: "case", Quantity: 12}, {Unit: "CASE", Quantity: 24}}
+
+	tests := []struct {
+		req  *stockClient.PostStockItemJSONRequestBody
+		code stockClient.ProblemCode
+	}{
+		{&stockClient.PostStockItemJSONRequestBody{Sku: "a b", Name: uuid.NewString()}, stockClient.ProblemCodeInvalidStockItemSku},
+		{&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString(), Barcodes: &invalidBarcodes}, stockClient.ProblemCodeInvalidStockItemBarcode},
+		{&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString(), PackSizes: &duplicateUnits}, stockClient.ProblemCodeDuplicateStockItemUnit},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := rh.Post(tt.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("%+v want %d, got %d", tt.req, http.StatusBadRequest, postRes.StatusCode)
+		}
+
+		problem, err := rch.AsProblem(postRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if problem.Code != tt.code {
+			t.Errorf("%+v want %s, got %s", tt.req, tt.code, problem.Code)
+		}
+	}
+}
+
+func TestPostConflictSkuTaken(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	sku := uuid.NewString()
+
+	// Given
+	firstRes, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  sku,
+			Name: uuid.NewString(),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer firstRes.Body.Close()
+
+	if firstRes.StatusCode != http.StatusCreated {
+		t.Fatalf("want %d, got %d", http.StatusCreated, firstRes.StatusCode)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  strings.ToUpper(sku),
+			Name: uuid.NewString(),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, postRes.StatusCode)
+	}
+
+	problem, err := rch.AsProblem(postRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if problem.Code != stockClient.ProblemCodeStockItemSkuTaken {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeStockItemSkuTaken, problem.Code)
+	}
+}
@@ -0,0 +1,61 @@
+package items
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/item"
+	domain "openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/transaction"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/etag"
+)
+
+// PutStockItem is a function that handles the HTTP PUT request for updating an existing stock item.
+func PutStockItem(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockItemId openapi_types.UUID, params oapicodegen.PutStockItemParams) error {
+	// Binding
+	req := &oapicodegen.PutStockItemJSONRequestBody{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	// Precondition
+	if _, err := domain.NewId(stockItemId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		return err
+	}
+
+	// Main Process
+	reqDto := &app.UpdateRequestDto{
+		Id:         stockItemId,
+		Sku:        req.Sku,
+		Name:       req.Name,
+		Barcodes:   req.Barcodes,
+		UnitVolume: req.UnitVolume,
+		UnitWeight: req.UnitWeight,
+		LotTracked: req.LotTracked,
+		Serialized: req.Serialized,
+	}
+	if req.PackSizes != nil {
+		packSizes := newPackSizeDtos(*req.PackSizes)
+		reqDto.PackSizes = &packSizes
+	}
+	if params.IfMatch != nil {
+		reqDto.Versions = etag.ParseIfMatch(*params.IfMatch)
+	}
+	resDto, err := app.Update(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	ctx.Response().Header().Set("ETag", etag.Format(resDto.Version))
+	return ctx.NoContent(http.StatusOK)
+}
@@ -0,0 +1,159 @@
+package items_test
+
+import (
+	stockClient "openapi/pkg/client/stock"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"net/http"
+)
+
+func TestPutOk(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	bforeName := uuid.NewString()
+	afterName := uuid.NewString()
+
+	// Given
+	postRes, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  uuid.NewString(),
+			Name: bforeName,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	if postRes.StatusCode != http.StatusCreated {
+		t.Fatalf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	}
+
+	postResBody, err := rch.AsCreated(postRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	putRes, err := rh.Put(
+		postResBody.Id,
+		&stockClient.PutStockItemJSONRequestBody{
+			Name: afterName,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer putRes.Body.Close()
+
+	// Then
+	if putRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, putRes.StatusCode)
+	}
+}
+
+func TestPutNotFound(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+
+	name := uuid.NewString()
+
+	putRes, err := rh.Put(
+		uuid.New(),
+		&stockClient.PutStockItemJSONRequestBody{
+			Name: name,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer putRes.Body.Close()
+
+	// Then
+	if putRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, putRes.StatusCode)
+	}
+}
+
+func TestPutBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	zeroLenName := ""
+	overLenName := strings.Repeat("a", 101)
+
+	// Given
+	postRes, err := rh.Post(
+		&stockClient.PostStockItemJSONRequestBody{
+			Sku:  uuid.NewString(),
+			Name: uuid.NewString(),
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	if postRes.StatusCode != http.StatusCreated {
+		t.Fatalf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	}
+
+	postResBody, err := rch.AsCreated(postRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	putResZeroLen, err := rh.Put(
+		postResBody.Id,
+		&stockClient.PutStockItemJSONRequestBody{
+			Name: zeroLenName,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer putResZeroLen.Body.Close()
+
+	putResOverLen, err := rh.Put(
+		postResBody.Id,
+		&stockClient.PutStockItemJSONRequestBody{
+			Name: overLenName,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer putResOverLen.Body.Close()
+
+	// Then
+	if putResZeroLen.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, putResZeroLen.StatusCode)
+	}
+
+	putResBodyZeroLen, err := rch.AsProblem(putResZeroLen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if putResBodyZeroLen.Code != stockClient.ProblemCodeValidationFailed {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, putResBodyZeroLen.Code)
+	}
+
+	if putResOverLen.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, putResOverLen.StatusCode)
+	}
+
+	putResBodyOverLen, err := rch.AsProblem(putResOverLen)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if putResBodyOverLen.Code != stockClient.ProblemCodeValidationFailed {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, putResBodyOverLen.Code)
+	}
+}
@@ -0,0 +1,40 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetStockLocationAncestors handles the HTTP GET request for listing the path from the root down to a stock location.
+func GetStockLocationAncestors(ctx echo.Context, repository domain.IRepository, stockLocationId openapi_types.UUID) error {
+	// Precondition
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Main Process
+	reqDto := &app.ListAncestorsRequestDto{
+		Id: stockLocationId,
+	}
+	resDto, err := app.ListAncestors(ctx.Request().Context(), reqDto, repository)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.StockLocations{
+		Items: make([]oapicodegen.StockLocation, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		res.Items = append(res.Items, newStockLocation(item))
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
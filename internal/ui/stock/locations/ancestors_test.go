@@ -0,0 +1,71 @@
+package locations_test
+
+import (
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestAncestorsOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	root := restoreAggregate(t, uuid.New(), uuid.NewString(), false, 1)
+	parent := restoreChild(t, uuid.New(), root, 1)
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(true, nil)
+	repository.EXPECT().Ancestors(gomock.Any(), gomock.Any()).Return([]*location.Aggregate{root, parent}, nil)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	ancestorsRes := rh.Ancestors(id)
+	defer ancestorsRes.Body.Close()
+
+	// Then
+	if ancestorsRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, ancestorsRes.StatusCode)
+	}
+
+	ancestorsResBody, err := rch.AsStockLocations(ancestorsRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := ancestorsResBody.Items
+	if len(items) != 2 || items[0].Id != root.Id.UUID() || items[1].Id != parent.Id.UUID() {
+		t.Errorf("%+v, want %s and %s", items, root.Id.UUID(), parent.Id.UUID())
+	}
+}
+
+func TestAncestorsNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(false, nil)
+	repository.EXPECT().Ancestors(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	ancestorsRes := rh.Ancestors(uuid.New())
+	defer ancestorsRes.Body.Close()
+
+	// Then
+	if ancestorsRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, ancestorsRes.StatusCode)
+	}
+}
@@ -0,0 +1,46 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetStockLocationBalances handles the HTTP GET request for listing the quantities on hand in a stock location
+// and every location below it.
+func GetStockLocationBalances(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockLocationId openapi_types.UUID) error {
+	// Precondition
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Main Process
+	reqDto := &app.ListBalancesRequestDto{
+		Id: stockLocationId,
+	}
+	resDto, err := app.ListBalances(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.StockBalances{
+		Items: make([]oapicodegen.StockBalance, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		res.Items = append(res.Items, oapicodegen.StockBalance{
+			ItemId:     item.ItemId,
+			LocationId: stockLocationId,
+			Quantity:   item.Quantity,
+		})
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
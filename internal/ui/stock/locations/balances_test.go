@@ -0,0 +1,87 @@
+package locations_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestBalancesOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := restoreAggregate(t, uuid.New(), uuid.NewString(), false, 1)
+	child := restoreChild(t, uuid.New(), a, 1)
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Find(gomock.Any(), a.Id).Return(true, nil)
+	repository.EXPECT().Descendants(gomock.Any(), a.Id).Return([]*location.Aggregate{child}, nil)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rh.movements.EXPECT().ListBalances(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, q movement.BalanceQuery) ([]movement.Balance, error) {
+			if len(q.LocationIds) != 2 {
+				t.Errorf("%T %+v want %s and %s", q, q, a.Id.UUID(), child.Id.UUID())
+			}
+			return []movement.Balance{
+				{ItemId: itemId, LocationId: a.Id, Quantity: 2},
+				{ItemId: itemId, LocationId: child.Id, Quantity: 3},
+			}, nil
+		},
+	)
+	rch := ResponseConvertHelper{}
+
+	// When
+	balancesRes := rh.Balances(a.Id.UUID())
+	defer balancesRes.Body.Close()
+
+	// Then
+	if balancesRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, balancesRes.StatusCode)
+	}
+
+	balancesResBody, err := rch.AsStockBalances(balancesRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := balancesResBody.Items
+	if len(items) != 1 || items[0].ItemId != itemId.UUID() || items[0].LocationId != a.Id.UUID() || items[0].Quantity != 5 {
+		t.Errorf("%+v, want 5 of %s in %s", items, itemId.UUID(), a.Id.UUID())
+	}
+}
+
+func TestBalancesNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	balancesRes := rh.Balances(uuid.New())
+	defer balancesRes.Body.Close()
+
+	// Then
+	if balancesRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, balancesRes.StatusCode)
+	}
+}
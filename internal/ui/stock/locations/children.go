@@ -0,0 +1,58 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetStockLocationChildren handles the HTTP GET request for listing the children of a stock location page by page.
+func GetStockLocationChildren(ctx echo.Context, repository domain.IRepository, stockLocationId openapi_types.UUID, params oapicodegen.GetStockLocationChildrenParams) error {
+	// Precondition
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	reqDto := &app.ListRequestDto{
+		Limit:    app.DefaultListLimit,
+		ParentId: &stockLocationId,
+		Order:    string(oapicodegen.GetStockLocationChildrenParamsOrderAsc),
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > app.MaxListLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and 100")
+		}
+		reqDto.Limit = *params.Limit
+	}
+
+	if params.Order != nil {
+		if *params.Order != oapicodegen.GetStockLocationChildrenParamsOrderAsc && *params.Order != oapicodegen.GetStockLocationChildrenParamsOrderDesc {
+			return echo.NewHTTPError(http.StatusBadRequest, "order must be asc or desc")
+		}
+		reqDto.Order = string(*params.Order)
+	}
+
+	if params.After != nil {
+		reqDto.After = *params.After
+	}
+
+	if params.IncludeDeleted != nil {
+		reqDto.IncludeDeleted = *params.IncludeDeleted
+	}
+
+	// Main Process
+	resDto, err := app.List(ctx.Request().Context(), reqDto, repository)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	return ctx.JSON(http.StatusOK, newStockLocations(resDto))
+}
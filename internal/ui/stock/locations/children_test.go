@@ -0,0 +1,81 @@
+package locations_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestChildrenOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parent := restoreAggregate(t, uuid.New(), uuid.NewString(), false, 1)
+	child := restoreChild(t, uuid.New(), parent, 1)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Find(gomock.Any(), parent.Id).Return(true, nil)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+			if q.ParentId == nil || *q.ParentId != parent.Id {
+				t.Errorf("%T %+v want children of %s", q, q, parent.Id.UUID())
+			}
+			return []*location.Aggregate{child}, nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	childrenRes := rh.Children(parent.Id.UUID())
+	defer childrenRes.Body.Close()
+
+	// Then
+	if childrenRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, childrenRes.StatusCode)
+	}
+
+	childrenResBody, err := rch.AsStockLocations(childrenRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(childrenResBody.Items) != 1 || childrenResBody.Items[0].Id != child.Id.UUID() {
+		t.Fatalf("%+v, want %s", childrenResBody.Items, child.Id.UUID())
+	}
+
+	if p := childrenResBody.Items[0].ParentId; p == nil || *p != parent.Id.UUID() {
+		t.Errorf("%T %+v want %+v", p, p, parent.Id.UUID())
+	}
+}
+
+func TestChildrenNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Find(gomock.Any(), gomock.Any()).Return(false, nil)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	childrenRes := rh.Children(uuid.New())
+	defer childrenRes.Body.Close()
+
+	// Then
+	if childrenRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, childrenRes.StatusCode)
+	}
+}
@@ -8,52 +8,39 @@ import (
 
 	app "openapi/internal/app/stock/location"
 	domain "openapi/internal/domain/stock/location"
-	"openapi/internal/infra/database"
-	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/etag"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // Delete is a function that handles the HTTP DELETE request for deleting an existing stock item.
-func DeleteStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error {
-	// Preprocess
-	db, err := database.Open()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	defer db.Close()
-
-	repository, err := infra.NewRepository(db)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-
+func DeleteStockLocation(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockLocationId openapi_types.UUID, params oapicodegen.DeleteStockLocationParams) error {
 	// Prepcondition
 	if stockLocationId == uuid.Nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid stock location id")
 	}
 
-	id, err := domain.NewId(stockLocationId)
-	if err != nil {
+	if _, err := domain.NewId(stockLocationId); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	found, err := repository.Find(id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	if !found {
-		return echo.NewHTTPError(http.StatusNotFound, "stock location not found")
-	}
-
 	// Main Process
 	reqDto := &app.DeleteRequestDto{
 		Id: stockLocationId,
 	}
-	if err := app.Delete(reqDto, repository); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+	if params.IfMatch != nil {
+		reqDto.Versions = etag.ParseIfMatch(*params.IfMatch)
+	}
+	if params.Cascade != nil {
+		reqDto.Cascade = *params.Cascade
+	}
+	err := app.Delete(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
 	}
 
 	// Postprocess
-	return ctx.JSON(http.StatusOK, nil)
+	return ctx.NoContent(http.StatusOK)
 }
@@ -1,48 +1,112 @@
 package locations_test
 
 import (
-	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"context"
+	"net/http"
 	"testing"
 
-	_ "github.com/lib/pq"
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	stockClient "openapi/pkg/client/stock"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
-
-	"net/http"
 )
 
 func TestDeleteOk(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
-	}
-	rch := ResponseConvertHelper{}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
 
 	// Given
-	postRes, err := rh.Post(
-		&oapicodegen.PostStockLocationJSONRequestBody{
-			Name: uuid.NewString(),
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 1), nil)
+	repository.EXPECT().Descendants(gomock.Any(), gomock.Any()).Return([]*location.Aggregate{}, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if !a.IsDeleted() {
+				t.Errorf("%T %+v want deleted", a, a)
+			}
+			return nil
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer postRes.Body.Close()
 
-	if postRes.StatusCode != http.StatusCreated {
-		t.Fatalf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	deleteRes := rh.Delete(id, "")
+	defer deleteRes.Body.Close()
+
+	// Then
+	if deleteRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, deleteRes.StatusCode)
 	}
+}
 
-	postResBody, err := rch.AsCreated(postRes)
-	if err != nil {
-		t.Fatal(err)
+func TestDeleteNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	deleteRes := rh.Delete(uuid.New(), "")
+	defer deleteRes.Body.Close()
+
+	// Then
+	if deleteRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, deleteRes.StatusCode)
 	}
+}
+
+func TestDeletePreconditionFailed(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 2), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
 
 	// When
-	deleteRes, err := rh.Delete(postResBody.Id)
-	if err != nil {
-		t.Fatal(err)
+	deleteRes := rh.Delete(id, `"1"`)
+	defer deleteRes.Body.Close()
+
+	// Then
+	if deleteRes.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("want %d, got %d", http.StatusPreconditionFailed, deleteRes.StatusCode)
 	}
+}
+
+func TestDeleteAlreadyDeleted(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), true, 2), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	deleteRes := rh.Delete(id, `"2"`)
 	defer deleteRes.Body.Close()
 
 	// Then
@@ -51,20 +115,72 @@ func TestDeleteOk(t *testing.T) {
 	}
 }
 
-func TestDeleteNotFound(t *testing.T) {
+func TestDeleteConflict(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	a := restoreAggregate(t, id, uuid.NewString(), false, 1)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
+	repository.EXPECT().Descendants(gomock.Any(), gomock.Any()).Return([]*location.Aggregate{restoreChild(t, uuid.New(), a, 1)}, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	deleteRes := rh.Delete(id, "")
+	defer deleteRes.Body.Close()
+
+	// Then
+	if deleteRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, deleteRes.StatusCode)
 	}
 
-	deleteRes, err := rh.Delete(uuid.New())
+	deleteResBody, err := rch.AsProblem(deleteRes)
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	if deleteResBody.Code != stockClient.ProblemCodeStockLocationHasChildren {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeStockLocationHasChildren, deleteResBody.Code)
+	}
+}
+
+func TestDeleteCascadeOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	a := restoreAggregate(t, id, uuid.NewString(), false, 1)
+	child := restoreChild(t, uuid.New(), a, 1)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(a, nil)
+	repository.EXPECT().Descendants(gomock.Any(), gomock.Any()).Return([]*location.Aggregate{child}, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if !a.IsDeleted() {
+				t.Errorf("%T %+v want deleted", a, a)
+			}
+			return nil
+		},
+	).Times(2)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	deleteRes := rh.DeleteCascade(id)
 	defer deleteRes.Body.Close()
 
 	// Then
-	if deleteRes.StatusCode != http.StatusNotFound {
-		t.Errorf("want %d, got %d", http.StatusNotFound, deleteRes.StatusCode)
+	if deleteRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, deleteRes.StatusCode)
 	}
 }
@@ -0,0 +1,35 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/ui/etag"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetStockLocation is a function that handles the HTTP GET request for reading an existing stock location.
+func GetStockLocation(ctx echo.Context, repository domain.IRepository, stockLocationId openapi_types.UUID) error {
+	// Precondition
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Main Process
+	reqDto := &app.GetRequestDto{
+		Id: stockLocationId,
+	}
+	resDto, err := app.Get(ctx.Request().Context(), reqDto, repository)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := newStockLocation(resDto)
+	ctx.Response().Header().Set("ETag", etag.Format(resDto.Version))
+	return ctx.JSON(http.StatusOK, &res)
+}
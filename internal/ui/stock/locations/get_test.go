@@ -0,0 +1,79 @@
+package locations_test
+
+import (
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestGetOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	name := uuid.NewString()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, name, false, 3), nil)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	getRes := rh.Get(id)
+	defer getRes.Body.Close()
+
+	// Then
+	if getRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, getRes.StatusCode)
+	}
+
+	if etag := getRes.Header.Get("ETag"); etag != `"3"` {
+		t.Errorf("want %s, got %s", `"3"`, etag)
+	}
+
+	getResBody, err := rch.AsStockLocation(getRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if getResBody.Id != id {
+		t.Errorf("want %s, got %s", id, getResBody.Id)
+	}
+
+	if getResBody.Name != name {
+		t.Errorf("want %s, got %s", name, getResBody.Name)
+	}
+
+	if getResBody.Deleted {
+		t.Errorf("want %t, got %t", false, getResBody.Deleted)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	getRes := rh.Get(uuid.New())
+	defer getRes.Body.Close()
+
+	// Then
+	if getRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, getRes.StatusCode)
+	}
+}
@@ -0,0 +1,106 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	"openapi/internal/domain/stock/location"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// GetStockLocations is a function that handles the HTTP GET request for listing stock locations page by page.
+func GetStockLocations(ctx echo.Context, repository location.IRepository, params oapicodegen.GetStockLocationsParams) error {
+	// Precondition
+	reqDto := &app.ListRequestDto{
+		Limit: app.DefaultListLimit,
+		Order: string(oapicodegen.GetStockLocationsParamsOrderAsc),
+	}
+
+	if params.Limit != nil {
+		if *params.Limit < 1 || *params.Limit > app.MaxListLimit {
+			return echo.NewHTTPError(http.StatusBadRequest, "limit must be between 1 and 100")
+		}
+		reqDto.Limit = *params.Limit
+	}
+
+	if params.Order != nil {
+		if *params.Order != oapicodegen.GetStockLocationsParamsOrderAsc && *params.Order != oapicodegen.GetStockLocationsParamsOrderDesc {
+			return echo.NewHTTPError(http.StatusBadRequest, "order must be asc or desc")
+		}
+		reqDto.Order = string(*params.Order)
+	}
+
+	if params.After != nil {
+		reqDto.After = *params.After
+	}
+
+	if params.NamePrefix != nil {
+		reqDto.NamePrefix = *params.NamePrefix
+	}
+
+	if params.Type != nil {
+		reqDto.Type = string(*params.Type)
+	}
+
+	if params.IncludeDeleted != nil {
+		reqDto.IncludeDeleted = *params.IncludeDeleted
+	}
+
+	// Main Process
+	resDto, err := app.List(ctx.Request().Context(), reqDto, repository)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	return ctx.JSON(http.StatusOK, newStockLocations(resDto))
+}
+
+func newStockLocations(resDto *app.ListResponseDto) *oapicodegen.StockLocations {
+	res := &oapicodegen.StockLocations{
+		Items: make([]oapicodegen.StockLocation, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		res.Items = append(res.Items, newStockLocation(item))
+	}
+	if resDto.NextCursor != "" {
+		res.NextCursor = &resDto.NextCursor
+	}
+	return res
+}
+
+func newStockLocation(dto *app.GetResponseDto) oapicodegen.StockLocation {
+	return oapicodegen.StockLocation{
+		Id:                 dto.Id,
+		Name:               dto.Name,
+		Type:               oapicodegen.StockLocationType(dto.Type),
+		Capacity:           newStockLocationCapacity(dto.Capacity),
+		ParentId:           dto.ParentId,
+		Deleted:            dto.Deleted,
+		AllowNegativeStock: dto.AllowNegativeStock,
+	}
+}
+
+func newStockLocationCapacity(dto *app.CapacityDto) *oapicodegen.StockLocationCapacity {
+	if dto == nil {
+		return nil
+	}
+	return &oapicodegen.StockLocationCapacity{
+		MaxUnits:  dto.MaxUnits,
+		MaxVolume: dto.MaxVolume,
+		MaxWeight: dto.MaxWeight,
+	}
+}
+
+func newCapacityDto(req *oapicodegen.StockLocationCapacity) *app.CapacityDto {
+	if req == nil {
+		return nil
+	}
+	return &app.CapacityDto{
+		MaxUnits:  req.MaxUnits,
+		MaxVolume: req.MaxVolume,
+		MaxWeight: req.MaxWeight,
+	}
+}
@@ -0,0 +1,172 @@
+package locations_test
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestListOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	prefix := uuid.NewString()
+	as := []*location.Aggregate{
+		restoreAggregate(t, uuid.New(), prefix+"a", false, 1),
+		restoreAggregate(t, uuid.New(), prefix+"c", false, 1),
+	}
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	gomock.InOrder(
+		repository.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+				if q.NamePrefix != prefix || q.IncludeDeleted || q.After != nil || q.Limit != 2 {
+					t.Errorf("%T %+v", q, q)
+				}
+				return as, nil
+			},
+		),
+		repository.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+				if q.After == nil || q.After.Id != as[0].Id {
+					t.Errorf("%T %+v want after %s", q, q, as[0].Id.UUID())
+				}
+				return as[1:], nil
+			},
+		),
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	firstRes := rh.List(url.Values{"name_prefix": {prefix}, "limit": {"1"}})
+	defer firstRes.Body.Close()
+
+	firstResBody, err := rch.AsStockLocations(firstRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if firstResBody.NextCursor == nil {
+		t.Fatalf("expected next cursor, actual nil")
+	}
+
+	secondRes := rh.List(url.Values{"name_prefix": {prefix}, "limit": {"1"}, "after": {*firstResBody.NextCursor}})
+	defer secondRes.Body.Close()
+
+	secondResBody, err := rch.AsStockLocations(secondRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Then
+	if firstRes.StatusCode != http.StatusOK || secondRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d and %d", http.StatusOK, firstRes.StatusCode, secondRes.StatusCode)
+	}
+
+	if len(firstResBody.Items) != 1 || firstResBody.Items[0].Id != as[0].Id.UUID() {
+		t.Errorf("want %s, got %+v", as[0].Id.UUID(), firstResBody.Items)
+	}
+
+	if len(secondResBody.Items) != 1 || secondResBody.Items[0].Id != as[1].Id.UUID() {
+		t.Errorf("want %s, got %+v", as[1].Id.UUID(), secondResBody.Items)
+	}
+
+	if secondResBody.NextCursor != nil {
+		t.Errorf("expected nil, actual %s", *secondResBody.NextCursor)
+	}
+}
+
+func TestListBadRequest(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	for _, query := range []url.Values{
+		{"limit": {"0"}},
+		{"limit": {"101"}},
+		{"order": {"random"}},
+		{"after": {"invalid"}},
+	} {
+		// When
+		listRes := rh.List(query)
+		defer listRes.Body.Close()
+
+		// Then
+		if listRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: want %d, got %d", query.Encode(), http.StatusBadRequest, listRes.StatusCode)
+		}
+	}
+}
+
+func TestListOkByType(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	a := restoreAggregate(t, uuid.New(), uuid.NewString(), false, 1)
+	a.Type = location.Bin
+	units := int64(10)
+	capacity, err := location.NewCapacity(&units, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a.Capacity = capacity
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, q location.ListQuery) ([]*location.Aggregate, error) {
+			if q.Type == nil || *q.Type != location.Bin {
+				t.Errorf("%T %+v want type %s", q, q, location.Bin)
+			}
+			return []*location.Aggregate{a}, nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	listRes := rh.List(url.Values{"type": {"bin"}})
+	defer listRes.Body.Close()
+
+	badRes := rh.List(url.Values{"type": {"shelf"}})
+	defer badRes.Body.Close()
+
+	// Then
+	if listRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, listRes.StatusCode)
+	}
+
+	listResBody, err := rch.AsStockLocations(listRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	items := listResBody.Items
+	if len(items) != 1 || items[0].Type != stockClient.Bin || items[0].Capacity == nil || *items[0].Capacity.MaxUnits != units {
+		t.Errorf("%+v, want a bin of %d units", items, units)
+	}
+
+	if badRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, badRes.StatusCode)
+	}
+}
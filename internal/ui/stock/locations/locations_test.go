@@ -1,89 +1,171 @@
 package locations_test
 
 import (
-	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"openapi/internal/infra/env"
-	oapicodegen "openapi/internal/infra/oapicodegen/stock"
-
+	"net/url"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	mock_movement "openapi/internal/infra/mock/domain/stock/movement"
+	mock_serial "openapi/internal/infra/mock/domain/stock/serial"
+	mock_transaction "openapi/internal/infra/mock/domain/stock/transaction"
+	mock_transfer "openapi/internal/infra/mock/domain/stock/transfer"
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
 )
 
+// adminToken is the admin token of the stock API served in process.
+const adminToken = stocktest.AdminToken
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over mocked
+// repositories, as many cases start from stored states the API cannot be brought into on demand, such as
+// a version changed between the read and the save.
 type RequestHelper struct {
-	client *http.Client
+	t      gomock.TestHelper
+	client *stockClient.ClientWithResponses
+	// movements, transfers and serials are the repositories of the stock API, which expect no calls until told to.
+	movements *mock_movement.MockIRepository
+	transfers *mock_transfer.MockIRepository
+	serials   *mock_serial.MockIRepository
 }
 
-func (h *RequestHelper) Post(reqBody *oapicodegen.PostStockLocationJSONRequestBody) (*http.Response, error) {
-	reqBodyJson, _ := json.Marshal(reqBody)
-	req, err := http.NewRequest(
-		http.MethodPost,
-		env.GetServiceUrl()+"/stock/locations",
-		bytes.NewBuffer(reqBodyJson),
-	)
-	if err != nil {
-		return nil, err
+// newRequestHelper returns a request helper whose stock API reads and writes stock locations through repository
+// and takes the ids of new stock locations from newId.
+func newRequestHelper(ctrl *gomock.Controller, repository *mock.MockIRepository, newId func() uuid.UUID) *RequestHelper {
+	movements := mock_movement.NewMockIRepository(ctrl)
+	transfers := mock_transfer.NewMockIRepository(ctrl)
+	serials := mock_serial.NewMockIRepository(ctrl)
+
+	repositories := mock_transaction.NewMockIRepositories(ctrl)
+	repositories.EXPECT().Location().Return(repository).AnyTimes()
+	repositories.EXPECT().Movement().Return(movements).AnyTimes()
+	repositories.EXPECT().Transfer().Return(transfers).AnyTimes()
+	repositories.EXPECT().Serial().Return(serials).AnyTimes()
+
+	unitOfWork := mock_transaction.NewMockIUnitOfWork(ctrl)
+	unitOfWork.EXPECT().Do(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, fn func(r transaction.IRepositories) error) error {
+			return fn(repositories)
+		},
+	).AnyTimes()
+
+	return &RequestHelper{
+		t:         ctrl.T,
+		client:    stocktest.NewClient(ctrl.T, repositories, unitOfWork, newId),
+		movements: movements,
+		transfers: transfers,
+		serials:   serials,
 	}
+}
+
+// must returns res, failing the test when the request could not be sent.
+func (h *RequestHelper) must(res *http.Response, err error) *http.Response {
+	h.t.Helper()
 
-	req.Header.Set("Content-Type", "application/json")
-	res, err := h.client.Do(req)
 	if err != nil {
-		return nil, err
+		h.t.Fatalf("%v", err)
 	}
+	return res
+}
 
-	return res, nil
+func (h *RequestHelper) Get(stockLocationsId uuid.UUID) *http.Response {
+	return h.must(h.client.ClientInterface.GetStockLocation(context.Background(), stockLocationsId))
 }
 
-func (h *RequestHelper) Put(stockLocationsId uuid.UUID, reqBody *oapicodegen.PutStockLocationJSONRequestBody) (*http.Response, error) {
-	reqBodyJson, _ := json.Marshal(reqBody)
-	req, err := http.NewRequest(
-		http.MethodPut,
-		env.GetServiceUrl()+"/stock/locations/"+stockLocationsId.String(),
-		bytes.NewBuffer(reqBodyJson),
-	)
-	if err != nil {
-		return nil, err
+// List sends query as is, so that tests can send values the typed parameters cannot hold.
+func (h *RequestHelper) List(query url.Values) *http.Response {
+	withQuery := func(_ context.Context, req *http.Request) error {
+		req.URL.RawQuery = query.Encode()
+		return nil
 	}
+	return h.must(h.client.ClientInterface.GetStockLocations(context.Background(), &stockClient.GetStockLocationsParams{}, withQuery))
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	res, err := h.client.Do(req)
-	if err != nil {
-		return nil, err
+func (h *RequestHelper) Post(reqBody *stockClient.PostStockLocationJSONRequestBody) *http.Response {
+	return h.must(h.client.ClientInterface.PostStockLocation(context.Background(), *reqBody))
+}
+
+func (h *RequestHelper) Put(stockLocationsId uuid.UUID, ifMatch string, reqBody *stockClient.PutStockLocationJSONRequestBody) *http.Response {
+	params := &stockClient.PutStockLocationParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
 	}
+	return h.must(h.client.ClientInterface.PutStockLocation(context.Background(), stockLocationsId, params, *reqBody))
+}
 
-	return res, nil
+func (h *RequestHelper) Delete(stockLocationsId uuid.UUID, ifMatch string) *http.Response {
+	params := &stockClient.DeleteStockLocationParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
+	}
+	return h.must(h.client.ClientInterface.DeleteStockLocation(context.Background(), stockLocationsId, params))
 }
 
-func (h *RequestHelper) Delete(stockLocationsId uuid.UUID) (*http.Response, error) {
-	req, err := http.NewRequest(
-		http.MethodDelete,
-		env.GetServiceUrl()+"/stock/locations/"+stockLocationsId.String(),
-		nil,
-	)
-	if err != nil {
-		return nil, err
+// DeleteCascade deletes the stock location along with its active descendants.
+func (h *RequestHelper) DeleteCascade(stockLocationsId uuid.UUID) *http.Response {
+	cascade := true
+	params := &stockClient.DeleteStockLocationParams{Cascade: &cascade}
+	return h.must(h.client.ClientInterface.DeleteStockLocation(context.Background(), stockLocationsId, params))
+}
+
+func (h *RequestHelper) Move(stockLocationsId uuid.UUID, ifMatch string, reqBody *stockClient.MoveStockLocationJSONRequestBody) *http.Response {
+	params := &stockClient.MoveStockLocationParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
 	}
+	return h.must(h.client.ClientInterface.MoveStockLocation(context.Background(), stockLocationsId, params, *reqBody))
+}
 
-	req.Header.Set("Content-Type", "application/json")
-	res, err := h.client.Do(req)
-	if err != nil {
-		return nil, err
+func (h *RequestHelper) Children(stockLocationsId uuid.UUID) *http.Response {
+	return h.must(h.client.ClientInterface.GetStockLocationChildren(context.Background(), stockLocationsId, &stockClient.GetStockLocationChildrenParams{}))
+}
+
+func (h *RequestHelper) Ancestors(stockLocationsId uuid.UUID) *http.Response {
+	return h.must(h.client.ClientInterface.GetStockLocationAncestors(context.Background(), stockLocationsId))
+}
+
+func (h *RequestHelper) Balances(stockLocationsId uuid.UUID) *http.Response {
+	return h.must(h.client.ClientInterface.GetStockLocationBalances(context.Background(), stockLocationsId))
+}
+
+func (h *RequestHelper) Restore(stockLocationsId uuid.UUID, ifMatch string) *http.Response {
+	params := &stockClient.RestoreStockLocationParams{}
+	if ifMatch != "" {
+		params.IfMatch = &ifMatch
 	}
+	return h.must(h.client.ClientInterface.RestoreStockLocation(context.Background(), stockLocationsId, params))
+}
 
-	return res, nil
+// Purge sends token as the bearer token, or no credentials when it is empty.
+func (h *RequestHelper) Purge(stockLocationsId uuid.UUID, token string) *http.Response {
+	withToken := func(_ context.Context, req *http.Request) error {
+		if token != "" {
+			req.Header.Set(echo.HeaderAuthorization, "Bearer "+token)
+		}
+		return nil
+	}
+	return h.must(h.client.ClientInterface.PurgeStockLocation(context.Background(), stockLocationsId, withToken))
 }
 
 type ResponseConvertHelper struct{}
 
-func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*oapicodegen.Created, error) {
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
 	resBodyByte, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	resBody := &oapicodegen.Created{}
+	resBody := &stockClient.Created{}
 	json.Unmarshal(resBodyByte, &resBody)
 	if resBody.Id == uuid.Nil {
 		return nil, fmt.Errorf("expected not empty, actual empty")
@@ -91,14 +173,82 @@ func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*oapicodegen.Crea
 	return resBody, nil
 }
 
-func (h *ResponseConvertHelper) AsBadRequest(res *http.Response) (*oapicodegen.BadRequestResponse, error) {
+func (h *ResponseConvertHelper) AsStockLocation(res *http.Response) (*stockClient.StockLocation, error) {
 	resBodyByte, err := io.ReadAll(res.Body)
 	if err != nil {
 		return nil, err
 	}
 
-	resBody := &oapicodegen.BadRequest{}
-	json.Unmarshal(resBodyByte, &resBody)
+	resBody := &stockClient.StockLocation{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
 
+func (h *ResponseConvertHelper) AsStockLocations(res *http.Response) (*stockClient.StockLocations, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockLocations{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsStockBalances(res *http.Response) (*stockClient.StockBalances, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockBalances{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsProblem(res *http.Response) (*stockClient.Problem, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Problem{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
 	return resBody, nil
 }
+
+// restoreAggregate returns a stock location as the repository would read it.
+func restoreAggregate(t *testing.T, id uuid.UUID, name string, deleted bool, version int64) *location.Aggregate {
+	t.Helper()
+
+	locationId, err := location.NewId(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationName, err := location.NewName(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return location.RestoreAggregate(locationId, locationName, location.Warehouse, location.Capacity{}, nil, deleted, false, version)
+}
+
+// restoreChild returns an active stock location under parent as the repository would read it.
+func restoreChild(t *testing.T, id uuid.UUID, parent *location.Aggregate, version int64) *location.Aggregate {
+	t.Helper()
+
+	a := restoreAggregate(t, id, uuid.NewString(), false, version)
+	if err := a.MoveUnder(parent, nil); err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
@@ -0,0 +1,50 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/etag"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// MoveStockLocation handles the HTTP POST request for placing a stock location under another one or at the root.
+func MoveStockLocation(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockLocationId openapi_types.UUID, params oapicodegen.MoveStockLocationParams) error {
+	// Binding
+	req := &oapicodegen.MoveStockLocationJSONRequestBody{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	// Precondition
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	if err := ctx.Validate(req); err != nil {
+		return err
+	}
+
+	// Main Process
+	reqDto := &app.MoveRequestDto{
+		Id:       stockLocationId,
+		ParentId: req.ParentId,
+	}
+	if params.IfMatch != nil {
+		reqDto.Versions = etag.ParseIfMatch(*params.IfMatch)
+	}
+	resDto, err := app.Move(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	ctx.Response().Header().Set("ETag", etag.Format(resDto.Version))
+	return ctx.NoContent(http.StatusOK)
+}
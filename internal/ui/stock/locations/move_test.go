@@ -0,0 +1,115 @@
+package locations_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestMoveOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	parent := restoreAggregate(t, uuid.New(), uuid.NewString(), false, 1)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 1), nil)
+	repository.EXPECT().Get(gomock.Any(), parent.Id).Return(parent, nil)
+	repository.EXPECT().Ancestors(gomock.Any(), parent.Id).Return([]*location.Aggregate{}, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if p := a.ParentId(); p == nil || *p != parent.Id {
+				t.Errorf("%T %+v want %+v", p, p, parent.Id)
+			}
+			*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+			return nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	parentId := parent.Id.UUID()
+	moveRes := rh.Move(id, `"1"`, &stockClient.MoveStockLocationJSONRequestBody{ParentId: &parentId})
+	defer moveRes.Body.Close()
+
+	// Then
+	if moveRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, moveRes.StatusCode)
+	}
+
+	if etag := moveRes.Header.Get("ETag"); etag != `"2"` {
+		t.Errorf("want %s, got %s", `"2"`, etag)
+	}
+}
+
+func TestMoveBadRequest(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	a := restoreAggregate(t, id, uuid.NewString(), false, 1)
+	child := restoreChild(t, uuid.New(), a, 1)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), a.Id).Return(a, nil)
+	repository.EXPECT().Get(gomock.Any(), child.Id).Return(child, nil)
+	repository.EXPECT().Ancestors(gomock.Any(), child.Id).Return([]*location.Aggregate{a}, nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	childId := child.Id.UUID()
+	moveRes := rh.Move(id, "", &stockClient.MoveStockLocationJSONRequestBody{ParentId: &childId})
+	defer moveRes.Body.Close()
+
+	// Then
+	if moveRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, moveRes.StatusCode)
+	}
+
+	moveResBody, err := rch.AsProblem(moveRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if moveResBody.Code != stockClient.ProblemCodeStockLocationParentCycle {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeStockLocationParentCycle, moveResBody.Code)
+	}
+}
+
+func TestMoveNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	moveRes := rh.Move(uuid.New(), "", &stockClient.MoveStockLocationJSONRequestBody{})
+	defer moveRes.Body.Close()
+
+	// Then
+	if moveRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, moveRes.StatusCode)
+	}
+}
@@ -7,43 +7,38 @@ import (
 	"github.com/labstack/echo/v4"
 
 	app "openapi/internal/app/stock/location"
-	"openapi/internal/infra/database"
+	"openapi/internal/domain/stock/transaction"
 	oapicodegen "openapi/internal/infra/oapicodegen/stock"
-	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
 )
 
 // PostStockLocation is a function that handles the HTTP POST request for creating a new stock item.
-func PostStockLocation(ctx echo.Context) error {
-	// Preprocess
-	db, err := database.Open()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	defer db.Close()
-
-	repository, err := infra.NewRepository(db)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-
+func PostStockLocation(ctx echo.Context, unitOfWork transaction.IUnitOfWork, newId func() uuid.UUID) error {
 	// Binding
 	req := &oapicodegen.PostStockLocationJSONRequestBody{}
 	if err := ctx.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return err
 	}
 
 	// Precondition
 	if err := ctx.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return err
 	}
 
 	// Main Process
 	reqDto := &app.CreateRequestDto{
-		Name: req.Name,
+		Name:     req.Name,
+		Capacity: newCapacityDto(req.Capacity),
+		ParentId: req.ParentId,
+	}
+	if req.Type != nil {
+		reqDto.Type = string(*req.Type)
+	}
+	if req.AllowNegativeStock != nil {
+		reqDto.AllowNegativeStock = *req.AllowNegativeStock
 	}
-	resDto, err := app.Create(reqDto, repository, uuid.New())
+	resDto, err := app.Create(ctx.Request().Context(), reqDto, unitOfWork, newId())
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	// Postprocess
@@ -51,7 +46,7 @@ func PostStockLocation(ctx echo.Context) error {
 
 	// Postcondition
 	if err := ctx.Validate(res); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
 	}
 	return ctx.JSON(http.StatusCreated, res)
 }
@@ -1,35 +1,48 @@
 package locations_test
 
 import (
-	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"context"
+	"net/http"
 	"strings"
 	"testing"
 
-	_ "github.com/lib/pq"
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	stockClient "openapi/pkg/client/stock"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
-
-	"net/http"
 )
 
 func TestPostCreated(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
-	}
-	rch := ResponseConvertHelper{}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
+	id := uuid.New()
 	name := uuid.NewString()
 
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if a.Id.UUID() != id || a.Name.String() != name {
+				t.Errorf("%T %+v want %s %s", a, a, id, name)
+			}
+			return nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, func() uuid.UUID { return id })
+	rch := ResponseConvertHelper{}
+
 	// When
-	postRes, err := rh.Post(
-		&oapicodegen.PostStockLocationJSONRequestBody{
+	postRes := rh.Post(
+		&stockClient.PostStockLocationJSONRequestBody{
 			Name: name,
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
 	defer postRes.Body.Close()
 
 	// Then
@@ -42,64 +55,202 @@ func TestPostCreated(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if postResBody.Id == uuid.Nil {
-		t.Errorf("expected not empty, actual empty")
+	if postResBody.Id != id {
+		t.Errorf("want %s, got %s", id, postResBody.Id)
 	}
 }
 
 func TestPostBadRequest(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
-	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
 	rch := ResponseConvertHelper{}
 
-	zeroLenName := ""
-	overLenName := strings.Repeat("a", 101)
+	for _, name := range []string{"", strings.Repeat("a", 101)} {
+		// When
+		postRes := rh.Post(
+			&stockClient.PostStockLocationJSONRequestBody{
+				Name: name,
+			},
+		)
+		defer postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("want %d, got %d", http.StatusBadRequest, postRes.StatusCode)
+		}
+
+		postResBody, err := rch.AsProblem(postRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if postResBody.Code != stockClient.ProblemCodeValidationFailed {
+			t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, postResBody.Code)
+		}
+
+		if postResBody.Errors == nil || len(*postResBody.Errors) != 1 || (*postResBody.Errors)[0].Field != "name" {
+			t.Errorf("%T %+v want name", postResBody.Errors, postResBody.Errors)
+		}
+	}
+}
+
+func TestPostConflict(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	name := uuid.NewString()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, uuid.New(), name, false, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
 
 	// When
-	postResZeroLen, err := rh.Post(
-		&oapicodegen.PostStockLocationJSONRequestBody{
-			Name: zeroLenName,
+	postRes := rh.Post(
+		&stockClient.PostStockLocationJSONRequestBody{
+			Name: " " + name,
 		},
 	)
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, postRes.StatusCode)
+	}
+
+	postResBody, err := rch.AsProblem(postRes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	defer postResZeroLen.Body.Close()
 
-	postResOverLen, err := rh.Post(
-		&oapicodegen.PostStockLocationJSONRequestBody{
-			Name: overLenName,
+	if postResBody.Code != stockClient.ProblemCodeStockLocationNameTaken {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeStockLocationNameTaken, postResBody.Code)
+	}
+}
+
+func TestPostBadRequestParentNotAvailable(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	parentId := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	postRes := rh.Post(
+		&stockClient.PostStockLocationJSONRequestBody{
+			Name:     uuid.NewString(),
+			ParentId: &parentId,
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer postResOverLen.Body.Close()
+	defer postRes.Body.Close()
 
 	// Then
-	if postResZeroLen.StatusCode != http.StatusBadRequest {
-		t.Errorf("want %d, got %d", http.StatusBadRequest, postResZeroLen.StatusCode)
+	if postRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, postRes.StatusCode)
 	}
 
-	postResZeroLenBody, err := rch.AsBadRequest(postResZeroLen)
+	postResBody, err := rch.AsProblem(postRes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if postResZeroLenBody.Message == "" {
-		t.Errorf("expected empty, actual %s", postResZeroLenBody.Message)
-	}
 
-	if postResOverLen.StatusCode != http.StatusBadRequest {
-		t.Errorf("want %d, got %d", http.StatusBadRequest, postResOverLen.StatusCode)
+	if postResBody.Code != stockClient.ProblemCodeStockLocationParentNotAvailable {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeStockLocationParentNotAvailable, postResBody.Code)
 	}
+}
 
-	postResOverLenBody, err := rch.AsBadRequest(postResOverLen)
-	if err != nil {
-		t.Fatal(err)
+func TestPostCreatedTypeAndCapacity(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	units, volume := int64(100), int64(50000)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if a.Type != location.Quarantine {
+				t.Errorf("%T %+v want %s", a.Type, a.Type, location.Quarantine)
+			}
+			if u, v := a.Capacity.Units(), a.Capacity.Volume(); u == nil || *u != units || v == nil || v.Int64() != volume || a.Capacity.Weight() != nil {
+				t.Errorf("%T %+v want units %d and volume %d", a.Capacity, a.Capacity, units, volume)
+			}
+			return nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	typ := stockClient.Quarantine
+	postRes := rh.Post(
+		&stockClient.PostStockLocationJSONRequestBody{
+			Name:     uuid.NewString(),
+			Type:     &typ,
+			Capacity: &stockClient.StockLocationCapacity{MaxUnits: &units, MaxVolume: &volume},
+		},
+	)
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusCreated {
+		t.Errorf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
 	}
-	if postResOverLenBody.Message == "" {
-		t.Errorf("expected empty, actual %s", postResOverLenBody.Message)
+}
+
+func TestPostBadRequestTypeAndCapacity(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	zero := int64(0)
+	shelf := stockClient.StockLocationType("shelf")
+	for _, reqBody := range []*stockClient.PostStockLocationJSONRequestBody{
+		{Name: uuid.NewString(), Type: &shelf},
+		{Name: uuid.NewString(), Capacity: &stockClient.StockLocationCapacity{MaxUnits: &zero}},
+	} {
+		// When
+		postRes := rh.Post(reqBody)
+		defer postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("want %d, got %d", http.StatusBadRequest, postRes.StatusCode)
+		}
+
+		postResBody, err := rch.AsProblem(postRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if postResBody.Code != stockClient.ProblemCodeValidationFailed {
+			t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, postResBody.Code)
+		}
 	}
 }
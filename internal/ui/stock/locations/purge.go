@@ -0,0 +1,38 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/ui/admin"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// PurgeStockLocation handles the HTTP POST request for permanently deleting a deleted stock location.
+// Only administrators may call it.
+func PurgeStockLocation(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockLocationId openapi_types.UUID) error {
+	// Precondition
+	if err := admin.Require(ctx); err != nil {
+		return err
+	}
+
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Main Process
+	reqDto := &app.PurgeRequestDto{
+		Id: stockLocationId,
+	}
+	if err := app.Purge(ctx.Request().Context(), reqDto, unitOfWork); err != nil {
+		return err
+	}
+
+	// Postprocess
+	return ctx.NoContent(http.StatusOK)
+}
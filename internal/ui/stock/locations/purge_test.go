@@ -0,0 +1,172 @@
+package locations_test
+
+import (
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/item"
+	"openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/movement"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestPurgeOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), true, 2), nil)
+	repository.EXPECT().List(gomock.Any(), gomock.Any()).Return([]*location.Aggregate{}, nil)
+	repository.EXPECT().Purge(gomock.Any(), gomock.Any()).Return(nil)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rh.movements.EXPECT().ListBalances(gomock.Any(), gomock.Any()).Return([]movement.Balance{}, nil)
+	rh.movements.EXPECT().FindByLocation(gomock.Any(), gomock.Any()).Return(false, nil)
+	rh.transfers.EXPECT().FindByLocation(gomock.Any(), gomock.Any()).Return(false, nil)
+	rh.serials.EXPECT().FindByLocation(gomock.Any(), gomock.Any()).Return(false, nil)
+
+	// When
+	purgeRes := rh.Purge(id, adminToken)
+	defer purgeRes.Body.Close()
+
+	// Then
+	if purgeRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, purgeRes.StatusCode)
+	}
+}
+
+func TestPurgeUnauthorized(t *testing.T) {
+	for _, tt := range []struct {
+		token  string
+		status int
+		code   stockClient.ProblemCode
+	}{
+		{"", http.StatusUnauthorized, stockClient.ProblemCodeUnauthorized},
+		{"Wrong" + adminToken, http.StatusForbidden, stockClient.ProblemCodeForbidden},
+	} {
+		// Setup
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rch := &ResponseConvertHelper{}
+
+		// Given
+		repository := mock.NewMockIRepository(ctrl)
+		repository.EXPECT().Purge(gomock.Any(), gomock.Any()).Times(0)
+
+		rh := newRequestHelper(ctrl, repository, uuid.New)
+
+		// When
+		purgeRes := rh.Purge(uuid.New(), tt.token)
+		defer purgeRes.Body.Close()
+
+		// Then
+		if purgeRes.StatusCode != tt.status {
+			t.Errorf("want %d, got %d", tt.status, purgeRes.StatusCode)
+		}
+
+		purgeResBody, err := rch.AsProblem(purgeRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if purgeResBody.Code != tt.code {
+			t.Errorf("want %s, got %s", tt.code, purgeResBody.Code)
+		}
+	}
+}
+
+func TestPurgeNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Purge(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	purgeRes := rh.Purge(uuid.New(), adminToken)
+	defer purgeRes.Body.Close()
+
+	// Then
+	if purgeRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, purgeRes.StatusCode)
+	}
+}
+
+func TestPurgeConflict(t *testing.T) {
+	itemId, err := item.NewId(uuid.New())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	child := restoreAggregate(t, uuid.New(), uuid.NewString(), true, 2)
+
+	for _, tt := range []struct {
+		deleted  bool
+		children []*location.Aggregate
+		balances []movement.Balance
+		// history is whether the location still has movements, asked only when it has no balances
+		history bool
+		code    stockClient.ProblemCode
+	}{
+		{false, nil, nil, false, stockClient.ProblemCodeStockLocationNotDeleted},
+		{true, []*location.Aggregate{child}, nil, false, stockClient.ProblemCodeStockLocationHasChildren},
+		{true, []*location.Aggregate{}, []movement.Balance{{ItemId: itemId, Quantity: 1}}, false, stockClient.ProblemCodeStockLocationInUse},
+		{true, []*location.Aggregate{}, []movement.Balance{}, true, stockClient.ProblemCodeStockLocationHasHistory},
+	} {
+		// Setup
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rch := &ResponseConvertHelper{}
+
+		id := uuid.New()
+
+		// Given
+		repository := mock.NewMockIRepository(ctrl)
+		repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), tt.deleted, 2), nil)
+		repository.EXPECT().Purge(gomock.Any(), gomock.Any()).Times(0)
+		if tt.children != nil {
+			repository.EXPECT().List(gomock.Any(), gomock.Any()).Return(tt.children, nil)
+		}
+
+		rh := newRequestHelper(ctrl, repository, uuid.New)
+		if tt.balances != nil {
+			rh.movements.EXPECT().ListBalances(gomock.Any(), gomock.Any()).Return(tt.balances, nil)
+		}
+		if tt.history {
+			rh.movements.EXPECT().FindByLocation(gomock.Any(), gomock.Any()).Return(true, nil)
+		}
+
+		// When
+		purgeRes := rh.Purge(id, adminToken)
+		defer purgeRes.Body.Close()
+
+		// Then
+		if purgeRes.StatusCode != http.StatusConflict {
+			t.Errorf("want %d, got %d", http.StatusConflict, purgeRes.StatusCode)
+		}
+
+		purgeResBody, err := rch.AsProblem(purgeRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if purgeResBody.Code != tt.code {
+			t.Errorf("want %s, got %s", tt.code, purgeResBody.Code)
+		}
+	}
+}
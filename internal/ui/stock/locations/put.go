@@ -7,63 +7,51 @@ import (
 
 	app "openapi/internal/app/stock/location"
 	domain "openapi/internal/domain/stock/location"
-	"openapi/internal/infra/database"
-	infra "openapi/internal/infra/repository/sqlboiler/stock/location"
+	"openapi/internal/domain/stock/transaction"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
 	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/etag"
 )
 
 // Put is a function that handles the HTTP PUT request for updating an existing stock location.
-func PutStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error {
-	// Preprocess
-	db, err := database.Open()
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-	defer db.Close()
-
-	repository, err := infra.NewRepository(db)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-
+func PutStockLocation(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockLocationId openapi_types.UUID, params oapicodegen.PutStockLocationParams) error {
 	// Binding
 	req := &oapicodegen.PutStockLocationJSONRequestBody{}
 	if err := ctx.Bind(&req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return err
 	}
 
 	// Precondition
-	id, err := domain.NewId(stockLocationId)
-	if err != nil {
+	if _, err := domain.NewId(stockLocationId); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
 	}
 
-	found, err := repository.Find(id)
-	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
-	}
-
-	if !found {
-		return echo.NewHTTPError(http.StatusNotFound, "stock location not found")
-	}
-
 	if err := ctx.Validate(req); err != nil {
-		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		return err
 	}
 
 	// Main Process
 	reqDto := &app.UpdateRequestDto{
-		Id:   stockLocationId,
-		Name: req.Name,
+		Id:                 stockLocationId,
+		Name:               req.Name,
+		Capacity:           newCapacityDto(req.Capacity),
+		AllowNegativeStock: req.AllowNegativeStock,
+	}
+	if req.Type != nil {
+		typ := string(*req.Type)
+		reqDto.Type = &typ
+	}
+	if params.IfMatch != nil {
+		reqDto.Versions = etag.ParseIfMatch(*params.IfMatch)
 	}
-	err = app.Update(reqDto, repository)
+	resDto, err := app.Update(ctx.Request().Context(), reqDto, unitOfWork)
 	if err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, err.Error())
+		return err
 	}
 
 	// Postprocess
-	return ctx.JSON(http.StatusOK, nil)
+	ctx.Response().Header().Set("ETag", etag.Format(resDto.Version))
+	return ctx.NoContent(http.StatusOK)
 }
@@ -1,82 +1,83 @@
 package locations_test
 
 import (
-	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"context"
+	"net/http"
 	"strings"
 	"testing"
 
-	_ "github.com/lib/pq"
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+	stockClient "openapi/pkg/client/stock"
 
+	"github.com/golang/mock/gomock"
 	"github.com/google/uuid"
-
-	"net/http"
 )
 
 func TestPutOk(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
-	}
-	rch := ResponseConvertHelper{}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	bforeName := uuid.NewString()
+	id := uuid.New()
 	afterName := uuid.NewString()
 
 	// Given
-	postRes, err := rh.Post(
-		&oapicodegen.PostStockLocationJSONRequestBody{
-			Name: bforeName,
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if a.Name.String() != afterName {
+				t.Errorf("%T %+v want %s", a, a, afterName)
+			}
+			*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+			return nil
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer postRes.Body.Close()
 
-	if postRes.StatusCode != http.StatusCreated {
-		t.Fatalf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
-	}
-
-	postResBody, err := rch.AsCreated(postRes)
-	if err != nil {
-		t.Fatal(err)
-	}
+	rh := newRequestHelper(ctrl, repository, uuid.New)
 
 	// When
-	putRes, err := rh.Put(
-		postResBody.Id,
-		&oapicodegen.PutStockLocationJSONRequestBody{
+	putRes := rh.Put(
+		id,
+		`"1"`,
+		&stockClient.PutStockLocationJSONRequestBody{
 			Name: afterName,
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
 	defer putRes.Body.Close()
 
 	// Then
 	if putRes.StatusCode != http.StatusOK {
 		t.Errorf("want %d, got %d", http.StatusOK, putRes.StatusCode)
 	}
+
+	if etag := putRes.Header.Get("ETag"); etag != `"2"` {
+		t.Errorf("want %s, got %s", `"2"`, etag)
+	}
 }
 
 func TestPutNotFound(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
-	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	name := uuid.NewString()
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
 
-	putRes, err := rh.Put(
+	// When
+	putRes := rh.Put(
 		uuid.New(),
-		&oapicodegen.PutStockLocationJSONRequestBody{
-			Name: name,
+		"",
+		&stockClient.PutStockLocationJSONRequestBody{
+			Name: uuid.NewString(),
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
 	defer putRes.Body.Close()
 
 	// Then
@@ -87,79 +88,157 @@ func TestPutNotFound(t *testing.T) {
 
 func TestPutBadRequest(t *testing.T) {
 	// Setup
-	rh := RequestHelper{
-		client: &http.Client{},
-	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
 	rch := ResponseConvertHelper{}
 
-	zeroLenName := ""
-	overLenName := strings.Repeat("a", 101)
+	for _, name := range []string{"", strings.Repeat("a", 101)} {
+		// When
+		putRes := rh.Put(
+			uuid.New(),
+			"",
+			&stockClient.PutStockLocationJSONRequestBody{
+				Name: name,
+			},
+		)
+		defer putRes.Body.Close()
+
+		// Then
+		if putRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("want %d, got %d", http.StatusBadRequest, putRes.StatusCode)
+		}
+
+		putResBody, err := rch.AsProblem(putRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if putResBody.Code != stockClient.ProblemCodeValidationFailed {
+			t.Errorf("want %s, got %s", stockClient.ProblemCodeValidationFailed, putResBody.Code)
+		}
+	}
+}
+
+func TestPutPreconditionFailed(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
 
 	// Given
-	postRes, err := rh.Post(
-		&oapicodegen.PostStockLocationJSONRequestBody{
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 2), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	putRes := rh.Put(
+		id,
+		`"1"`,
+		&stockClient.PutStockLocationJSONRequestBody{
 			Name: uuid.NewString(),
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer postRes.Body.Close()
+	defer putRes.Body.Close()
 
-	if postRes.StatusCode != http.StatusCreated {
-		t.Fatalf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	// Then
+	if putRes.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("want %d, got %d", http.StatusPreconditionFailed, putRes.StatusCode)
 	}
+}
 
-	postResBody, err := rch.AsCreated(postRes)
-	if err != nil {
-		t.Fatal(err)
-	}
+func TestPutConflict(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
 
-	// When
-	putResZeroLen, err := rh.Put(
-		postResBody.Id,
-		&oapicodegen.PutStockLocationJSONRequestBody{
-			Name: zeroLenName,
-		},
-	)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer putResZeroLen.Body.Close()
+	id := uuid.New()
+	taken := uuid.NewString()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 1), nil)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, uuid.New(), taken, false, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
 
-	putResOverLen, err := rh.Put(
-		postResBody.Id,
-		&oapicodegen.PutStockLocationJSONRequestBody{
-			Name: overLenName,
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+	rch := ResponseConvertHelper{}
+
+	// When
+	putRes := rh.Put(
+		id,
+		`"1"`,
+		&stockClient.PutStockLocationJSONRequestBody{
+			Name: taken,
 		},
 	)
-	if err != nil {
-		t.Fatal(err)
-	}
-	defer putResOverLen.Body.Close()
+	defer putRes.Body.Close()
 
 	// Then
-	if putResZeroLen.StatusCode != http.StatusBadRequest {
-		t.Errorf("want %d, got %d", http.StatusBadRequest, putResZeroLen.StatusCode)
+	if putRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, putRes.StatusCode)
 	}
 
-	putResBodyZeroLen, err := rch.AsBadRequest(putResZeroLen)
+	putResBody, err := rch.AsProblem(putRes)
 	if err != nil {
 		t.Fatal(err)
 	}
-	if putResBodyZeroLen.Message == "" {
-		t.Errorf("expected not empty, actual empty")
-	}
 
-	if putResOverLen.StatusCode != http.StatusBadRequest {
-		t.Errorf("want %d, got %d", http.StatusBadRequest, putResOverLen.StatusCode)
+	if putResBody.Code != stockClient.ProblemCodeStockLocationNameTaken {
+		t.Errorf("want %s, got %s", stockClient.ProblemCodeStockLocationNameTaken, putResBody.Code)
 	}
+}
 
-	putResBodyOverLen, err := rch.AsBadRequest(putResOverLen)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if putResBodyOverLen.Message == "" {
-		t.Errorf("expected not empty, actual empty")
+func TestPutOkTypeAndCapacity(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+	name := uuid.NewString()
+	weight := int64(1000000)
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, name, false, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if a.Type != location.Zone {
+				t.Errorf("%T %+v want %s", a.Type, a.Type, location.Zone)
+			}
+			if w := a.Capacity.Weight(); w == nil || w.Int64() != weight || a.Capacity.Units() != nil {
+				t.Errorf("%T %+v want weight %d", a.Capacity, a.Capacity, weight)
+			}
+			*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+			return nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	typ := stockClient.Zone
+	putRes := rh.Put(
+		id,
+		`"1"`,
+		&stockClient.PutStockLocationJSONRequestBody{
+			Name:     name,
+			Type:     &typ,
+			Capacity: &stockClient.StockLocationCapacity{MaxWeight: &weight},
+		},
+	)
+	defer putRes.Body.Close()
+
+	// Then
+	if putRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, putRes.StatusCode)
 	}
 }
@@ -0,0 +1,39 @@
+package locations
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/location"
+	domain "openapi/internal/domain/stock/location"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/etag"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// RestoreStockLocation handles the HTTP POST request for restoring a deleted stock location.
+func RestoreStockLocation(ctx echo.Context, unitOfWork transaction.IUnitOfWork, stockLocationId openapi_types.UUID, params oapicodegen.RestoreStockLocationParams) error {
+	// Precondition
+	if _, err := domain.NewId(stockLocationId); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	// Main Process
+	reqDto := &app.RestoreRequestDto{
+		Id: stockLocationId,
+	}
+	if params.IfMatch != nil {
+		reqDto.Versions = etag.ParseIfMatch(*params.IfMatch)
+	}
+	resDto, err := app.Restore(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	ctx.Response().Header().Set("ETag", etag.Format(resDto.Version))
+	return ctx.NoContent(http.StatusOK)
+}
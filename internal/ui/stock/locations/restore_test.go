@@ -0,0 +1,124 @@
+package locations_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"openapi/internal/domain/stock/location"
+	mock "openapi/internal/infra/mock/domain/stock/location"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+)
+
+func TestRestoreOk(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().FindByName(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), true, 1), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, a *location.Aggregate) error {
+			if a.IsDeleted() {
+				t.Errorf("%T %+v want not deleted", a, a)
+			}
+			*a = *location.RestoreAggregate(a.Id, a.Name, a.Type, a.Capacity, a.ParentId(), a.IsDeleted(), a.AllowNegativeStock, a.Version()+1)
+			return nil
+		},
+	)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	restoreRes := rh.Restore(id, `"1"`)
+	defer restoreRes.Body.Close()
+
+	// Then
+	if restoreRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, restoreRes.StatusCode)
+	}
+
+	if etag := restoreRes.Header.Get("ETag"); etag != `"2"` {
+		t.Errorf("want %s, got %s", `"2"`, etag)
+	}
+}
+
+func TestRestoreNotDeleted(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), false, 3), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	restoreRes := rh.Restore(id, "")
+	defer restoreRes.Body.Close()
+
+	// Then
+	if restoreRes.StatusCode != http.StatusOK {
+		t.Errorf("want %d, got %d", http.StatusOK, restoreRes.StatusCode)
+	}
+
+	if etag := restoreRes.Header.Get("ETag"); etag != `"3"` {
+		t.Errorf("want %s, got %s", `"3"`, etag)
+	}
+}
+
+func TestRestoreNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(nil, location.ErrNotFound)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	restoreRes := rh.Restore(uuid.New(), "")
+	defer restoreRes.Body.Close()
+
+	// Then
+	if restoreRes.StatusCode != http.StatusNotFound {
+		t.Errorf("want %d, got %d", http.StatusNotFound, restoreRes.StatusCode)
+	}
+}
+
+func TestRestorePreconditionFailed(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	id := uuid.New()
+
+	// Given
+	repository := mock.NewMockIRepository(ctrl)
+	repository.EXPECT().Get(gomock.Any(), gomock.Any()).Return(restoreAggregate(t, id, uuid.NewString(), true, 2), nil)
+	repository.EXPECT().Save(gomock.Any(), gomock.Any()).Times(0)
+
+	rh := newRequestHelper(ctrl, repository, uuid.New)
+
+	// When
+	restoreRes := rh.Restore(id, `"1"`)
+	defer restoreRes.Body.Close()
+
+	// Then
+	if restoreRes.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("want %d, got %d", http.StatusPreconditionFailed, restoreRes.StatusCode)
+	}
+}
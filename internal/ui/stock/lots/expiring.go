@@ -0,0 +1,64 @@
+package lots
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/lot"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// DefaultWithin is how many days ahead the expiring lots are listed when the request does not tell.
+const DefaultWithin = 30
+
+// GetStockLotsExpiring handles the HTTP GET request for listing the stock of the lots that expire soon.
+func GetStockLotsExpiring(ctx echo.Context, unitOfWork transaction.IUnitOfWork, now func() time.Time, params oapicodegen.GetStockLotsExpiringParams) error {
+	// Precondition
+	reqDto := &app.ListExpiringRequestDto{
+		Today:  now(),
+		Within: DefaultWithin,
+	}
+
+	if params.Within != nil {
+		v, ok := strings.CutSuffix(*params.Within, "d")
+		days, err := strconv.Atoi(v)
+		if !ok || err != nil || days < 0 {
+			return echo.NewHTTPError(http.StatusBadRequest, "within must be a number of days such as 30d")
+		}
+		reqDto.Within = days
+	}
+
+	// Main Process
+	resDto, err := app.ListExpiring(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.ExpiringStockLots{
+		Items: make([]oapicodegen.ExpiringStockLot, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		l := oapicodegen.ExpiringStockLot{
+			ItemId:     item.ItemId,
+			Lot:        item.Lot,
+			ExpiresOn:  openapi_types.Date{Time: item.ExpiresOn},
+			Expired:    item.Expired,
+			LocationId: item.LocationId,
+			Quantity:   item.Quantity,
+		}
+		if item.ManufacturedOn != nil {
+			l.ManufacturedOn = &openapi_types.Date{Time: *item.ManufacturedOn}
+		}
+		res.Items = append(res.Items, l)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,86 @@
+package lots_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+func TestListExpiringOK(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	for _, r := range []struct {
+		lot      string
+		days     *int
+		quantity int64
+	}{
+		{"EXPIRED", days(-1), 1},
+		{"SOON", days(10), 2},
+		{"LATER", days(40), 4},
+		{"NEVER", nil, 8},
+	} {
+		if err := Receive(rh, &rch, itemId, locationId, r.lot, r.days, r.quantity); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	listRes, err := rh.ListExpiring(url.Values{"within": {"30d"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listRes.Body.Close()
+
+	// Then
+	if listRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, listRes.StatusCode)
+	}
+
+	listResBody, err := rch.AsExpiringStockLots(listRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got := map[string]bool{}
+	for _, l := range listResBody.Items {
+		if l.ItemId != itemId {
+			continue
+		}
+		if l.LocationId != locationId {
+			t.Errorf("want %s, got %s", locationId, l.LocationId)
+		}
+		got[l.Lot] = l.Expired
+	}
+
+	want := map[string]bool{"EXPIRED": true, "SOON": false}
+	if len(got) != len(want) || got["EXPIRED"] != want["EXPIRED"] || got["SOON"] != want["SOON"] {
+		t.Errorf("want %v, got %v", want, got)
+	}
+}
+
+func TestListExpiringBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+
+	for _, within := range []string{"30", "-1d", "30days", "d"} {
+		// When
+		listRes, err := rh.ListExpiring(url.Values{"within": {within}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		listRes.Body.Close()
+
+		// Then
+		if listRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("%s: want %d, got %d", within, http.StatusBadRequest, listRes.StatusCode)
+		}
+	}
+}
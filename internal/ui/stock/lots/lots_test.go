@@ -0,0 +1,155 @@
+package lots_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over an
+// in-memory database of its own.
+type RequestHelper struct {
+	client *stockClient.ClientWithResponses
+}
+
+func newRequestHelper(t *testing.T) *RequestHelper {
+	t.Helper()
+
+	return &RequestHelper{client: stocktest.NewMemoryClient(t)}
+}
+
+// ListExpiring sends query as is, so that tests can send values the typed parameters cannot hold.
+func (h *RequestHelper) ListExpiring(query url.Values) (*http.Response, error) {
+	return h.client.ClientInterface.GetStockLotsExpiring(context.Background(), &stockClient.GetStockLotsExpiringParams{}, withQuery(query))
+}
+
+// Suggest sends query as is, so that tests can send values the typed parameters cannot hold.
+func (h *RequestHelper) Suggest(query url.Values) (*http.Response, error) {
+	return h.client.ClientInterface.GetStockLotSuggestions(context.Background(), &stockClient.GetStockLotSuggestionsParams{}, withQuery(query))
+}
+
+func (h *RequestHelper) PostMovement(reqBody *stockClient.PostStockMovementJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockMovement(context.Background(), *reqBody)
+}
+
+func withQuery(query url.Values) stockClient.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+type ResponseConvertHelper struct{}
+
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Created{}
+	json.Unmarshal(resBodyByte, &resBody)
+	if resBody.Id == uuid.Nil {
+		return nil, fmt.Errorf("expected not empty, actual empty")
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsExpiringStockLots(res *http.Response) (*stockClient.ExpiringStockLots, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.ExpiringStockLots{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsStockLotPicks(res *http.Response) (*stockClient.StockLotPicks, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockLotPicks{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *RequestHelper) create(post func() (*http.Response, error), rch *ResponseConvertHelper) (uuid.UUID, error) {
+	res, err := post()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer res.Body.Close()
+
+	created, err := rch.AsCreated(res)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return created.Id, nil
+}
+
+// Setup creates a lot tracked stock item and a stock location.
+func Setup(rh *RequestHelper, rch *ResponseConvertHelper) (uuid.UUID, uuid.UUID, error) {
+	lotTracked := true
+	itemId, err := rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockItem(context.Background(), stockClient.PostStockItemJSONRequestBody{
+			Sku:        uuid.NewString(),
+			Name:       uuid.NewString(),
+			LotTracked: &lotTracked,
+		})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	locationId, err := rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockLocation(context.Background(), stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return itemId, locationId, nil
+}
+
+// Receive records a receipt of quantity of a new lot that expires in days from today, or never when days is nil.
+func Receive(rh *RequestHelper, rch *ResponseConvertHelper, itemId uuid.UUID, locationId uuid.UUID, lot string, days *int, quantity int64) error {
+	reqBody := &stockClient.PostStockMovementJSONRequestBody{
+		ItemId:     itemId,
+		LocationId: locationId,
+		Kind:       stockClient.Receipt,
+		Quantity:   quantity,
+		Lot:        &lot,
+	}
+	if days != nil {
+		reqBody.ExpiresOn = &openapi_types.Date{Time: time.Now().UTC().AddDate(0, 0, *days)}
+	}
+
+	_, err := rh.create(func() (*http.Response, error) {
+		return rh.PostMovement(reqBody)
+	}, rch)
+	return err
+}
+
+func days(n int) *int {
+	return &n
+}
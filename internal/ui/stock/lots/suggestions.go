@@ -0,0 +1,52 @@
+package lots
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/lot"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+// GetStockLotSuggestions handles the HTTP GET request for suggesting the lots to issue a quantity from,
+// first expired first out.
+func GetStockLotSuggestions(ctx echo.Context, unitOfWork transaction.IUnitOfWork, now func() time.Time, params oapicodegen.GetStockLotSuggestionsParams) error {
+	// Precondition
+	if params.Quantity < 1 {
+		return echo.NewHTTPError(http.StatusBadRequest, "quantity must be positive")
+	}
+
+	// Main Process
+	reqDto := &app.SuggestRequestDto{
+		ItemId:     params.ItemId,
+		LocationId: params.LocationId,
+		Quantity:   params.Quantity,
+		Today:      now(),
+	}
+	resDto, err := app.Suggest(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.StockLotPicks{
+		Items: make([]oapicodegen.StockLotPick, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		p := oapicodegen.StockLotPick{
+			Lot:      item.Lot,
+			Quantity: item.Quantity,
+		}
+		if item.ExpiresOn != nil {
+			p.ExpiresOn = &openapi_types.Date{Time: *item.ExpiresOn}
+		}
+		res.Items = append(res.Items, p)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,114 @@
+package lots_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestSuggestOK(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	for _, r := range []struct {
+		lot      string
+		days     *int
+		quantity int64
+	}{
+		{"EXPIRED", days(-1), 10},
+		{"NEVER", nil, 10},
+		{"LATER", days(60), 2},
+		{"FIRST", days(0), 3},
+	} {
+		if err := Receive(rh, &rch, itemId, locationId, r.lot, r.days, r.quantity); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// When
+	suggestRes, err := rh.Suggest(url.Values{
+		"item_id":     {itemId.String()},
+		"location_id": {locationId.String()},
+		"quantity":    {"6"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer suggestRes.Body.Close()
+
+	// Then
+	if suggestRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d", http.StatusOK, suggestRes.StatusCode)
+	}
+
+	picks, err := rch.AsStockLotPicks(suggestRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		lot      string
+		quantity int64
+	}{{"FIRST", 3}, {"LATER", 2}, {"NEVER", 1}}
+	if len(picks.Items) != len(want) {
+		t.Fatalf("want %v, got %+v", want, picks.Items)
+	}
+
+	for i, p := range picks.Items {
+		if p.Lot != want[i].lot || p.Quantity != want[i].quantity {
+			t.Errorf("want %v, got %+v", want[i], p)
+		}
+	}
+
+	if picks.Items[2].ExpiresOn != nil {
+		t.Errorf("want no expiry, got %v", picks.Items[2].ExpiresOn)
+	}
+}
+
+func TestSuggestFail(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := Receive(rh, &rch, itemId, locationId, "L1", nil, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		query url.Values
+		want  int
+	}{
+		{url.Values{"item_id": {itemId.String()}, "location_id": {locationId.String()}, "quantity": {"3"}}, http.StatusConflict},
+		{url.Values{"item_id": {itemId.String()}, "location_id": {locationId.String()}, "quantity": {"0"}}, http.StatusBadRequest},
+		{url.Values{"item_id": {itemId.String()}, "location_id": {uuid.NewString()}, "quantity": {"1"}}, http.StatusNotFound},
+		{url.Values{"item_id": {uuid.NewString()}, "location_id": {locationId.String()}, "quantity": {"1"}}, http.StatusNotFound},
+		{url.Values{"item_id": {itemId.String()}, "quantity": {"1"}}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		// When
+		suggestRes, err := rh.Suggest(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		suggestRes.Body.Close()
+
+		// Then
+		if suggestRes.StatusCode != tt.want {
+			t.Errorf("%v: want %d, got %d", tt.query, tt.want, suggestRes.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package movements_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+)
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over an
+// in-memory database of its own.
+type RequestHelper struct {
+	client *stockClient.ClientWithResponses
+}
+
+func newRequestHelper(t *testing.T) *RequestHelper {
+	t.Helper()
+
+	return &RequestHelper{client: stocktest.NewMemoryClient(t)}
+}
+
+func (h *RequestHelper) Post(reqBody *stockClient.PostStockMovementJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockMovement(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) PostItem(reqBody *stockClient.PostStockItemJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockItem(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) PostLocation(reqBody *stockClient.PostStockLocationJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockLocation(context.Background(), *reqBody)
+}
+
+type ResponseConvertHelper struct{}
+
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Created{}
+	json.Unmarshal(resBodyByte, &resBody)
+	if resBody.Id == uuid.Nil {
+		return nil, fmt.Errorf("expected not empty, actual empty")
+	}
+	return resBody, nil
+}
+
+// Setup creates a stock item and a stock location to record movements against.
+func Setup(rh *RequestHelper, rch *ResponseConvertHelper, allowNegativeStock bool) (uuid.UUID, uuid.UUID, error) {
+	itemRes, err := rh.PostItem(&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString()})
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	defer itemRes.Body.Close()
+
+	item, err := rch.AsCreated(itemRes)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	locationRes, err := rh.PostLocation(&stockClient.PostStockLocationJSONRequestBody{
+		Name:               uuid.NewString(),
+		AllowNegativeStock: &allowNegativeStock,
+	})
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	defer locationRes.Body.Close()
+
+	location, err := rch.AsCreated(locationRes)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return item.Id, location.Id, nil
+}
@@ -0,0 +1,62 @@
+package movements
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/movement"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// PostStockMovement is a function that handles the HTTP POST request for recording a stock movement.
+func PostStockMovement(ctx echo.Context, unitOfWork transaction.IUnitOfWork, newId func() uuid.UUID) error {
+	// Binding
+	req := &oapicodegen.PostStockMovementJSONRequestBody{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	// Precondition
+	if err := ctx.Validate(req); err != nil {
+		return err
+	}
+
+	// Main Process
+	reqDto := &app.RecordRequestDto{
+		ItemId:     req.ItemId,
+		LocationId: req.LocationId,
+		Kind:       string(req.Kind),
+		Quantity:   req.Quantity,
+	}
+	if req.Unit != nil {
+		reqDto.Unit = *req.Unit
+	}
+	if req.Lot != nil {
+		reqDto.Lot = *req.Lot
+	}
+	if req.ManufacturedOn != nil {
+		reqDto.ManufacturedOn = &req.ManufacturedOn.Time
+	}
+	if req.ExpiresOn != nil {
+		reqDto.ExpiresOn = &req.ExpiresOn.Time
+	}
+	if req.Serials != nil {
+		reqDto.Serials = *req.Serials
+	}
+	resDto, err := app.Record(ctx.Request().Context(), reqDto, unitOfWork, newId)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.Created{Id: resDto.Id}
+
+	// Postcondition
+	if err := ctx.Validate(res); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+	return ctx.JSON(http.StatusCreated, res)
+}
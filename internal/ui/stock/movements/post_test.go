@@ -0,0 +1,305 @@
+package movements_test
+
+import (
+	stockClient "openapi/pkg/client/stock"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+
+	"net/http"
+)
+
+func TestPostCreated(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: locationId,
+			Kind:       stockClient.Receipt,
+			Quantity:   10,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusCreated {
+		t.Errorf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	}
+
+	postResBody, err := rch.AsCreated(postRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if postResBody.Id == uuid.Nil {
+		t.Errorf("expected not empty, actual empty")
+	}
+}
+
+func TestPostBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*stockClient.PostStockMovementJSONRequestBody{
+		{ItemId: itemId, LocationId: locationId, Kind: "transfer", Quantity: 1},
+		{ItemId: itemId, LocationId: locationId, Kind: stockClient.Issue, Quantity: -1},
+		{ItemId: itemId, LocationId: locationId, Kind: stockClient.Adjustment, Quantity: 0},
+		{ItemId: uuid.New(), LocationId: locationId, Kind: stockClient.Receipt, Quantity: 1},
+		{ItemId: itemId, LocationId: uuid.New(), Kind: stockClient.Receipt, Quantity: 1},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := rh.Post(tt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("%+v want %d, got %d", tt, http.StatusBadRequest, postRes.StatusCode)
+		}
+	}
+}
+
+func TestPostCreatedPackUnit(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	packSizes := []stockClient.StockItemPackSize{{Unit: "case", Quantity: 12}}
+	itemRes, err := rh.PostItem(&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString(), PackSizes: &packSizes})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer itemRes.Body.Close()
+
+	item, err := rch.AsCreated(itemRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	locationRes, err := rh.PostLocation(&stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer locationRes.Body.Close()
+
+	location, err := rch.AsCreated(locationRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases, each, pallets := "case", "each", "pallet"
+	tests := []struct {
+		req    *stockClient.PostStockMovementJSONRequestBody
+		status int
+	}{
+		// Given: two cases are received, which are 24 each
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Receipt, Quantity: 2, Unit: &cases}, http.StatusCreated},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Issue, Quantity: 20, Unit: &each}, http.StatusCreated},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Issue, Quantity: 1, Unit: &cases}, http.StatusConflict},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Issue, Quantity: 4}, http.StatusCreated},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: location.Id, Kind: stockClient.Receipt, Quantity: 1, Unit: &pallets}, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := rh.Post(tt.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != tt.status {
+			t.Fatalf("%+v want %d, got %d", tt.req, tt.status, postRes.StatusCode)
+		}
+	}
+}
+
+func TestPostConflictInsufficientQuantity(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: locationId,
+			Kind:       stockClient.Issue,
+			Quantity:   1,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, postRes.StatusCode)
+	}
+}
+
+func TestPostCreatedAllowNegativeStock(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, err := Setup(rh, &rch, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: locationId,
+			Kind:       stockClient.Issue,
+			Quantity:   1,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusCreated {
+		t.Errorf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	}
+}
+
+func TestPostConflictOverCapacity(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, _, err := Setup(rh, &rch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	maxUnits := int64(5)
+	locationRes, err := rh.PostLocation(&stockClient.PostStockLocationJSONRequestBody{
+		Name:     uuid.NewString(),
+		Capacity: &stockClient.StockLocationCapacity{MaxUnits: &maxUnits},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer locationRes.Body.Close()
+
+	location, err := rch.AsCreated(locationRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: location.Id,
+			Kind:       stockClient.Receipt,
+			Quantity:   6,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, postRes.StatusCode)
+	}
+}
+
+func TestPostLot(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	_, locationId, err := Setup(rh, &rch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lotTracked := true
+	itemRes, err := rh.PostItem(&stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString(), LotTracked: &lotTracked})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer itemRes.Body.Close()
+
+	item, err := rch.AsCreated(itemRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	lot, otherLot := "L-"+uuid.NewString()[:8], "L-other"
+	manufacturedOn := openapi_types.Date{Time: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)}
+	expiresOn := openapi_types.Date{Time: time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)}
+	otherExpiresOn := openapi_types.Date{Time: time.Date(2025, 7, 1, 0, 0, 0, 0, time.UTC)}
+
+	tests := []struct {
+		reqBody *stockClient.PostStockMovementJSONRequestBody
+		want    int
+	}{
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: locationId, Kind: stockClient.Receipt, Quantity: 5,
+			Lot: &lot, ManufacturedOn: &manufacturedOn, ExpiresOn: &expiresOn}, http.StatusCreated},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: locationId, Kind: stockClient.Issue, Quantity: 2,
+			Lot: &lot}, http.StatusCreated},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: locationId, Kind: stockClient.Receipt, Quantity: 1},
+			http.StatusBadRequest},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: locationId, Kind: stockClient.Issue, Quantity: 1,
+			Lot: &otherLot}, http.StatusBadRequest},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: locationId, Kind: stockClient.Receipt, Quantity: 1,
+			Lot: &lot, ExpiresOn: &otherExpiresOn}, http.StatusConflict},
+		{&stockClient.PostStockMovementJSONRequestBody{ItemId: item.Id, LocationId: locationId, Kind: stockClient.Issue, Quantity: 4,
+			Lot: &lot}, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := rh.Post(tt.reqBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != tt.want {
+			t.Errorf("%+v: want %d, got %d", tt.reqBody, tt.want, postRes.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package serials
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/serial"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// GetStockSerial handles the HTTP GET request for the units with a serial number and their movement history.
+func GetStockSerial(ctx echo.Context, unitOfWork transaction.IUnitOfWork, serial oapicodegen.StockSerialNumber, params oapicodegen.GetStockSerialParams) error {
+	// Main Process
+	reqDto := &app.GetRequestDto{
+		Number: serial,
+		ItemId: params.ItemId,
+	}
+	resDto, err := app.Get(ctx.Request().Context(), reqDto, unitOfWork)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.StockSerials{
+		Items: make([]oapicodegen.StockSerial, 0, len(resDto.Items)),
+	}
+	for _, item := range resDto.Items {
+		s := oapicodegen.StockSerial{
+			ItemId:     item.ItemId,
+			Serial:     item.Number,
+			LocationId: item.LocationId,
+			Movements:  make([]oapicodegen.StockSerialMovement, 0, len(item.Movements)),
+		}
+		for _, m := range item.Movements {
+			s.Movements = append(s.Movements, oapicodegen.StockSerialMovement{
+				Id:         m.Id,
+				Kind:       m.Kind,
+				LocationId: m.LocationId,
+				Quantity:   m.Quantity,
+			})
+		}
+		res.Items = append(res.Items, s)
+	}
+
+	return ctx.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,265 @@
+package serials_test
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+)
+
+func TestGetOK(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, fromLocationId, toLocationId, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Given
+	moved, issued := uuid.NewString(), uuid.NewString()
+	receiptId, err := Receive(rh, &rch, itemId, fromLocationId, moved, issued)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = rh.create(func() (*http.Response, error) {
+		return rh.PostTransfer(&stockClient.PostStockTransferJSONRequestBody{
+			ItemId:         itemId,
+			FromLocationId: fromLocationId,
+			ToLocationId:   toLocationId,
+			Quantity:       1,
+			Serials:        &[]string{moved},
+		})
+	}, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issueId, err := rh.create(func() (*http.Response, error) {
+		return rh.PostMovement(&stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: fromLocationId,
+			Kind:       stockClient.Issue,
+			Quantity:   1,
+			Serials:    &[]string{issued},
+		})
+	}, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	movedRes, err := rh.Get(moved, url.Values{"item_id": {itemId.String()}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer movedRes.Body.Close()
+
+	issuedRes, err := rh.Get(issued, url.Values{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer issuedRes.Body.Close()
+
+	// Then
+	if movedRes.StatusCode != http.StatusOK || issuedRes.StatusCode != http.StatusOK {
+		t.Fatalf("want %d, got %d %d", http.StatusOK, movedRes.StatusCode, issuedRes.StatusCode)
+	}
+
+	movedBody, err := rch.AsStockSerials(movedRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(movedBody.Items) != 1 {
+		t.Fatalf("want 1, got %+v", movedBody.Items)
+	}
+
+	s := movedBody.Items[0]
+	if s.ItemId != itemId || s.Serial != moved || s.LocationId == nil || *s.LocationId != toLocationId {
+		t.Errorf("want %s %s in %s, got %+v", itemId, moved, toLocationId, s)
+	}
+
+	want := []struct {
+		id         uuid.UUID
+		kind       string
+		locationId uuid.UUID
+		quantity   int64
+	}{
+		{receiptId, "receipt", fromLocationId, 2},
+		{uuid.Nil, "transfer_out", fromLocationId, -1},
+		{uuid.Nil, "transfer_in", toLocationId, 1},
+	}
+	if len(s.Movements) != len(want) {
+		t.Fatalf("want %+v, got %+v", want, s.Movements)
+	}
+	for i, m := range s.Movements {
+		if m.Kind != want[i].kind || m.LocationId != want[i].locationId || m.Quantity != want[i].quantity {
+			t.Errorf("want %+v, got %+v", want[i], m)
+		}
+		if want[i].id != uuid.Nil && m.Id != want[i].id {
+			t.Errorf("want %s, got %s", want[i].id, m.Id)
+		}
+	}
+
+	issuedBody, err := rch.AsStockSerials(issuedRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issuedBody.Items) != 1 || issuedBody.Items[0].LocationId != nil {
+		t.Fatalf("want 1 not in stock, got %+v", issuedBody.Items)
+	}
+	if ms := issuedBody.Items[0].Movements; len(ms) != 2 || ms[1].Id != issueId || ms[1].Kind != "issue" {
+		t.Errorf("want the receipt and issue %s, got %+v", issueId, ms)
+	}
+}
+
+func TestGetNotFound(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, _, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serial := uuid.NewString()
+	if _, err := Receive(rh, &rch, itemId, locationId, serial); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		serial string
+		query  url.Values
+	}{
+		{uuid.NewString(), url.Values{}},
+		{serial, url.Values{"item_id": {uuid.NewString()}}},
+	}
+
+	for _, tt := range tests {
+		// When
+		getRes, err := rh.Get(tt.serial, tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer getRes.Body.Close()
+
+		// Then
+		if getRes.StatusCode != http.StatusNotFound {
+			t.Errorf("%+v want %d, got %d", tt, http.StatusNotFound, getRes.StatusCode)
+		}
+	}
+}
+
+func TestGetBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+
+	// When
+	getRes, err := rh.Get(uuid.NewString(), url.Values{"item_id": {"not-a-uuid"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer getRes.Body.Close()
+
+	// Then
+	if getRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, getRes.StatusCode)
+	}
+}
+
+func TestPostConflictSerial(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, fromLocationId, toLocationId, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serial := uuid.NewString()
+	if _, err := Receive(rh, &rch, itemId, fromLocationId, serial); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Receive(rh, &rch, itemId, toLocationId, uuid.NewString()); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		post func() (*http.Response, error)
+		want string
+	}{
+		{func() (*http.Response, error) {
+			return rh.PostMovement(&stockClient.PostStockMovementJSONRequestBody{
+				ItemId: itemId, LocationId: toLocationId, Kind: stockClient.Receipt, Quantity: 1, Serials: &[]string{serial},
+			})
+		}, "stock_serial_in_stock"},
+		{func() (*http.Response, error) {
+			return rh.PostTransfer(&stockClient.PostStockTransferJSONRequestBody{
+				ItemId: itemId, FromLocationId: toLocationId, ToLocationId: fromLocationId, Quantity: 1, Serials: &[]string{serial},
+			})
+		}, "stock_serial_not_in_location"},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := tt.post()
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusConflict {
+			t.Errorf("%s want %d, got %d", tt.want, http.StatusConflict, postRes.StatusCode)
+			continue
+		}
+
+		problem, err := rch.AsProblem(postRes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(problem.Code) != tt.want {
+			t.Errorf("want %s, got %s", tt.want, problem.Code)
+		}
+	}
+}
+
+func TestPostBadRequestSerial(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, locationId, _, err := Setup(rh, &rch)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serial := uuid.NewString()
+	tests := []*stockClient.PostStockMovementJSONRequestBody{
+		{ItemId: itemId, LocationId: locationId, Kind: stockClient.Receipt, Quantity: 1},
+		{ItemId: itemId, LocationId: locationId, Kind: stockClient.Receipt, Quantity: 2, Serials: &[]string{serial}},
+		{ItemId: itemId, LocationId: locationId, Kind: stockClient.Receipt, Quantity: 2, Serials: &[]string{serial, serial}},
+		{ItemId: itemId, LocationId: locationId, Kind: stockClient.Issue, Quantity: 1, Serials: &[]string{uuid.NewString()}},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := rh.PostMovement(tt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("%+v want %d, got %d", tt, http.StatusBadRequest, postRes.StatusCode)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package serials_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+)
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over an
+// in-memory database of its own.
+type RequestHelper struct {
+	client *stockClient.ClientWithResponses
+}
+
+func newRequestHelper(t *testing.T) *RequestHelper {
+	t.Helper()
+
+	return &RequestHelper{client: stocktest.NewMemoryClient(t)}
+}
+
+// Get sends query as is, so that tests can send values the typed parameters cannot hold.
+func (h *RequestHelper) Get(serial string, query url.Values) (*http.Response, error) {
+	return h.client.ClientInterface.GetStockSerial(context.Background(), serial, &stockClient.GetStockSerialParams{}, withQuery(query))
+}
+
+func (h *RequestHelper) PostMovement(reqBody *stockClient.PostStockMovementJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockMovement(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) PostTransfer(reqBody *stockClient.PostStockTransferJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockTransfer(context.Background(), *reqBody)
+}
+
+func withQuery(query url.Values) stockClient.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.URL.RawQuery = query.Encode()
+		return nil
+	}
+}
+
+type ResponseConvertHelper struct{}
+
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Created{}
+	json.Unmarshal(resBodyByte, &resBody)
+	if resBody.Id == uuid.Nil {
+		return nil, fmt.Errorf("expected not empty, actual empty")
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsStockSerials(res *http.Response) (*stockClient.StockSerials, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockSerials{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsProblem(res *http.Response) (*stockClient.Problem, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Problem{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *RequestHelper) create(post func() (*http.Response, error), rch *ResponseConvertHelper) (uuid.UUID, error) {
+	res, err := post()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer res.Body.Close()
+
+	created, err := rch.AsCreated(res)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return created.Id, nil
+}
+
+func (h *RequestHelper) createLocation(rch *ResponseConvertHelper) (uuid.UUID, error) {
+	return h.create(func() (*http.Response, error) {
+		return h.client.ClientInterface.PostStockLocation(context.Background(), stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+	}, rch)
+}
+
+// Setup creates a serialized stock item and two stock locations.
+func Setup(rh *RequestHelper, rch *ResponseConvertHelper) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	serialized := true
+	itemId, err := rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockItem(context.Background(), stockClient.PostStockItemJSONRequestBody{
+			Sku:        uuid.NewString(),
+			Name:       uuid.NewString(),
+			Serialized: &serialized,
+		})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	fromLocationId, err := rh.createLocation(rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	toLocationId, err := rh.createLocation(rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return itemId, fromLocationId, toLocationId, nil
+}
+
+// Receive records a receipt of the units with the serials.
+func Receive(rh *RequestHelper, rch *ResponseConvertHelper, itemId uuid.UUID, locationId uuid.UUID, serials ...string) (uuid.UUID, error) {
+	return rh.create(func() (*http.Response, error) {
+		return rh.PostMovement(&stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: locationId,
+			Kind:       stockClient.Receipt,
+			Quantity:   int64(len(serials)),
+			Serials:    &serials,
+		})
+	}, rch)
+}
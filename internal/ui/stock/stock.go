@@ -1,47 +1,189 @@
 package stock
 
 import (
+	"strings"
+	"time"
+
+	"openapi/internal/domain/stock/transaction"
 	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/stock/balances"
+	"openapi/internal/ui/stock/items"
 	"openapi/internal/ui/stock/locations"
+	"openapi/internal/ui/stock/lots"
+	"openapi/internal/ui/stock/movements"
+	"openapi/internal/ui/stock/serials"
+	"openapi/internal/ui/stock/transfers"
 
 	openapi_types "github.com/oapi-codegen/runtime/types"
 
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v4"
 )
 
-func New() oapicodegen.ServerInterface {
-	return &Api{}
+// New returns the stock API.
+// Its handlers read through r, write through u, take the ids of new resources from newId
+// and the current time from now, so that each of them can be replaced in tests.
+func New(r transaction.IRepositories, u transaction.IUnitOfWork, newId func() uuid.UUID, now func() time.Time) oapicodegen.ServerInterface {
+	return &Api{
+		repositories: r,
+		unitOfWork:   u,
+		newId:        newId,
+		now:          now,
+	}
+}
+
+// GetSwagger returns the OpenAPI document the stock API implements.
+func GetSwagger() (*openapi3.T, error) {
+	return oapicodegen.GetSwagger()
 }
 
 func RegisterHandlers(e *echo.Echo, si oapicodegen.ServerInterface) {
-	oapicodegen.RegisterHandlers(e, si)
+	oapicodegen.RegisterHandlers(&customMethodRouter{Echo: e, paths: map[string]*customMethods{}}, si)
+}
+
+// customMethodRouter routes custom methods, such as POST /stock/locations/{id}:restore.
+// Echo takes the whole last segment for the path parameter, so the custom methods of a path
+// are served by one route that cuts the method off the parameter and hands the request to its handler.
+type customMethodRouter struct {
+	*echo.Echo
+	// paths are the custom methods by the path they are appended to.
+	paths map[string]*customMethods
+}
+
+type customMethods struct {
+	route    *echo.Route
+	handlers map[string]echo.HandlerFunc
+}
+
+func (r *customMethodRouter) POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route {
+	i := strings.LastIndex(path, ":")
+	if i <= strings.LastIndex(path, "/")+1 {
+		return r.Echo.POST(path, h, m...)
+	}
+
+	base, method := path[:i], path[i+1:]
+	for j := len(m) - 1; j >= 0; j-- {
+		h = m[j](h)
+	}
+
+	cm, ok := r.paths[base]
+	if !ok {
+		cm = &customMethods{handlers: map[string]echo.HandlerFunc{}}
+		cm.route = r.Echo.POST(base, cm.serve)
+		r.paths[base] = cm
+	}
+	cm.handlers[method] = h
+	return cm.route
 }
 
-type Api struct{}
+func (cm *customMethods) serve(ctx echo.Context) error {
+	values := ctx.ParamValues()
+	last := values[len(values)-1]
+	i := strings.LastIndex(last, ":")
+	if i < 0 {
+		// the path itself is a resource, which is not created by POST
+		return echo.ErrMethodNotAllowed
+	}
+
+	h, ok := cm.handlers[last[i+1:]]
+	if !ok {
+		return echo.ErrNotFound
+	}
+
+	values = append([]string{}, values...)
+	values[len(values)-1] = last[:i]
+	ctx.SetParamValues(values...)
+	return h(ctx)
+}
+
+type Api struct {
+	repositories transaction.IRepositories
+	unitOfWork   transaction.IUnitOfWork
+	newId        func() uuid.UUID
+	now          func() time.Time
+}
+
+func (a *Api) GetStockLocations(ctx echo.Context, params oapicodegen.GetStockLocationsParams) error {
+	return locations.GetStockLocations(ctx, a.repositories.Location(), params)
+}
+
+func (a *Api) GetStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error {
+	return locations.GetStockLocation(ctx, a.repositories.Location(), stockLocationId)
+}
 
 func (a *Api) PostStockLocation(ctx echo.Context) error {
-	return locations.PostStockLocation(ctx)
+	return locations.PostStockLocation(ctx, a.unitOfWork, a.newId)
+}
+
+func (a *Api) PutStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params oapicodegen.PutStockLocationParams) error {
+	return locations.PutStockLocation(ctx, a.unitOfWork, stockLocationId, params)
+}
+
+func (a *Api) DeleteStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params oapicodegen.DeleteStockLocationParams) error {
+	return locations.DeleteStockLocation(ctx, a.unitOfWork, stockLocationId, params)
+}
+
+func (a *Api) RestoreStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params oapicodegen.RestoreStockLocationParams) error {
+	return locations.RestoreStockLocation(ctx, a.unitOfWork, stockLocationId, params)
+}
+
+func (a *Api) MoveStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID, params oapicodegen.MoveStockLocationParams) error {
+	return locations.MoveStockLocation(ctx, a.unitOfWork, stockLocationId, params)
+}
+
+func (a *Api) GetStockLocationChildren(ctx echo.Context, stockLocationId openapi_types.UUID, params oapicodegen.GetStockLocationChildrenParams) error {
+	return locations.GetStockLocationChildren(ctx, a.repositories.Location(), stockLocationId, params)
+}
+
+func (a *Api) GetStockLocationAncestors(ctx echo.Context, stockLocationId openapi_types.UUID) error {
+	return locations.GetStockLocationAncestors(ctx, a.repositories.Location(), stockLocationId)
 }
 
-func (a *Api) PutStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error {
-	return locations.PutStockLocation(ctx, stockLocationId)
+func (a *Api) GetStockLocationBalances(ctx echo.Context, stockLocationId openapi_types.UUID) error {
+	return locations.GetStockLocationBalances(ctx, a.unitOfWork, stockLocationId)
 }
 
-func (a *Api) DeleteStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error {
-	return locations.DeleteStockLocation(ctx, stockLocationId)
+func (a *Api) PurgeStockLocation(ctx echo.Context, stockLocationId openapi_types.UUID) error {
+	return locations.PurgeStockLocation(ctx, a.unitOfWork, stockLocationId)
+}
+
+func (a *Api) GetStockItems(ctx echo.Context, params oapicodegen.GetStockItemsParams) error {
+	return items.GetStockItems(ctx, a.repositories.Item(), params)
 }
 
 func (a *Api) PostStockItem(ctx echo.Context) error {
-	//	return items.PostStockItem(ctx)
-	return nil
+	return items.PostStockItem(ctx, a.repositories.Item(), a.newId)
+}
+
+func (a *Api) PutStockItem(ctx echo.Context, stockItemId openapi_types.UUID, params oapicodegen.PutStockItemParams) error {
+	return items.PutStockItem(ctx, a.unitOfWork, stockItemId, params)
+}
+
+func (a *Api) DeleteStockItem(ctx echo.Context, stockItemId openapi_types.UUID, params oapicodegen.DeleteStockItemParams) error {
+	return items.DeleteStockItem(ctx, a.unitOfWork, stockItemId, params)
+}
+
+func (a *Api) PostStockMovement(ctx echo.Context) error {
+	return movements.PostStockMovement(ctx, a.unitOfWork, a.newId)
+}
+
+func (a *Api) PostStockTransfer(ctx echo.Context) error {
+	return transfers.PostStockTransfer(ctx, a.unitOfWork, a.newId)
+}
+
+func (a *Api) GetStockBalances(ctx echo.Context, params oapicodegen.GetStockBalancesParams) error {
+	return balances.GetStockBalances(ctx, a.repositories.Movement(), params)
+}
+
+func (a *Api) GetStockLotsExpiring(ctx echo.Context, params oapicodegen.GetStockLotsExpiringParams) error {
+	return lots.GetStockLotsExpiring(ctx, a.unitOfWork, a.now, params)
 }
 
-func (a *Api) PutStockItem(ctx echo.Context, stockItemId openapi_types.UUID) error {
-	// return items.PutStockItem(ctx, stockItemId)
-	return nil
+func (a *Api) GetStockLotSuggestions(ctx echo.Context, params oapicodegen.GetStockLotSuggestionsParams) error {
+	return lots.GetStockLotSuggestions(ctx, a.unitOfWork, a.now, params)
 }
 
-func (a *Api) DeleteStockItem(ctx echo.Context, stockItemId openapi_types.UUID) error {
-	// return items.DeleteStockItem(ctx, stockItemId)
-	return nil
+func (a *Api) GetStockSerial(ctx echo.Context, serial oapicodegen.StockSerialNumber, params oapicodegen.GetStockSerialParams) error {
+	return serials.GetStockSerial(ctx, a.unitOfWork, serial, params)
 }
@@ -0,0 +1,94 @@
+// Package stocktest serves the stock API in process for the tests of its handlers. The requests go through
+// the API client and the request and response validation, admin check, validator and error handler cmd/main
+// serves with, but never leave the process, so the tests need no running server or database.
+package stocktest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/stock/transaction"
+	"openapi/internal/infra/repository/memory"
+	transactionInfra "openapi/internal/infra/repository/memory/stock/transaction"
+	"openapi/internal/ui/admin"
+	"openapi/internal/ui/problem"
+	"openapi/internal/ui/stock"
+	"openapi/internal/ui/validation"
+	"openapi/pkg/client"
+	stockClient "openapi/pkg/client/stock"
+)
+
+// AdminToken is the admin token of the stock API served in process.
+const AdminToken = "TestAdminToken"
+
+// handlerDoer answers requests with handler instead of sending them over the network.
+type handlerDoer struct {
+	handler http.Handler
+}
+
+func (d handlerDoer) Do(req *http.Request) (*http.Response, error) {
+	rec := httptest.NewRecorder()
+	d.handler.ServeHTTP(rec, req)
+	return rec.Result(), nil
+}
+
+// NewClient returns a client of the stock API served in process, which reads and writes through r and u
+// and takes the ids of new resources from newId. Tests that need stored states the API cannot be brought
+// into give it mocked repositories.
+func NewClient(t gomock.TestHelper, r transaction.IRepositories, u transaction.IUnitOfWork, newId func() uuid.UUID) *stockClient.ClientWithResponses {
+	t.Helper()
+
+	swagger, err := stock.GetSwagger()
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	requestValidator, err := validation.RequestMiddleware(swagger)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	responseValidator, err := validation.ResponseMiddleware(true, swagger)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	e := echo.New()
+	e.Use(responseValidator)
+	e.Use(requestValidator)
+	e.Use(admin.Middleware(AdminToken))
+	e.Validator = validation.New()
+	e.HTTPErrorHandler = problem.HTTPErrorHandler
+	stock.RegisterHandlers(e, stock.New(r, u, newId, time.Now))
+
+	c, err := stockClient.New("http://stock.test", client.WithHTTPClient(handlerDoer{handler: e}), client.WithRetry(client.NoRetry))
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+	return c
+}
+
+// NewMemoryClient returns a client of the stock API served in process over an empty in-memory database
+// of its own, for the tests that build what they need through the API itself.
+func NewMemoryClient(t gomock.TestHelper) *stockClient.ClientWithResponses {
+	t.Helper()
+
+	db := memory.Open()
+
+	r, err := transactionInfra.NewRepositories(db)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	u, err := transactionInfra.NewUnitOfWork(db)
+	if err != nil {
+		t.Fatalf("%v", err)
+	}
+
+	return NewClient(t, r, u, uuid.New)
+}
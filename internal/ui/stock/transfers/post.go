@@ -0,0 +1,53 @@
+package transfers
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	app "openapi/internal/app/stock/transfer"
+	"openapi/internal/domain/stock/transaction"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+)
+
+// PostStockTransfer is a function that handles the HTTP POST request for moving stock between locations.
+func PostStockTransfer(ctx echo.Context, unitOfWork transaction.IUnitOfWork, newId func() uuid.UUID) error {
+	// Binding
+	req := &oapicodegen.PostStockTransferJSONRequestBody{}
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+
+	// Precondition
+	if err := ctx.Validate(req); err != nil {
+		return err
+	}
+
+	// Main Process
+	reqDto := &app.CreateRequestDto{
+		ItemId:         req.ItemId,
+		FromLocationId: req.FromLocationId,
+		ToLocationId:   req.ToLocationId,
+		Quantity:       req.Quantity,
+	}
+	if req.Lot != nil {
+		reqDto.Lot = *req.Lot
+	}
+	if req.Serials != nil {
+		reqDto.Serials = *req.Serials
+	}
+	resDto, err := app.Create(ctx.Request().Context(), reqDto, unitOfWork, newId)
+	if err != nil {
+		return err
+	}
+
+	// Postprocess
+	res := &oapicodegen.Created{Id: resDto.Id}
+
+	// Postcondition
+	if err := ctx.Validate(res); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError).SetInternal(err)
+	}
+	return ctx.JSON(http.StatusCreated, res)
+}
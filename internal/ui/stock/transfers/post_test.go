@@ -0,0 +1,164 @@
+package transfers_test
+
+import (
+	stockClient "openapi/pkg/client/stock"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"net/http"
+)
+
+func TestPostCreated(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, fromId, toId, err := Setup(rh, &rch, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockTransferJSONRequestBody{
+			ItemId:         itemId,
+			FromLocationId: fromId,
+			ToLocationId:   toId,
+			Quantity:       4,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusCreated {
+		t.Errorf("want %d, got %d", http.StatusCreated, postRes.StatusCode)
+	}
+
+	if _, err := rch.AsCreated(postRes); err != nil {
+		t.Fatal(err)
+	}
+
+	listRes, err := rh.ListBalances(itemId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer listRes.Body.Close()
+
+	balances, err := rch.AsStockBalances(listRes)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[uuid.UUID]int64{fromId: 6, toId: 4}
+	if len(balances.Items) != len(want) {
+		t.Fatalf("want %d, got %d", len(want), len(balances.Items))
+	}
+
+	for _, b := range balances.Items {
+		if b.Quantity != want[b.LocationId] {
+			t.Errorf("%s want %d, got %d", b.LocationId, want[b.LocationId], b.Quantity)
+		}
+	}
+}
+
+func TestPostBadRequest(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, fromId, toId, err := Setup(rh, &rch, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []*stockClient.PostStockTransferJSONRequestBody{
+		{ItemId: itemId, FromLocationId: fromId, ToLocationId: fromId, Quantity: 1},
+		{ItemId: itemId, FromLocationId: fromId, ToLocationId: toId, Quantity: 0},
+		{ItemId: uuid.New(), FromLocationId: fromId, ToLocationId: toId, Quantity: 1},
+		{ItemId: itemId, FromLocationId: fromId, ToLocationId: uuid.New(), Quantity: 1},
+	}
+
+	for _, tt := range tests {
+		// When
+		postRes, err := rh.Post(tt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		postRes.Body.Close()
+
+		// Then
+		if postRes.StatusCode != http.StatusBadRequest {
+			t.Errorf("%+v want %d, got %d", tt, http.StatusBadRequest, postRes.StatusCode)
+		}
+	}
+}
+
+func TestPostBadRequestDeletedLocation(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, fromId, toId, err := Setup(rh, &rch, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	deleteRes, err := rh.DeleteLocation(toId)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deleteRes.Body.Close()
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockTransferJSONRequestBody{
+			ItemId:         itemId,
+			FromLocationId: fromId,
+			ToLocationId:   toId,
+			Quantity:       1,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusBadRequest {
+		t.Errorf("want %d, got %d", http.StatusBadRequest, postRes.StatusCode)
+	}
+}
+
+func TestPostConflictInsufficientQuantity(t *testing.T) {
+	// Setup
+	rh := newRequestHelper(t)
+	rch := ResponseConvertHelper{}
+
+	itemId, fromId, toId, err := Setup(rh, &rch, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	postRes, err := rh.Post(
+		&stockClient.PostStockTransferJSONRequestBody{
+			ItemId:         itemId,
+			FromLocationId: fromId,
+			ToLocationId:   toId,
+			Quantity:       4,
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer postRes.Body.Close()
+
+	// Then
+	if postRes.StatusCode != http.StatusConflict {
+		t.Errorf("want %d, got %d", http.StatusConflict, postRes.StatusCode)
+	}
+}
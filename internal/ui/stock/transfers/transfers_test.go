@@ -0,0 +1,121 @@
+package transfers_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"openapi/internal/ui/stock/stocktest"
+	stockClient "openapi/pkg/client/stock"
+
+	"github.com/google/uuid"
+)
+
+// RequestHelper sends requests through the stock API client to a stock API served in process over an
+// in-memory database of its own.
+type RequestHelper struct {
+	client *stockClient.ClientWithResponses
+}
+
+func newRequestHelper(t *testing.T) *RequestHelper {
+	t.Helper()
+
+	return &RequestHelper{client: stocktest.NewMemoryClient(t)}
+}
+
+func (h *RequestHelper) Post(reqBody *stockClient.PostStockTransferJSONRequestBody) (*http.Response, error) {
+	return h.client.ClientInterface.PostStockTransfer(context.Background(), *reqBody)
+}
+
+func (h *RequestHelper) DeleteLocation(stockLocationsId uuid.UUID) (*http.Response, error) {
+	return h.client.ClientInterface.DeleteStockLocation(context.Background(), stockLocationsId, &stockClient.DeleteStockLocationParams{})
+}
+
+func (h *RequestHelper) ListBalances(itemId uuid.UUID) (*http.Response, error) {
+	return h.client.ClientInterface.GetStockBalances(context.Background(), &stockClient.GetStockBalancesParams{ItemId: &itemId})
+}
+
+type ResponseConvertHelper struct{}
+
+func (h *ResponseConvertHelper) AsCreated(res *http.Response) (*stockClient.Created, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.Created{}
+	json.Unmarshal(resBodyByte, &resBody)
+	if resBody.Id == uuid.Nil {
+		return nil, fmt.Errorf("expected not empty, actual empty")
+	}
+	return resBody, nil
+}
+
+func (h *ResponseConvertHelper) AsStockBalances(res *http.Response) (*stockClient.StockBalances, error) {
+	resBodyByte, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	resBody := &stockClient.StockBalances{}
+	if err := json.Unmarshal(resBodyByte, &resBody); err != nil {
+		return nil, err
+	}
+	return resBody, nil
+}
+
+func (h *RequestHelper) create(post func() (*http.Response, error), rch *ResponseConvertHelper) (uuid.UUID, error) {
+	res, err := post()
+	if err != nil {
+		return uuid.Nil, err
+	}
+	defer res.Body.Close()
+
+	created, err := rch.AsCreated(res)
+	if err != nil {
+		return uuid.Nil, err
+	}
+
+	return created.Id, nil
+}
+
+// Setup creates a stock item and two stock locations, the first of which holds the given quantity.
+func Setup(rh *RequestHelper, rch *ResponseConvertHelper, quantity int64) (uuid.UUID, uuid.UUID, uuid.UUID, error) {
+	itemId, err := rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockItem(context.Background(), stockClient.PostStockItemJSONRequestBody{Sku: uuid.NewString(), Name: uuid.NewString()})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	fromId, err := rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockLocation(context.Background(), stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	toId, err := rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockLocation(context.Background(), stockClient.PostStockLocationJSONRequestBody{Name: uuid.NewString()})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	_, err = rh.create(func() (*http.Response, error) {
+		return rh.client.ClientInterface.PostStockMovement(context.Background(), stockClient.PostStockMovementJSONRequestBody{
+			ItemId:     itemId,
+			LocationId: fromId,
+			Kind:       stockClient.Receipt,
+			Quantity:   quantity,
+		})
+	}, rch)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, uuid.Nil, err
+	}
+
+	return itemId, fromId, toId, nil
+}
@@ -0,0 +1,36 @@
+package timeout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/labstack/echo/v4"
+)
+
+// Middleware cancels the context of a request once it has run for longer than timeout,
+// which aborts the database queries started on its behalf. A timeout of zero disables it.
+// Errors of requests cut short this way wrap context.DeadlineExceeded, even the failures a handler returns
+// after the deadline, so that they are reported as timeouts.
+func Middleware(timeout time.Duration) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if timeout <= 0 {
+				return next(c)
+			}
+
+			ctx, cancel := context.WithTimeout(c.Request().Context(), timeout)
+			defer cancel()
+
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			err := next(c)
+			// drivers report a cancelled query in their own words, so the cause is taken from ctx
+			if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && !errors.Is(err, context.DeadlineExceeded) {
+				return fmt.Errorf("%w: %w", context.DeadlineExceeded, err)
+			}
+			return err
+		}
+	}
+}
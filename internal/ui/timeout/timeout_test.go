@@ -0,0 +1,100 @@
+package timeout_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/failure"
+	"openapi/internal/ui/problem"
+	"openapi/internal/ui/timeout"
+)
+
+func TestMiddleware(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	// Given
+	handler := timeout.Middleware(time.Millisecond)(func(c echo.Context) error {
+		<-c.Request().Context().Done()
+		return errors.New("pq: canceling statement due to user request")
+	})
+
+	// When
+	err := handler(ctx)
+
+	// Then
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("%T = %v, want %v", err, err, context.DeadlineExceeded)
+	}
+}
+
+func TestMiddlewareFailureAfterDeadline(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	// Given
+	handler := timeout.Middleware(time.Millisecond)(func(c echo.Context) error {
+		<-c.Request().Context().Done()
+		return failure.NotFound("stock_location_not_found", "stock location not found")
+	})
+
+	// When
+	err := handler(ctx)
+
+	// Then
+	if p := problem.New(err); p.Status != http.StatusServiceUnavailable || p.Code != "timeout" {
+		t.Errorf("%T %+v want %d timeout", p, p, http.StatusServiceUnavailable)
+	}
+}
+
+func TestMiddlewareInTime(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	// Given
+	handler := timeout.Middleware(time.Minute)(func(c echo.Context) error {
+		if _, ok := c.Request().Context().Deadline(); !ok {
+			t.Errorf("deadline must be set")
+		}
+		return nil
+	})
+
+	// When
+	err := handler(ctx)
+
+	// Then
+	if err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
+
+func TestMiddlewareDisabled(t *testing.T) {
+	// Setup
+	e := echo.New()
+	ctx := e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), httptest.NewRecorder())
+
+	// Given
+	handler := timeout.Middleware(0)(func(c echo.Context) error {
+		if _, ok := c.Request().Context().Deadline(); ok {
+			t.Errorf("deadline must not be set")
+		}
+		return nil
+	})
+
+	// When
+	err := handler(ctx)
+
+	// Then
+	if err != nil {
+		t.Errorf("%T = %v, want nil", err, err)
+	}
+}
@@ -0,0 +1,207 @@
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/failure"
+)
+
+func init() {
+	// check uuid formats the way the generated handlers parse them
+	openapi3.DefineStringFormatCallback("uuid", func(v string) error {
+		_, err := uuid.Parse(v)
+		return err
+	})
+}
+
+// RequestMiddleware rejects requests that break the operation of docs they are routed to,
+// before they reach the handlers. Requests for paths or methods no document describes are passed on,
+// so that echo answers them as usual.
+func RequestMiddleware(docs ...*openapi3.T) (echo.MiddlewareFunc, error) {
+	rs, err := newRouters(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		MultiError: true,
+		// the handlers apply the defaults themselves
+		SkipSettingDefaults: true,
+		// the handlers check credentials themselves
+		AuthenticationFunc: openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			req := ctx.Request()
+			_, route, pathParams, err := rs.FindRoute(req)
+			if err != nil {
+				return next(ctx)
+			}
+
+			input := &openapi3filter.RequestValidationInput{
+				Request:    req,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			}
+			if err := openapi3filter.ValidateRequest(req.Context(), input); err != nil {
+				return requestFailure(err)
+			}
+
+			return next(ctx)
+		}
+	}, nil
+}
+
+// newRouters routes by path alone, since the servers of a document name where it is deployed.
+func newRouters(docs []*openapi3.T) (customMethodRouters, error) {
+	rs := make(legacy.Routers, 0, len(docs))
+	for _, doc := range docs {
+		d := *doc
+		d.Servers = nil
+		d.Paths = openapi3.NewPaths()
+		for path, item := range doc.Paths.Map() {
+			d.Paths.Set(customMethodPattern.ReplaceAllString(path, "}/:$1"), item)
+		}
+
+		r, err := legacy.NewRouter(&d)
+		if err != nil {
+			return customMethodRouters{}, fmt.Errorf("newRouters: %w", err)
+		}
+		rs = append(rs, r.(*legacy.Router))
+	}
+	return customMethodRouters{rs}, nil
+}
+
+// customMethodPattern matches the custom method at the end of a path, such as :restore in /stock/locations/{id}:restore.
+var customMethodPattern = regexp.MustCompile(`}:([A-Za-z]+)$`)
+
+// customMethodRouters routes custom methods, such as POST /stock/locations/{id}:restore.
+// The legacy router takes the whole last segment for the path parameter, so the documents
+// and the requests are routed as if the custom method were a segment of its own.
+type customMethodRouters struct {
+	legacy.Routers
+}
+
+func (rs customMethodRouters) FindRoute(req *http.Request) (routers.Router, *routers.Route, map[string]string, error) {
+	path := req.URL.Path
+	if i := strings.LastIndex(path, ":"); i > strings.LastIndex(path, "/") {
+		u := *req.URL
+		u.Path, u.RawPath = path[:i]+"/"+path[i:], ""
+		r := *req
+		r.URL = &u
+		if router, route, pathParams, err := rs.Routers.FindRoute(&r); err == nil {
+			return router, route, pathParams, nil
+		}
+	}
+	return rs.Routers.FindRoute(req)
+}
+
+// requestFailure turns what openapi3filter reports into a validation failure listing every broken rule,
+// or into 415 when the body is not in a media type the operation accepts.
+func requestFailure(err error) error {
+	var fields []failure.Field
+	for _, e := range flatten(err) {
+		var reqErr *openapi3filter.RequestError
+		if !errors.As(e, &reqErr) {
+			return e
+		}
+
+		if reqErr.RequestBody != nil && strings.HasPrefix(reqErr.Reason, "header Content-Type has unexpected value") {
+			return echo.NewHTTPError(http.StatusUnsupportedMediaType, reqErr.Error())
+		}
+
+		fields = append(fields, fieldsOf(reqErr)...)
+	}
+
+	return failure.Validation("validation_failed", "request does not satisfy the API specification", fields...)
+}
+
+func fieldsOf(err *openapi3filter.RequestError) []failure.Field {
+	name := "body"
+	if err.Parameter != nil {
+		name = err.Parameter.Name
+	}
+
+	if errors.Is(err.Err, openapi3filter.ErrInvalidRequired) || errors.Is(err.Err, openapi3filter.ErrInvalidEmptyValue) {
+		return []failure.Field{{Name: name, Reason: "required"}}
+	}
+
+	var fields []failure.Field
+	for _, e := range flatten(err.Err) {
+		var schemaErr *openapi3.SchemaError
+		if errors.As(e, &schemaErr) {
+			fields = append(fields, failure.Field{Name: fieldName(name, schemaErr), Reason: ruleOf(schemaErr)})
+			continue
+		}
+
+		reason := "json"
+		if err.Parameter != nil && err.Parameter.Schema != nil && err.Parameter.Schema.Value != nil {
+			reason = "type=" + err.Parameter.Schema.Value.Type
+		}
+		fields = append(fields, failure.Field{Name: name, Reason: reason})
+	}
+	return fields
+}
+
+// fieldName names a value inside the body by its JSON path, such as items.0.name.
+func fieldName(name string, err *openapi3.SchemaError) string {
+	path := err.JSONPointer()
+	if name != "body" || len(path) == 0 {
+		return name
+	}
+	return strings.Join(path, ".")
+}
+
+// ruleOf names the keyword of the schema the value broke, with its argument when it has one,
+// in the same form as the validate tags, such as maxLength=100.
+func ruleOf(err *openapi3.SchemaError) string {
+	s := err.Schema
+	switch err.SchemaField {
+	case "maxLength":
+		if s.MaxLength != nil {
+			return fmt.Sprintf("maxLength=%d", *s.MaxLength)
+		}
+	case "minLength":
+		return fmt.Sprintf("minLength=%d", s.MinLength)
+	case "maximum":
+		if s.Max != nil {
+			return fmt.Sprintf("maximum=%v", *s.Max)
+		}
+	case "minimum":
+		if s.Min != nil {
+			return fmt.Sprintf("minimum=%v", *s.Min)
+		}
+	case "format":
+		return "format=" + s.Format
+	case "type":
+		return "type=" + s.Type
+	}
+	return err.SchemaField
+}
+
+// flatten lists the errors of an openapi3.MultiError, however deeply they are nested.
+func flatten(err error) []error {
+	me, ok := err.(openapi3.MultiError)
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, e := range me {
+		errs = append(errs, flatten(e)...)
+	}
+	return errs
+}
@@ -0,0 +1,189 @@
+package validation_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/failure"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/validation"
+)
+
+// serve runs req through the request middleware for the stock API and reports whether the handler was reached.
+func serve(t *testing.T, req *http.Request) (bool, error) {
+	t.Helper()
+
+	swagger, err := oapicodegen.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mw, err := validation.RequestMiddleware(swagger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	reached := false
+	handler := mw(func(c echo.Context) error {
+		reached = true
+		return nil
+	})
+
+	e := echo.New()
+	return reached, handler(e.NewContext(req, httptest.NewRecorder()))
+}
+
+func newJSONRequest(method string, target string, body string) *http.Request {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	return req
+}
+
+func TestRequestMiddleware(t *testing.T) {
+	// Given
+	req := newJSONRequest(http.MethodPost, "/stock/locations", `{"name":"TestName"}`)
+
+	// When
+	reached, err := serve(t, req)
+
+	// Then
+	if err != nil {
+		t.Fatalf("%T = %v, want nil", err, err)
+	}
+	if !reached {
+		t.Errorf("handler must be reached")
+	}
+}
+
+func TestRequestMiddlewareFailBody(t *testing.T) {
+	tests := []struct {
+		body string
+		want []failure.Field
+	}{
+		{`{}`, []failure.Field{{Name: "name", Reason: "required"}}},
+		{`{"name":""}`, []failure.Field{{Name: "name", Reason: "minLength=1"}}},
+		{`{"name":"` + strings.Repeat("a", 101) + `"}`, []failure.Field{{Name: "name", Reason: "maxLength=100"}}},
+		{`{"name":1,"allow_negative_stock":"yes"}`, []failure.Field{
+			{Name: "name", Reason: "type=string"},
+			{Name: "allow_negative_stock", Reason: "type=boolean"},
+		}},
+	}
+
+	for _, tt := range tests {
+		// Given
+		req := newJSONRequest(http.MethodPost, "/stock/locations", tt.body)
+
+		// When
+		reached, err := serve(t, req)
+
+		// Then
+		if reached {
+			t.Errorf("%s: handler must not be reached", tt.body)
+		}
+
+		var f *failure.Error
+		if !errors.As(err, &f) || f.Kind != failure.KindValidation {
+			t.Fatalf("%T = %v, want validation failure", err, err)
+		}
+		if diff := cmp.Diff(tt.want, f.Fields, cmpopts.SortSlices(func(a, b failure.Field) bool { return a.Name < b.Name })); diff != "" {
+			t.Errorf("%s: fields mismatch (-want +got):\n%s", tt.body, diff)
+		}
+	}
+}
+
+func TestRequestMiddlewareFailParameter(t *testing.T) {
+	tests := []struct {
+		target string
+		want   failure.Field
+	}{
+		{"/stock/locations/not-a-uuid", failure.Field{Name: "StockLocationId", Reason: "format=uuid"}},
+		{"/stock/locations?limit=0", failure.Field{Name: "limit", Reason: "minimum=1"}},
+		{"/stock/locations?limit=101", failure.Field{Name: "limit", Reason: "maximum=100"}},
+		{"/stock/locations?order=up", failure.Field{Name: "order", Reason: "enum"}},
+		{"/stock/balances?item_id=1", failure.Field{Name: "item_id", Reason: "format=uuid"}},
+	}
+
+	for _, tt := range tests {
+		// Given
+		req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+
+		// When
+		reached, err := serve(t, req)
+
+		// Then
+		if reached {
+			t.Errorf("%s: handler must not be reached", tt.target)
+		}
+
+		var f *failure.Error
+		if !errors.As(err, &f) || f.Kind != failure.KindValidation {
+			t.Fatalf("%T = %v, want validation failure", err, err)
+		}
+		if diff := cmp.Diff([]failure.Field{tt.want}, f.Fields); diff != "" {
+			t.Errorf("%s: fields mismatch (-want +got):\n%s", tt.target, diff)
+		}
+	}
+}
+
+func TestRequestMiddlewareFailContentType(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodPost, "/stock/locations", strings.NewReader(`name=TestName`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+
+	// When
+	reached, err := serve(t, req)
+
+	// Then
+	if reached {
+		t.Errorf("handler must not be reached")
+	}
+
+	var he *echo.HTTPError
+	if !errors.As(err, &he) || he.Code != http.StatusUnsupportedMediaType {
+		t.Errorf("%T = %v, want %d", err, err, http.StatusUnsupportedMediaType)
+	}
+}
+
+func TestRequestMiddlewareUnknownRoute(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodPatch, "/stock/locations", nil)
+
+	// When
+	reached, err := serve(t, req)
+
+	// Then
+	if err != nil {
+		t.Fatalf("%T = %v, want nil", err, err)
+	}
+	if !reached {
+		t.Errorf("handler must be reached, for echo to answer the route")
+	}
+}
+
+func TestRequestMiddlewareCustomMethod(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodPost, "/stock/locations/not-a-uuid:restore", nil)
+
+	// When
+	reached, err := serve(t, req)
+
+	// Then
+	if reached {
+		t.Errorf("handler must not be reached")
+	}
+
+	var f *failure.Error
+	if !errors.As(err, &f) || f.Kind != failure.KindValidation {
+		t.Fatalf("%T = %v, want validation failure", err, err)
+	}
+	if diff := cmp.Diff([]failure.Field{{Name: "StockLocationId", Reason: "format=uuid"}}, f.Fields); diff != "" {
+		t.Errorf("fields mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,128 @@
+package validation
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/labstack/echo/v4"
+)
+
+// ResponseMiddleware checks the status, headers and body of every response against the operation of docs
+// the request is routed to, including the problem details written for errors.
+// A response that breaks the document is logged, and in strict mode replaced by 500 Internal Server Error,
+// so that tests notice when the handlers drift from the document. The response is held in memory until it is checked.
+func ResponseMiddleware(strict bool, docs ...*openapi3.T) (echo.MiddlewareFunc, error) {
+	rs, err := newRouters(docs)
+	if err != nil {
+		return nil, err
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:            true,
+		IncludeResponseStatus: true,
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(ctx echo.Context) error {
+			_, route, pathParams, err := rs.FindRoute(ctx.Request())
+			if err != nil {
+				return next(ctx)
+			}
+
+			res := ctx.Response()
+			w := res.Writer
+			buf := &bufferedWriter{header: w.Header().Clone()}
+			res.Writer = buf
+			defer func() { res.Writer = w }()
+
+			if err := next(ctx); err != nil {
+				ctx.Error(err)
+			}
+
+			input := &openapi3filter.ResponseValidationInput{
+				RequestValidationInput: &openapi3filter.RequestValidationInput{
+					Request:    ctx.Request(),
+					PathParams: pathParams,
+					Route:      route,
+					Options:    options,
+				},
+				Status:  res.Status,
+				Header:  buf.header,
+				Options: options,
+			}
+			input.SetBodyBytes(buf.body.Bytes())
+			if err := validateResponse(ctx, input, route); err != nil {
+				err = fmt.Errorf("%s %s responded %d against the API specification: %w", ctx.Request().Method, route.Path, res.Status, err)
+				if strict {
+					res.Writer = w
+					res.Status = http.StatusOK
+					res.Size = 0
+					res.Committed = false
+					return err
+				}
+				ctx.Logger().Error(err)
+			}
+
+			return buf.flush(w, res.Status)
+		}
+	}, nil
+}
+
+// validateResponse also rejects a body where the document describes none,
+// which openapi3filter lets through.
+func validateResponse(ctx echo.Context, input *openapi3filter.ResponseValidationInput, route *routers.Route) error {
+	if err := openapi3filter.ValidateResponse(ctx.Request().Context(), input); err != nil {
+		return err
+	}
+
+	if ctx.Request().Method == http.MethodHead {
+		return nil
+	}
+
+	response := route.Operation.Responses.Status(input.Status)
+	if response == nil {
+		response = route.Operation.Responses.Default()
+	}
+	if response == nil || response.Value == nil || len(response.Value.Content) > 0 {
+		return nil
+	}
+
+	body, err := io.ReadAll(input.Body)
+	if err != nil {
+		return err
+	}
+	if len(body) > 0 {
+		return fmt.Errorf("response body must be empty, got %q", body)
+	}
+	return nil
+}
+
+// bufferedWriter holds a response back until it has been checked.
+type bufferedWriter struct {
+	header http.Header
+	body   bytes.Buffer
+}
+
+func (b *bufferedWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedWriter) WriteHeader(int) {}
+
+func (b *bufferedWriter) Write(p []byte) (int, error) {
+	return b.body.Write(p)
+}
+
+func (b *bufferedWriter) flush(w http.ResponseWriter, status int) error {
+	for k, v := range b.header {
+		w.Header()[k] = v
+	}
+	w.WriteHeader(status)
+	_, err := w.Write(b.body.Bytes())
+	return err
+}
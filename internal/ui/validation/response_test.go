@@ -0,0 +1,140 @@
+package validation_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+
+	"openapi/internal/domain/failure"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/problem"
+	"openapi/internal/ui/validation"
+)
+
+// respond runs handler for req behind the response middleware for the stock API.
+func respond(t *testing.T, strict bool, req *http.Request, handler echo.HandlerFunc) (*httptest.ResponseRecorder, error) {
+	t.Helper()
+
+	swagger, err := oapicodegen.GetSwagger()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mw, err := validation.ResponseMiddleware(strict, swagger)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	e := echo.New()
+	e.HTTPErrorHandler = problem.HTTPErrorHandler
+	rec := httptest.NewRecorder()
+	return rec, mw(handler)(e.NewContext(req, rec))
+}
+
+func TestResponseMiddleware(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodPut, "/stock/locations/"+uuidString, nil)
+	handler := func(c echo.Context) error {
+		c.Response().Header().Set("ETag", `"1"`)
+		return c.NoContent(http.StatusOK)
+	}
+
+	// When
+	rec, err := respond(t, true, req, handler)
+
+	// Then
+	if err != nil {
+		t.Fatalf("%T = %v, want nil", err, err)
+	}
+	if rec.Code != http.StatusOK || rec.Header().Get("ETag") != `"1"` {
+		t.Errorf("%d %v, want %d with ETag", rec.Code, rec.Header(), http.StatusOK)
+	}
+}
+
+func TestResponseMiddlewareProblem(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodPut, "/stock/locations/"+uuidString, nil)
+	handler := func(c echo.Context) error {
+		return failure.PreconditionFailed("stock_location_version_mismatch", "stock location has been changed")
+	}
+
+	// When
+	rec, err := respond(t, true, req, handler)
+
+	// Then
+	if err != nil {
+		t.Fatalf("%T = %v, want nil", err, err)
+	}
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("%d, want %d", rec.Code, http.StatusPreconditionFailed)
+	}
+}
+
+func TestResponseMiddlewareFailStrict(t *testing.T) {
+	tests := []struct {
+		name    string
+		handler echo.HandlerFunc
+	}{
+		{"null body where none is described", func(c echo.Context) error {
+			c.Response().Header().Set("ETag", `"1"`)
+			return c.JSON(http.StatusOK, nil)
+		}},
+		{"missing ETag", func(c echo.Context) error {
+			return c.NoContent(http.StatusOK)
+		}},
+		{"undocumented status", func(c echo.Context) error {
+			return c.NoContent(http.StatusTeapot)
+		}},
+		{"unknown problem code", func(c echo.Context) error {
+			return failure.Conflict("no_such_code", "no such code")
+		}},
+	}
+
+	for _, tt := range tests {
+		// Given
+		req := httptest.NewRequest(http.MethodPut, "/stock/locations/"+uuidString, nil)
+
+		// When
+		rec, err := respond(t, true, req, tt.handler)
+
+		// Then
+		if err == nil {
+			t.Errorf("%s: error must not be nil", tt.name)
+		}
+
+		var f *failure.Error
+		var he *echo.HTTPError
+		if errors.As(err, &f) || errors.As(err, &he) {
+			t.Errorf("%s: %T = %v, want an internal error", tt.name, err, err)
+		}
+
+		if rec.Body.Len() != 0 {
+			t.Errorf("%s: body %q must not be written", tt.name, rec.Body.String())
+		}
+	}
+}
+
+func TestResponseMiddlewareWarn(t *testing.T) {
+	// Given
+	req := httptest.NewRequest(http.MethodPut, "/stock/locations/"+uuidString, nil)
+	handler := func(c echo.Context) error {
+		c.Response().Header().Set("ETag", `"1"`)
+		return c.JSON(http.StatusOK, nil)
+	}
+
+	// When
+	rec, err := respond(t, false, req, handler)
+
+	// Then
+	if err != nil {
+		t.Fatalf("%T = %v, want nil", err, err)
+	}
+	if rec.Code != http.StatusOK || rec.Body.String() != "null\n" {
+		t.Errorf("%d %q, want the response as written", rec.Code, rec.Body.String())
+	}
+}
+
+const uuidString = "7f1b2a4e-8c0d-4f5e-9a3b-2c6d8e0f1a2b"
@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+
+	"github.com/go-playground/validator"
+
+	"openapi/internal/domain/failure"
+)
+
+// CustomValidator checks the validate tags of request and response bodies for echo.Context.Validate.
+type CustomValidator struct {
+	validator *validator.Validate
+}
+
+func New() *CustomValidator {
+	v := validator.New()
+	// report fields by the names clients send them with
+	v.RegisterTagNameFunc(func(f reflect.StructField) string {
+		name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	// required takes the zero of a number for a missing value, so the numbers that may be negative but
+	// not zero have their own rule
+	if err := v.RegisterValidation("nonzero", func(fl validator.FieldLevel) bool {
+		return !fl.Field().IsZero()
+	}); err != nil {
+		panic(err)
+	}
+
+	return &CustomValidator{validator: v}
+}
+
+// Validate returns a validation failure listing every field that breaks its rules.
+func (cv *CustomValidator) Validate(i interface{}) error {
+	err := cv.validator.Struct(i)
+	if err == nil {
+		return nil
+	}
+
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return err
+	}
+
+	fields := make([]failure.Field, 0, len(errs))
+	for _, e := range errs {
+		reason := e.Tag()
+		if e.Param() != "" {
+			reason += "=" + e.Param()
+		}
+		fields = append(fields, failure.Field{Name: e.Field(), Reason: reason})
+	}
+
+	return failure.Validation("validation_failed", "request does not satisfy the validation rules", fields...)
+}
@@ -0,0 +1,56 @@
+package validation_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"openapi/internal/domain/failure"
+	oapicodegen "openapi/internal/infra/oapicodegen/stock"
+	"openapi/internal/ui/validation"
+)
+
+func TestValidateNonzero(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		quantity int64
+		want     []failure.Field
+	}{
+		{-3, nil},
+		{5, nil},
+		{0, []failure.Field{{Name: "quantity", Reason: "nonzero"}}},
+	}
+
+	id := uuid.MustParse(uuidString)
+
+	for _, tt := range tests {
+		// Given
+		req := &oapicodegen.NewStockMovement{
+			ItemId:     id,
+			LocationId: id,
+			Kind:       oapicodegen.Adjustment,
+			Quantity:   tt.quantity,
+		}
+
+		// When
+		err := validation.New().Validate(req)
+
+		// Then
+		if tt.want == nil {
+			if err != nil {
+				t.Errorf("quantity %d: %v", tt.quantity, err)
+			}
+			continue
+		}
+
+		var f *failure.Error
+		if !errors.As(err, &f) {
+			t.Fatalf("quantity %d: %T %v want failure", tt.quantity, err, err)
+		}
+		if len(f.Fields) != 1 || f.Fields[0] != tt.want[0] {
+			t.Errorf("quantity %d: %+v want %+v", tt.quantity, f.Fields, tt.want)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+// Package client is the transport shared by the generated API clients in its subpackages.
+// It retries idempotent requests, authenticates them and lets callers hook into every attempt.
+package client
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand"
+	"net/http"
+	"time"
+)
+
+// Doer sends HTTP requests, as *http.Client does.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Auth adds the credentials of the caller to req.
+type Auth func(ctx context.Context, req *http.Request) error
+
+// BearerToken authenticates with the token in an Authorization header.
+func BearerToken(token string) Auth {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
+	}
+}
+
+// BasicAuth authenticates with the user name and password in an Authorization header.
+func BasicAuth(username, password string) Auth {
+	return func(_ context.Context, req *http.Request) error {
+		req.SetBasicAuth(username, password)
+		return nil
+	}
+}
+
+// RequestHook is called before each attempt of a request is sent, after it has been authenticated.
+// An error stops the request.
+type RequestHook func(ctx context.Context, req *http.Request) error
+
+// ResponseHook is called after each attempt of a request with its response or error.
+type ResponseHook func(req *http.Request, res *http.Response, err error)
+
+// Retry is how often and how patiently idempotent requests are retried after a network error
+// or a 429, 502, 503 or 504 response. The wait doubles after each attempt up to MaxBackoff, with jitter.
+type Retry struct {
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+var (
+	DefaultRetry = Retry{MaxAttempts: 3, InitialBackoff: 100 * time.Millisecond, MaxBackoff: 2 * time.Second}
+	NoRetry      = Retry{MaxAttempts: 1}
+)
+
+type Option func(t *Transport)
+
+// WithHTTPClient sends the requests with d in place of http.DefaultClient.
+func WithHTTPClient(d Doer) Option {
+	return func(t *Transport) {
+		t.doer = d
+	}
+}
+
+// WithRetry replaces DefaultRetry.
+func WithRetry(r Retry) Option {
+	return func(t *Transport) {
+		t.retry = r
+	}
+}
+
+func WithAuth(a Auth) Option {
+	return func(t *Transport) {
+		t.auth = a
+	}
+}
+
+func WithRequestHook(h RequestHook) Option {
+	return func(t *Transport) {
+		t.onRequest = append(t.onRequest, h)
+	}
+}
+
+func WithResponseHook(h ResponseHook) Option {
+	return func(t *Transport) {
+		t.onResponse = append(t.onResponse, h)
+	}
+}
+
+// Transport is the Doer the generated clients are given.
+type Transport struct {
+	doer       Doer
+	retry      Retry
+	auth       Auth
+	onRequest  []RequestHook
+	onResponse []ResponseHook
+}
+
+func NewTransport(opts ...Option) *Transport {
+	t := &Transport{
+		doer:  http.DefaultClient,
+		retry: DefaultRetry,
+	}
+	for _, opt := range opts {
+		opt(t)
+	}
+	return t
+}
+
+// Do sends req, and again while it is idempotent, fails in a way worth retrying and attempts are left.
+func (t *Transport) Do(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	attempts := 1
+	if isIdempotent(req.Method) && t.retry.MaxAttempts > 1 {
+		attempts = t.retry.MaxAttempts
+	}
+
+	for attempt := 1; ; attempt++ {
+		res, err := t.do(ctx, req)
+		if attempt >= attempts || !shouldRetry(ctx, res, err) {
+			return res, err
+		}
+
+		if res != nil {
+			io.Copy(io.Discard, res.Body)
+			res.Body.Close()
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(t.backoff(attempt)):
+		}
+	}
+}
+
+func (t *Transport) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	r := req.Clone(ctx)
+	if req.Body != nil && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, fmt.Errorf("client: rewinding body: %w", err)
+		}
+		r.Body = body
+	}
+
+	if t.auth != nil {
+		if err := t.auth(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+
+	for _, hook := range t.onRequest {
+		if err := hook(ctx, r); err != nil {
+			return nil, err
+		}
+	}
+
+	res, err := t.doer.Do(r)
+	for _, hook := range t.onResponse {
+		hook(r, res, err)
+	}
+	return res, err
+}
+
+// backoff is the wait after the attempt, between half and all of its doubled backoff.
+func (t *Transport) backoff(attempt int) time.Duration {
+	d := t.retry.InitialBackoff << (attempt - 1)
+	if d <= 0 || (t.retry.MaxBackoff > 0 && d > t.retry.MaxBackoff) {
+		d = t.retry.MaxBackoff
+	}
+	if d <= 0 {
+		return 0
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodTrace, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func shouldRetry(ctx context.Context, res *http.Response, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if err != nil {
+		return true
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
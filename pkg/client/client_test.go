@@ -0,0 +1,188 @@
+package client_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"openapi/pkg/client"
+)
+
+var fastRetry = client.Retry{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+// newServer answers with the statuses in turn, the last one from then on, and counts the requests.
+func newServer(t *testing.T, statuses ...int) (*httptest.Server, *int) {
+	t.Helper()
+
+	count := 0
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Method == http.MethodPut && string(body) != "body" {
+			t.Errorf("attempt %d got body %q, want body", count+1, body)
+		}
+
+		status := statuses[len(statuses)-1]
+		if count < len(statuses) {
+			status = statuses[count]
+		}
+		count++
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(s.Close)
+	return s, &count
+}
+
+func TestTransportRetry(t *testing.T) {
+	// Given
+	s, count := newServer(t, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+	transport := client.NewTransport(client.WithRetry(fastRetry))
+
+	req, err := http.NewRequest(http.MethodPut, s.URL, strings.NewReader("body"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	res, err := transport.Do(req)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK || *count != 3 {
+		t.Errorf("%d after %d attempts, want %d after 3", res.StatusCode, *count, http.StatusOK)
+	}
+}
+
+func TestTransportRetryGivesUp(t *testing.T) {
+	// Given
+	s, count := newServer(t, http.StatusServiceUnavailable)
+	transport := client.NewTransport(client.WithRetry(fastRetry))
+
+	req, err := http.NewRequest(http.MethodGet, s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	res, err := transport.Do(req)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusServiceUnavailable || *count != fastRetry.MaxAttempts {
+		t.Errorf("%d after %d attempts, want %d after %d", res.StatusCode, *count, http.StatusServiceUnavailable, fastRetry.MaxAttempts)
+	}
+}
+
+func TestTransportNoRetry(t *testing.T) {
+	tests := []struct {
+		method string
+		status int
+	}{
+		{http.MethodPost, http.StatusServiceUnavailable},
+		{http.MethodGet, http.StatusInternalServerError},
+		{http.MethodGet, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		// Given
+		s, count := newServer(t, tt.status, http.StatusOK)
+		transport := client.NewTransport(client.WithRetry(fastRetry))
+
+		req, err := http.NewRequest(tt.method, s.URL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// When
+		res, err := transport.Do(req)
+
+		// Then
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+
+		if res.StatusCode != tt.status || *count != 1 {
+			t.Errorf("%s %d: %d after %d attempts, want one attempt", tt.method, tt.status, res.StatusCode, *count)
+		}
+	}
+}
+
+func TestTransportRetryCancelled(t *testing.T) {
+	// Given
+	s, count := newServer(t, http.StatusServiceUnavailable)
+	transport := client.NewTransport(client.WithRetry(client.Retry{MaxAttempts: 5, InitialBackoff: time.Hour, MaxBackoff: time.Hour}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	_, err = transport.Do(req)
+
+	// Then
+	if err != context.DeadlineExceeded || *count != 1 {
+		t.Errorf("%T = %v after %d attempts, want %v after 1", err, err, *count, context.DeadlineExceeded)
+	}
+}
+
+func TestTransportAuthAndHooks(t *testing.T) {
+	// Given
+	s, _ := newServer(t, http.StatusServiceUnavailable, http.StatusOK)
+
+	var auths, statuses []string
+	transport := client.NewTransport(
+		client.WithRetry(fastRetry),
+		client.WithAuth(client.BearerToken("token")),
+		client.WithRequestHook(func(_ context.Context, req *http.Request) error {
+			auths = append(auths, req.Header.Get("Authorization"))
+			return nil
+		}),
+		client.WithResponseHook(func(_ *http.Request, res *http.Response, err error) {
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			statuses = append(statuses, res.Status)
+		}),
+	)
+
+	req, err := http.NewRequest(http.MethodGet, s.URL, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// When
+	res, err := transport.Do(req)
+
+	// Then
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+
+	if len(auths) != 2 || auths[0] != "Bearer token" || auths[1] != "Bearer token" {
+		t.Errorf("%T %+v want Bearer token on both attempts", auths, auths)
+	}
+	if len(statuses) != 2 || statuses[0] != "503 Service Unavailable" || statuses[1] != "200 OK" {
+		t.Errorf("%T %+v want 503 then 200", statuses, statuses)
+	}
+	if req.Header.Get("Authorization") != "" {
+		t.Errorf("request of the caller must not be changed")
+	}
+}
@@ -0,0 +1,11 @@
+package hello
+
+import (
+	"openapi/pkg/client"
+)
+
+// New returns a client of the hello API served at server, such as http://localhost:1323,
+// whose requests are sent through a client.Transport configured by opts.
+func New(server string, opts ...client.Option) (*ClientWithResponses, error) {
+	return NewClientWithResponses(server, WithHTTPClient(client.NewTransport(opts...)))
+}
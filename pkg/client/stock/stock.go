@@ -0,0 +1,4537 @@
+// Package stock provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/deepmap/oapi-codegen version v1.16.2 DO NOT EDIT.
+package stock
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+)
+
+const (
+	AdminTokenScopes = "AdminToken.Scopes"
+)
+
+// Defines values for NewStockMovementKind.
+const (
+	Adjustment NewStockMovementKind = "adjustment"
+	Issue      NewStockMovementKind = "issue"
+	Receipt    NewStockMovementKind = "receipt"
+)
+
+// Defines values for ProblemCode.
+const (
+	ProblemCodeBadRequest                      ProblemCode = "bad_request"
+	ProblemCodeConflict                        ProblemCode = "conflict"
+	ProblemCodeDuplicateStockItemBarcode       ProblemCode = "duplicate_stock_item_barcode"
+	ProblemCodeDuplicateStockItemUnit          ProblemCode = "duplicate_stock_item_unit"
+	ProblemCodeDuplicateStockSerialNumber      ProblemCode = "duplicate_stock_serial_number"
+	ProblemCodeForbidden                       ProblemCode = "forbidden"
+	ProblemCodeInsufficientQuantity            ProblemCode = "insufficient_quantity"
+	ProblemCodeInternalError                   ProblemCode = "internal_error"
+	ProblemCodeInvalidCursor                   ProblemCode = "invalid_cursor"
+	ProblemCodeInvalidStockItemBarcode         ProblemCode = "invalid_stock_item_barcode"
+	ProblemCodeInvalidStockItemName            ProblemCode = "invalid_stock_item_name"
+	ProblemCodeInvalidStockItemPackSize        ProblemCode = "invalid_stock_item_pack_size"
+	ProblemCodeInvalidStockItemSku             ProblemCode = "invalid_stock_item_sku"
+	ProblemCodeInvalidStockItemUnit            ProblemCode = "invalid_stock_item_unit"
+	ProblemCodeInvalidStockLocationCapacity    ProblemCode = "invalid_stock_location_capacity"
+	ProblemCodeInvalidStockLocationName        ProblemCode = "invalid_stock_location_name"
+	ProblemCodeInvalidStockLocationType        ProblemCode = "invalid_stock_location_type"
+	ProblemCodeInvalidStockLotDates            ProblemCode = "invalid_stock_lot_dates"
+	ProblemCodeInvalidStockLotNumber           ProblemCode = "invalid_stock_lot_number"
+	ProblemCodeInvalidStockMovement            ProblemCode = "invalid_stock_movement"
+	ProblemCodeInvalidStockSerialNumber        ProblemCode = "invalid_stock_serial_number"
+	ProblemCodeInvalidStockTransfer            ProblemCode = "invalid_stock_transfer"
+	ProblemCodeInvalidVolume                   ProblemCode = "invalid_volume"
+	ProblemCodeInvalidWeight                   ProblemCode = "invalid_weight"
+	ProblemCodeMethodNotAllowed                ProblemCode = "method_not_allowed"
+	ProblemCodeNotFound                        ProblemCode = "not_found"
+	ProblemCodePreconditionFailed              ProblemCode = "precondition_failed"
+	ProblemCodeStockItemHasStock               ProblemCode = "stock_item_has_stock"
+	ProblemCodeStockItemNotAvailable           ProblemCode = "stock_item_not_available"
+	ProblemCodeStockItemNotFound               ProblemCode = "stock_item_not_found"
+	ProblemCodeStockItemNotLotTracked          ProblemCode = "stock_item_not_lot_tracked"
+	ProblemCodeStockItemNotSerialized          ProblemCode = "stock_item_not_serialized"
+	ProblemCodeStockItemSkuTaken               ProblemCode = "stock_item_sku_taken"
+	ProblemCodeStockItemVersionMismatch        ProblemCode = "stock_item_version_mismatch"
+	ProblemCodeStockLocationHasChildren        ProblemCode = "stock_location_has_children"
+	ProblemCodeStockLocationHasHistory         ProblemCode = "stock_location_has_history"
+	ProblemCodeStockLocationInUse              ProblemCode = "stock_location_in_use"
+	ProblemCodeStockLocationNameTaken          ProblemCode = "stock_location_name_taken"
+	ProblemCodeStockLocationNotAvailable       ProblemCode = "stock_location_not_available"
+	ProblemCodeStockLocationNotDeleted         ProblemCode = "stock_location_not_deleted"
+	ProblemCodeStockLocationNotFound           ProblemCode = "stock_location_not_found"
+	ProblemCodeStockLocationOverCapacity       ProblemCode = "stock_location_over_capacity"
+	ProblemCodeStockLocationParentCycle        ProblemCode = "stock_location_parent_cycle"
+	ProblemCodeStockLocationParentNotAvailable ProblemCode = "stock_location_parent_not_available"
+	ProblemCodeStockLocationVersionMismatch    ProblemCode = "stock_location_version_mismatch"
+	ProblemCodeStockLotDatesMismatch           ProblemCode = "stock_lot_dates_mismatch"
+	ProblemCodeStockLotNotAvailable            ProblemCode = "stock_lot_not_available"
+	ProblemCodeStockLotNotFound                ProblemCode = "stock_lot_not_found"
+	ProblemCodeStockLotNumberTaken             ProblemCode = "stock_lot_number_taken"
+	ProblemCodeStockLotRequired                ProblemCode = "stock_lot_required"
+	ProblemCodeStockSerialCountMismatch        ProblemCode = "stock_serial_count_mismatch"
+	ProblemCodeStockSerialInStock              ProblemCode = "stock_serial_in_stock"
+	ProblemCodeStockSerialNotAvailable         ProblemCode = "stock_serial_not_available"
+	ProblemCodeStockSerialNotFound             ProblemCode = "stock_serial_not_found"
+	ProblemCodeStockSerialNotInLocation        ProblemCode = "stock_serial_not_in_location"
+	ProblemCodeStockSerialNumberTaken          ProblemCode = "stock_serial_number_taken"
+	ProblemCodeTimeout                         ProblemCode = "timeout"
+	ProblemCodeUnauthorized                    ProblemCode = "unauthorized"
+	ProblemCodeUnknownStockItemUnit            ProblemCode = "unknown_stock_item_unit"
+	ProblemCodeUnsupportedMediaType            ProblemCode = "unsupported_media_type"
+	ProblemCodeValidationFailed                ProblemCode = "validation_failed"
+)
+
+// Defines values for StockLocationType.
+const (
+	Bin        StockLocationType = "bin"
+	Quarantine StockLocationType = "quarantine"
+	Staging    StockLocationType = "staging"
+	Virtual    StockLocationType = "virtual"
+	Warehouse  StockLocationType = "warehouse"
+	Zone       StockLocationType = "zone"
+)
+
+// Defines values for GetStockLocationsParamsOrder.
+const (
+	GetStockLocationsParamsOrderAsc  GetStockLocationsParamsOrder = "asc"
+	GetStockLocationsParamsOrderDesc GetStockLocationsParamsOrder = "desc"
+)
+
+// Defines values for GetStockLocationChildrenParamsOrder.
+const (
+	GetStockLocationChildrenParamsOrderAsc  GetStockLocationChildrenParamsOrder = "asc"
+	GetStockLocationChildrenParamsOrderDesc GetStockLocationChildrenParamsOrder = "desc"
+)
+
+// ExpiringStockLot defines model for ExpiringStockLot.
+type ExpiringStockLot struct {
+	// Expired The lot expired before today
+	Expired        bool                `json:"expired"`
+	ExpiresOn      openapi_types.Date  `json:"expires_on"`
+	ItemId         openapi_types.UUID  `json:"item_id"`
+	LocationId     openapi_types.UUID  `json:"location_id"`
+	Lot            string              `json:"lot"`
+	ManufacturedOn *openapi_types.Date `json:"manufactured_on,omitempty"`
+	Quantity       int64               `json:"quantity"`
+}
+
+// ExpiringStockLots defines model for ExpiringStockLots.
+type ExpiringStockLots struct {
+	Items []ExpiringStockLot `json:"items"`
+}
+
+// MoveStockLocation defines model for MoveStockLocation.
+type MoveStockLocation struct {
+	// ParentId Stock Location to move under, the root when omitted
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+}
+
+// NewStockItem defines model for NewStockItem.
+type NewStockItem struct {
+	// Barcodes GTINs of the Stock Item, each an EAN-8, UPC-A, EAN-13 or GTIN-14 with a valid check digit
+	Barcodes *[]StockItemBarcode `json:"barcodes,omitempty"`
+
+	// BaseUnit Unit of measure the quantities of the Stock Item are counted in, each when omitted.
+	// Stored in lower case, at most 20 letters and digits. It cannot be changed later.
+	BaseUnit *string `json:"base_unit,omitempty"`
+
+	// LotTracked Count the stock per lot, so that every movement and transfer of the Stock Item names its lot.
+	// Not tracked when omitted on creation, kept as is when omitted on update. It can only be changed
+	// while the Stock Item has no stock on hand.
+	LotTracked *bool  `json:"lot_tracked,omitempty"`
+	Name       string `json:"name" validate:"required,max=100"`
+
+	// PackSizes Units of measure the Stock Item is also packed in, each once and none of them the base unit
+	PackSizes *[]StockItemPackSize `json:"pack_sizes,omitempty"`
+
+	// Serialized Register every unit by its serial number, so that every movement and transfer of the Stock Item
+	// names the serials of the units it moves. Not serialized when omitted on creation, kept as is when
+	// omitted on update. It can only be changed while the Stock Item has no stock on hand.
+	Serialized *bool `json:"serialized,omitempty"`
+
+	// Sku Stock keeping unit, stored without surrounding white space and in upper case. At most 64 letters,
+	// digits and the characters - _ . / of ASCII. Unique among the active Stock Items.
+	Sku StockItemSku `json:"sku"`
+
+	// UnitVolume Cubic centimetres of one unit, counted towards the volume capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitVolume *int64 `json:"unit_volume,omitempty"`
+
+	// UnitWeight Grams of one unit, counted towards the weight capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitWeight *int64 `json:"unit_weight,omitempty"`
+}
+
+// NewStockLocation defines model for NewStockLocation.
+type NewStockLocation struct {
+	// AllowNegativeStock Allow the quantity on hand to go below zero
+	AllowNegativeStock *bool `json:"allow_negative_stock,omitempty"`
+
+	// Capacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+	// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+	// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+	Capacity *StockLocationCapacity `json:"capacity,omitempty"`
+
+	// Name Stored without surrounding white space and normalized to Unicode NFC, at most 100 characters
+	// without control characters. Unique among the active Stock Locations.
+	Name string `json:"name" validate:"required,max=100"`
+
+	// ParentId Stock Location to create the new one under, a root location when omitted
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Type What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+	// A virtual location holds stock that is not in any physical place, such as stock in transit.
+	Type *StockLocationType `json:"type,omitempty"`
+}
+
+// NewStockMovement defines model for NewStockMovement.
+type NewStockMovement struct {
+	// ExpiresOn Last day the lot may be used, only given with a receipt. A lot without it never expires.
+	// The first receipt of a lot records its dates, the later ones must give the same dates or none.
+	ExpiresOn  *openapi_types.Date  `json:"expires_on,omitempty"`
+	ItemId     openapi_types.UUID   `json:"item_id" validate:"required"`
+	Kind       NewStockMovementKind `json:"kind" validate:"required,oneof=receipt issue adjustment"`
+	LocationId openapi_types.UUID   `json:"location_id" validate:"required"`
+
+	// Lot Lot of a lot tracked Stock Item, required for them and refused for the other Stock Items.
+	// Stored without surrounding white space, at most 64 characters without control characters.
+	// Only a receipt records a new lot.
+	Lot *StockLotNumber `json:"lot,omitempty"`
+
+	// ManufacturedOn Day the lot was made, only given with a receipt. The first receipt of a lot records its dates,
+	// the later ones must give the same dates or none.
+	ManufacturedOn *openapi_types.Date `json:"manufactured_on,omitempty"`
+
+	// Quantity Positive for receipts and issues, signed for adjustments, never zero. Counted in unit.
+	Quantity int64 `json:"quantity" validate:"nonzero"`
+
+	// Serials Serial numbers of the units moved, one per unit of the quantity, each once. Required for serialized
+	// Stock Items and refused for the other Stock Items. A receipt registers the serial numbers it is the
+	// first to bring in; the other movements only move registered units out of the Stock Location they are in.
+	Serials *StockSerialNumbers `json:"serials,omitempty"`
+
+	// Unit Unit the quantity is counted in, the base unit of the Stock Item or one of its pack sizes.
+	// The movement is recorded in the base unit, which is also the unit when it is omitted.
+	Unit *string `json:"unit,omitempty"`
+}
+
+// NewStockMovementKind defines model for NewStockMovement.Kind.
+type NewStockMovementKind string
+
+// NewStockTransfer defines model for NewStockTransfer.
+type NewStockTransfer struct {
+	FromLocationId openapi_types.UUID `json:"from_location_id" validate:"required"`
+	ItemId         openapi_types.UUID `json:"item_id" validate:"required"`
+
+	// Lot Lot of a lot tracked Stock Item, required for them and refused for the other Stock Items.
+	// Stored without surrounding white space, at most 64 characters without control characters.
+	// Only a receipt records a new lot.
+	Lot      *StockLotNumber `json:"lot,omitempty"`
+	Quantity int64           `json:"quantity" validate:"required,gt=0"`
+
+	// Serials Serial numbers of the units moved, one per unit of the quantity, each once. Required for serialized
+	// Stock Items and refused for the other Stock Items. A receipt registers the serial numbers it is the
+	// first to bring in; the other movements only move registered units out of the Stock Location they are in.
+	Serials      *StockSerialNumbers `json:"serials,omitempty"`
+	ToLocationId openapi_types.UUID  `json:"to_location_id" validate:"required"`
+}
+
+// Problem Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Problem struct {
+	// Code Stable identifier of the problem for clients to branch on
+	Code ProblemCode `json:"code"`
+
+	// Detail Explanation for a human, which may change between releases
+	Detail *string `json:"detail,omitempty"`
+
+	// Errors Fields of the request that broke a rule
+	Errors *[]ProblemField `json:"errors,omitempty"`
+
+	// Instance Path of the request
+	Instance *string `json:"instance,omitempty"`
+	Status   int     `json:"status"`
+	Title    string  `json:"title"`
+
+	// Type Always about:blank, the title is the HTTP status text
+	Type string `json:"type"`
+}
+
+// ProblemCode Stable identifier of the problem for clients to branch on
+type ProblemCode string
+
+// ProblemField defines model for ProblemField.
+type ProblemField struct {
+	// Field JSON name of the field
+	Field string `json:"field"`
+
+	// Reason Rule the field broke, such as required or maxLength=100
+	Reason string `json:"reason"`
+}
+
+// StockBalance defines model for StockBalance.
+type StockBalance struct {
+	ItemId     openapi_types.UUID `json:"item_id"`
+	LocationId openapi_types.UUID `json:"location_id"`
+
+	// Lot Lot of a lot tracked Stock Item, absent for the other Stock Items
+	Lot      *string `json:"lot,omitempty"`
+	Quantity int64   `json:"quantity"`
+}
+
+// StockBalances defines model for StockBalances.
+type StockBalances struct {
+	Items []StockBalance `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// StockItem defines model for StockItem.
+type StockItem struct {
+	Barcodes   []string            `json:"barcodes"`
+	BaseUnit   string              `json:"base_unit"`
+	Id         openapi_types.UUID  `json:"id"`
+	LotTracked bool                `json:"lot_tracked"`
+	Name       string              `json:"name"`
+	PackSizes  []StockItemPackSize `json:"pack_sizes"`
+	Serialized bool                `json:"serialized"`
+	Sku        string              `json:"sku"`
+
+	// UnitVolume Cubic centimetres of one unit, 0 when not declared
+	UnitVolume int64 `json:"unit_volume"`
+
+	// UnitWeight Grams of one unit, 0 when not declared
+	UnitWeight int64 `json:"unit_weight"`
+}
+
+// StockItemBarcode defines model for StockItemBarcode.
+type StockItemBarcode = string
+
+// StockItemPackSize defines model for StockItemPackSize.
+type StockItemPackSize struct {
+	// Quantity Base units one pack holds, such as 12 for 1 case = 12 each
+	Quantity int64 `json:"quantity"`
+
+	// Unit Unit of measure of the pack, stored in lower case, at most 20 letters and digits
+	Unit string `json:"unit"`
+}
+
+// StockItemSku Stock keeping unit, stored without surrounding white space and in upper case. At most 64 letters,
+// digits and the characters - _ . / of ASCII. Unique among the active Stock Items.
+type StockItemSku = string
+
+// StockItems defines model for StockItems.
+type StockItems struct {
+	Items []StockItem `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// StockLocation defines model for StockLocation.
+type StockLocation struct {
+	AllowNegativeStock bool `json:"allow_negative_stock"`
+
+	// Capacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+	// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+	// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+	Capacity *StockLocationCapacity `json:"capacity,omitempty"`
+	Deleted  bool                   `json:"deleted"`
+	Id       openapi_types.UUID     `json:"id"`
+	Name     string                 `json:"name"`
+
+	// ParentId Stock Location this one is part of, absent for a root location
+	ParentId *openapi_types.UUID `json:"parent_id,omitempty"`
+
+	// Type What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+	// A virtual location holds stock that is not in any physical place, such as stock in transit.
+	Type StockLocationType `json:"type"`
+}
+
+// StockLocationCapacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+type StockLocationCapacity struct {
+	// MaxUnits Units of all Stock Items together
+	MaxUnits *int64 `json:"max_units,omitempty"`
+
+	// MaxVolume Cubic centimetres, from the unit_volume of the Stock Items
+	MaxVolume *int64 `json:"max_volume,omitempty"`
+
+	// MaxWeight Grams, from the unit_weight of the Stock Items
+	MaxWeight *int64 `json:"max_weight,omitempty"`
+}
+
+// StockLocationType What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+// A virtual location holds stock that is not in any physical place, such as stock in transit.
+type StockLocationType string
+
+// StockLocations defines model for StockLocations.
+type StockLocations struct {
+	Items []StockLocation `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// StockLotNumber Lot of a lot tracked Stock Item, required for them and refused for the other Stock Items.
+// Stored without surrounding white space, at most 64 characters without control characters.
+// Only a receipt records a new lot.
+type StockLotNumber = string
+
+// StockLotPick defines model for StockLotPick.
+type StockLotPick struct {
+	// ExpiresOn Absent for a lot that never expires
+	ExpiresOn *openapi_types.Date `json:"expires_on,omitempty"`
+	Lot       string              `json:"lot"`
+	Quantity  int64               `json:"quantity"`
+}
+
+// StockLotPicks defines model for StockLotPicks.
+type StockLotPicks struct {
+	Items []StockLotPick `json:"items"`
+}
+
+// StockSerial defines model for StockSerial.
+type StockSerial struct {
+	ItemId openapi_types.UUID `json:"item_id"`
+
+	// LocationId Stock Location the unit is in, absent while it is not in stock
+	LocationId *openapi_types.UUID `json:"location_id,omitempty"`
+
+	// Movements Movements of the unit in the order they were recorded
+	Movements []StockSerialMovement `json:"movements"`
+	Serial    string                `json:"serial"`
+}
+
+// StockSerialMovement defines model for StockSerialMovement.
+type StockSerialMovement struct {
+	Id openapi_types.UUID `json:"id"`
+
+	// Kind One of receipt, issue, adjustment, transfer_out and transfer_in
+	Kind       string             `json:"kind"`
+	LocationId openapi_types.UUID `json:"location_id"`
+
+	// Quantity Change the whole movement made to the balance, of which the unit is one
+	Quantity int64 `json:"quantity"`
+}
+
+// StockSerialNumber Serial number of a unit of a serialized Stock Item, unique per Stock Item.
+// Stored without surrounding white space, at most 64 characters without control characters.
+type StockSerialNumber = string
+
+// StockSerialNumbers Serial numbers of the units moved, one per unit of the quantity, each once. Required for serialized
+// Stock Items and refused for the other Stock Items. A receipt registers the serial numbers it is the
+// first to bring in; the other movements only move registered units out of the Stock Location they are in.
+type StockSerialNumbers = []StockSerialNumber
+
+// StockSerials defines model for StockSerials.
+type StockSerials struct {
+	Items []StockSerial `json:"items"`
+}
+
+// UpdateStockItem defines model for UpdateStockItem.
+type UpdateStockItem struct {
+	// Barcodes Replace the barcodes, kept as is when omitted and removed when empty
+	Barcodes *[]StockItemBarcode `json:"barcodes,omitempty"`
+
+	// LotTracked Count the stock per lot, so that every movement and transfer of the Stock Item names its lot.
+	// Not tracked when omitted on creation, kept as is when omitted on update. It can only be changed
+	// while the Stock Item has no stock on hand.
+	LotTracked *bool  `json:"lot_tracked,omitempty"`
+	Name       string `json:"name" validate:"required,max=100"`
+
+	// PackSizes Replace the pack sizes, kept as is when omitted and removed when empty
+	PackSizes *[]StockItemPackSize `json:"pack_sizes,omitempty"`
+
+	// Serialized Register every unit by its serial number, so that every movement and transfer of the Stock Item
+	// names the serials of the units it moves. Not serialized when omitted on creation, kept as is when
+	// omitted on update. It can only be changed while the Stock Item has no stock on hand.
+	Serialized *bool `json:"serialized,omitempty"`
+
+	// Sku Stock keeping unit, stored without surrounding white space and in upper case. At most 64 letters,
+	// digits and the characters - _ . / of ASCII. Unique among the active Stock Items.
+	Sku *StockItemSku `json:"sku,omitempty"`
+
+	// UnitVolume Cubic centimetres of one unit, counted towards the volume capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitVolume *int64 `json:"unit_volume,omitempty"`
+
+	// UnitWeight Grams of one unit, counted towards the weight capacity of Stock Locations.
+	// Not declared when 0 or omitted on creation, kept as is when omitted on update.
+	UnitWeight *int64 `json:"unit_weight,omitempty"`
+}
+
+// UpdateStockLocation defines model for UpdateStockLocation.
+type UpdateStockLocation struct {
+	// AllowNegativeStock Allow the quantity on hand to go below zero, kept as is when omitted
+	AllowNegativeStock *bool `json:"allow_negative_stock,omitempty"`
+
+	// Capacity Limits of what the Stock Location itself holds, without the Stock Locations below it.
+	// An omitted limit does not apply, unlimited when omitted on creation and kept as is when omitted on update.
+	// Lowering a limit below the stock on hand keeps the stock but refuses further receipts and transfers in.
+	Capacity *StockLocationCapacity `json:"capacity,omitempty"`
+
+	// Name Stored without surrounding white space and normalized to Unicode NFC, at most 100 characters
+	// without control characters. Unique among the active Stock Locations.
+	Name string `json:"name" validate:"required,max=100"`
+
+	// Type What the Stock Location is for, a warehouse when omitted on creation and kept as is when omitted on update.
+	// A virtual location holds stock that is not in any physical place, such as stock in transit.
+	Type *StockLocationType `json:"type,omitempty"`
+}
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// BadRequest Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type BadRequest = Problem
+
+// Conflict Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Conflict = Problem
+
+// Created defines model for Created.
+type Created struct {
+	Id openapi_types.UUID `json:"id" validate:"required"`
+}
+
+// Forbidden Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Forbidden = Problem
+
+// InternalServerError Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type InternalServerError = Problem
+
+// NotFound Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type NotFound = Problem
+
+// PreconditionFailed Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type PreconditionFailed = Problem
+
+// ServiceUnavailable Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type ServiceUnavailable = Problem
+
+// Unauthorized Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type Unauthorized = Problem
+
+// UnsupportedMediaType Problem details as defined by RFC 7807. Some problems carry extension members next to the others
+// with the context of the problem, such as the serial or lot it is about.
+type UnsupportedMediaType = Problem
+
+// GetStockBalancesParams defines parameters for GetStockBalances.
+type GetStockBalancesParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After      *string             `form:"after,omitempty" json:"after,omitempty"`
+	ItemId     *openapi_types.UUID `form:"item_id,omitempty" json:"item_id,omitempty"`
+	LocationId *openapi_types.UUID `form:"location_id,omitempty" json:"location_id,omitempty"`
+}
+
+// GetStockItemsParams defines parameters for GetStockItems.
+type GetStockItemsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After *string `form:"after,omitempty" json:"after,omitempty"`
+
+	// Sku Only the Stock Item with the SKU, compared without regard to case
+	Sku *string `form:"sku,omitempty" json:"sku,omitempty"`
+
+	// Barcode Only the Stock Items with the barcode
+	Barcode *string `form:"barcode,omitempty" json:"barcode,omitempty"`
+}
+
+// DeleteStockItemParams defines parameters for DeleteStockItem.
+type DeleteStockItemParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutStockItemParams defines parameters for PutStockItem.
+type PutStockItemParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStockLocationsParams defines parameters for GetStockLocations.
+type GetStockLocationsParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After      *string                       `form:"after,omitempty" json:"after,omitempty"`
+	NamePrefix *string                       `form:"name_prefix,omitempty" json:"name_prefix,omitempty"`
+	Order      *GetStockLocationsParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+
+	// Type Only the Stock Locations of the type
+	Type           *StockLocationType `form:"type,omitempty" json:"type,omitempty"`
+	IncludeDeleted *bool              `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetStockLocationsParamsOrder defines parameters for GetStockLocations.
+type GetStockLocationsParamsOrder string
+
+// DeleteStockLocationParams defines parameters for DeleteStockLocation.
+type DeleteStockLocationParams struct {
+	// Cascade Delete the active descendants of the location too
+	Cascade *bool `form:"cascade,omitempty" json:"cascade,omitempty"`
+
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// PutStockLocationParams defines parameters for PutStockLocation.
+type PutStockLocationParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStockLocationChildrenParams defines parameters for GetStockLocationChildren.
+type GetStockLocationChildrenParams struct {
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// After Cursor returned as next_cursor by the previous page
+	After          *string                              `form:"after,omitempty" json:"after,omitempty"`
+	Order          *GetStockLocationChildrenParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+	IncludeDeleted *bool                                `form:"include_deleted,omitempty" json:"include_deleted,omitempty"`
+}
+
+// GetStockLocationChildrenParamsOrder defines parameters for GetStockLocationChildren.
+type GetStockLocationChildrenParamsOrder string
+
+// MoveStockLocationParams defines parameters for MoveStockLocation.
+type MoveStockLocationParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// RestoreStockLocationParams defines parameters for RestoreStockLocation.
+type RestoreStockLocationParams struct {
+	// IfMatch ETag of the version the change is based on; the request fails with 412 when it is stale
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStockLotsExpiringParams defines parameters for GetStockLotsExpiring.
+type GetStockLotsExpiringParams struct {
+	// Within Days from today, such as 30d
+	Within *string `form:"within,omitempty" json:"within,omitempty"`
+}
+
+// GetStockLotSuggestionsParams defines parameters for GetStockLotSuggestions.
+type GetStockLotSuggestionsParams struct {
+	ItemId     openapi_types.UUID `form:"item_id" json:"item_id"`
+	LocationId openapi_types.UUID `form:"location_id" json:"location_id"`
+	Quantity   int64              `form:"quantity" json:"quantity"`
+}
+
+// GetStockSerialParams defines parameters for GetStockSerial.
+type GetStockSerialParams struct {
+	// ItemId Only the unit of the Stock Item
+	ItemId *openapi_types.UUID `form:"item_id,omitempty" json:"item_id,omitempty"`
+}
+
+// PostStockItemJSONRequestBody defines body for PostStockItem for application/json ContentType.
+type PostStockItemJSONRequestBody = NewStockItem
+
+// PutStockItemJSONRequestBody defines body for PutStockItem for application/json ContentType.
+type PutStockItemJSONRequestBody = UpdateStockItem
+
+// PostStockLocationJSONRequestBody defines body for PostStockLocation for application/json ContentType.
+type PostStockLocationJSONRequestBody = NewStockLocation
+
+// PutStockLocationJSONRequestBody defines body for PutStockLocation for application/json ContentType.
+type PutStockLocationJSONRequestBody = UpdateStockLocation
+
+// MoveStockLocationJSONRequestBody defines body for MoveStockLocation for application/json ContentType.
+type MoveStockLocationJSONRequestBody = MoveStockLocation
+
+// PostStockMovementJSONRequestBody defines body for PostStockMovement for application/json ContentType.
+type PostStockMovementJSONRequestBody = NewStockMovement
+
+// PostStockTransferJSONRequestBody defines body for PostStockTransfer for application/json ContentType.
+type PostStockTransferJSONRequestBody = NewStockTransfer
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetStockBalances request
+	GetStockBalances(ctx context.Context, params *GetStockBalancesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockItems request
+	GetStockItems(ctx context.Context, params *GetStockItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostStockItemWithBody request with any body
+	PostStockItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostStockItem(ctx context.Context, body PostStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteStockItem request
+	DeleteStockItem(ctx context.Context, stockItemId openapi_types.UUID, params *DeleteStockItemParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutStockItemWithBody request with any body
+	PutStockItemWithBody(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutStockItem(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, body PutStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLocations request
+	GetStockLocations(ctx context.Context, params *GetStockLocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostStockLocationWithBody request with any body
+	PostStockLocationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostStockLocation(ctx context.Context, body PostStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteStockLocation request
+	DeleteStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *DeleteStockLocationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLocation request
+	GetStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutStockLocationWithBody request with any body
+	PutStockLocationWithBody(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, body PutStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLocationAncestors request
+	GetStockLocationAncestors(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLocationBalances request
+	GetStockLocationBalances(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLocationChildren request
+	GetStockLocationChildren(ctx context.Context, stockLocationId openapi_types.UUID, params *GetStockLocationChildrenParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// MoveStockLocationWithBody request with any body
+	MoveStockLocationWithBody(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	MoveStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, body MoveStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PurgeStockLocation request
+	PurgeStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// RestoreStockLocation request
+	RestoreStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *RestoreStockLocationParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLotsExpiring request
+	GetStockLotsExpiring(ctx context.Context, params *GetStockLotsExpiringParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockLotSuggestions request
+	GetStockLotSuggestions(ctx context.Context, params *GetStockLotSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostStockMovementWithBody request with any body
+	PostStockMovementWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostStockMovement(ctx context.Context, body PostStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStockSerial request
+	GetStockSerial(ctx context.Context, serial StockSerialNumber, params *GetStockSerialParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostStockTransferWithBody request with any body
+	PostStockTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostStockTransfer(ctx context.Context, body PostStockTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetStockBalances(ctx context.Context, params *GetStockBalancesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockBalancesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockItems(ctx context.Context, params *GetStockItemsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockItemsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockItemWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockItemRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockItem(ctx context.Context, body PostStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockItemRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteStockItem(ctx context.Context, stockItemId openapi_types.UUID, params *DeleteStockItemParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteStockItemRequest(c.Server, stockItemId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutStockItemWithBody(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutStockItemRequestWithBody(c.Server, stockItemId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutStockItem(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, body PutStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutStockItemRequest(c.Server, stockItemId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLocations(ctx context.Context, params *GetStockLocationsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLocationsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockLocationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockLocationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockLocation(ctx context.Context, body PostStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockLocationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *DeleteStockLocationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteStockLocationRequest(c.Server, stockLocationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLocationRequest(c.Server, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutStockLocationWithBody(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutStockLocationRequestWithBody(c.Server, stockLocationId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, body PutStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutStockLocationRequest(c.Server, stockLocationId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLocationAncestors(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLocationAncestorsRequest(c.Server, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLocationBalances(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLocationBalancesRequest(c.Server, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLocationChildren(ctx context.Context, stockLocationId openapi_types.UUID, params *GetStockLocationChildrenParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLocationChildrenRequest(c.Server, stockLocationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveStockLocationWithBody(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveStockLocationRequestWithBody(c.Server, stockLocationId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) MoveStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, body MoveStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewMoveStockLocationRequest(c.Server, stockLocationId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PurgeStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPurgeStockLocationRequest(c.Server, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) RestoreStockLocation(ctx context.Context, stockLocationId openapi_types.UUID, params *RestoreStockLocationParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewRestoreStockLocationRequest(c.Server, stockLocationId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLotsExpiring(ctx context.Context, params *GetStockLotsExpiringParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLotsExpiringRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockLotSuggestions(ctx context.Context, params *GetStockLotSuggestionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockLotSuggestionsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockMovementWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockMovementRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockMovement(ctx context.Context, body PostStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockMovementRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStockSerial(ctx context.Context, serial StockSerialNumber, params *GetStockSerialParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStockSerialRequest(c.Server, serial, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockTransferWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockTransferRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostStockTransfer(ctx context.Context, body PostStockTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostStockTransferRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetStockBalancesRequest generates requests for GetStockBalances
+func NewGetStockBalancesRequest(server string, params *GetStockBalancesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/balances")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ItemId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "item_id", runtime.ParamLocationQuery, *params.ItemId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LocationId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location_id", runtime.ParamLocationQuery, *params.LocationId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStockItemsRequest generates requests for GetStockItems
+func NewGetStockItemsRequest(server string, params *GetStockItemsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Sku != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "sku", runtime.ParamLocationQuery, *params.Sku); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Barcode != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "barcode", runtime.ParamLocationQuery, *params.Barcode); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostStockItemRequest calls the generic PostStockItem builder with application/json body
+func NewPostStockItemRequest(server string, body PostStockItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostStockItemRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostStockItemRequestWithBody generates requests for PostStockItem with any type of body
+func NewPostStockItemRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/items")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteStockItemRequest generates requests for DeleteStockItem
+func NewDeleteStockItemRequest(server string, stockItemId openapi_types.UUID, params *DeleteStockItemParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "stockItemId", runtime.ParamLocationPath, stockItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPutStockItemRequest calls the generic PutStockItem builder with application/json body
+func NewPutStockItemRequest(server string, stockItemId openapi_types.UUID, params *PutStockItemParams, body PutStockItemJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutStockItemRequestWithBody(server, stockItemId, params, "application/json", bodyReader)
+}
+
+// NewPutStockItemRequestWithBody generates requests for PutStockItem with any type of body
+func NewPutStockItemRequestWithBody(server string, stockItemId openapi_types.UUID, params *PutStockItemParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "stockItemId", runtime.ParamLocationPath, stockItemId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/items/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetStockLocationsRequest generates requests for GetStockLocations
+func NewGetStockLocationsRequest(server string, params *GetStockLocationsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.NamePrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "name_prefix", runtime.ParamLocationQuery, *params.NamePrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Type != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "type", runtime.ParamLocationQuery, *params.Type); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostStockLocationRequest calls the generic PostStockLocation builder with application/json body
+func NewPostStockLocationRequest(server string, body PostStockLocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostStockLocationRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostStockLocationRequestWithBody generates requests for PostStockLocation with any type of body
+func NewPostStockLocationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteStockLocationRequest generates requests for DeleteStockLocation
+func NewDeleteStockLocationRequest(server string, stockLocationId openapi_types.UUID, params *DeleteStockLocationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Cascade != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cascade", runtime.ParamLocationQuery, *params.Cascade); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetStockLocationRequest generates requests for GetStockLocation
+func NewGetStockLocationRequest(server string, stockLocationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutStockLocationRequest calls the generic PutStockLocation builder with application/json body
+func NewPutStockLocationRequest(server string, stockLocationId openapi_types.UUID, params *PutStockLocationParams, body PutStockLocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutStockLocationRequestWithBody(server, stockLocationId, params, "application/json", bodyReader)
+}
+
+// NewPutStockLocationRequestWithBody generates requests for PutStockLocation with any type of body
+func NewPutStockLocationRequestWithBody(server string, stockLocationId openapi_types.UUID, params *PutStockLocationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetStockLocationAncestorsRequest generates requests for GetStockLocationAncestors
+func NewGetStockLocationAncestorsRequest(server string, stockLocationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s/ancestors", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStockLocationBalancesRequest generates requests for GetStockLocationBalances
+func NewGetStockLocationBalancesRequest(server string, stockLocationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s/balances", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStockLocationChildrenRequest generates requests for GetStockLocationChildren
+func NewGetStockLocationChildrenRequest(server string, stockLocationId openapi_types.UUID, params *GetStockLocationChildrenParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s/children", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.After != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "after", runtime.ParamLocationQuery, *params.After); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Order != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "order", runtime.ParamLocationQuery, *params.Order); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IncludeDeleted != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "include_deleted", runtime.ParamLocationQuery, *params.IncludeDeleted); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewMoveStockLocationRequest calls the generic MoveStockLocation builder with application/json body
+func NewMoveStockLocationRequest(server string, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, body MoveStockLocationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewMoveStockLocationRequestWithBody(server, stockLocationId, params, "application/json", bodyReader)
+}
+
+// NewMoveStockLocationRequestWithBody generates requests for MoveStockLocation with any type of body
+func NewMoveStockLocationRequestWithBody(server string, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s:move", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPurgeStockLocationRequest generates requests for PurgeStockLocation
+func NewPurgeStockLocationRequest(server string, stockLocationId openapi_types.UUID) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s:purge", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewRestoreStockLocationRequest generates requests for RestoreStockLocation
+func NewRestoreStockLocationRequest(server string, stockLocationId openapi_types.UUID, params *RestoreStockLocationParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "StockLocationId", runtime.ParamLocationPath, stockLocationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/locations/%s:restore", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetStockLotsExpiringRequest generates requests for GetStockLotsExpiring
+func NewGetStockLotsExpiringRequest(server string, params *GetStockLotsExpiringParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/lots/expiring")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Within != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "within", runtime.ParamLocationQuery, *params.Within); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStockLotSuggestionsRequest generates requests for GetStockLotSuggestions
+func NewGetStockLotSuggestionsRequest(server string, params *GetStockLotSuggestionsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/lots/suggestions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "item_id", runtime.ParamLocationQuery, params.ItemId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "location_id", runtime.ParamLocationQuery, params.LocationId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "quantity", runtime.ParamLocationQuery, params.Quantity); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostStockMovementRequest calls the generic PostStockMovement builder with application/json body
+func NewPostStockMovementRequest(server string, body PostStockMovementJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostStockMovementRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostStockMovementRequestWithBody generates requests for PostStockMovement with any type of body
+func NewPostStockMovementRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/movements")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetStockSerialRequest generates requests for GetStockSerial
+func NewGetStockSerialRequest(server string, serial StockSerialNumber, params *GetStockSerialParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "serial", runtime.ParamLocationPath, serial)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/serials/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ItemId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "item_id", runtime.ParamLocationQuery, *params.ItemId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostStockTransferRequest calls the generic PostStockTransfer builder with application/json body
+func NewPostStockTransferRequest(server string, body PostStockTransferJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostStockTransferRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostStockTransferRequestWithBody generates requests for PostStockTransfer with any type of body
+func NewPostStockTransferRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/stock/transfers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetStockBalancesWithResponse request
+	GetStockBalancesWithResponse(ctx context.Context, params *GetStockBalancesParams, reqEditors ...RequestEditorFn) (*GetStockBalancesResponse, error)
+
+	// GetStockItemsWithResponse request
+	GetStockItemsWithResponse(ctx context.Context, params *GetStockItemsParams, reqEditors ...RequestEditorFn) (*GetStockItemsResponse, error)
+
+	// PostStockItemWithBodyWithResponse request with any body
+	PostStockItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockItemResponse, error)
+
+	PostStockItemWithResponse(ctx context.Context, body PostStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockItemResponse, error)
+
+	// DeleteStockItemWithResponse request
+	DeleteStockItemWithResponse(ctx context.Context, stockItemId openapi_types.UUID, params *DeleteStockItemParams, reqEditors ...RequestEditorFn) (*DeleteStockItemResponse, error)
+
+	// PutStockItemWithBodyWithResponse request with any body
+	PutStockItemWithBodyWithResponse(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutStockItemResponse, error)
+
+	PutStockItemWithResponse(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, body PutStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutStockItemResponse, error)
+
+	// GetStockLocationsWithResponse request
+	GetStockLocationsWithResponse(ctx context.Context, params *GetStockLocationsParams, reqEditors ...RequestEditorFn) (*GetStockLocationsResponse, error)
+
+	// PostStockLocationWithBodyWithResponse request with any body
+	PostStockLocationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockLocationResponse, error)
+
+	PostStockLocationWithResponse(ctx context.Context, body PostStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockLocationResponse, error)
+
+	// DeleteStockLocationWithResponse request
+	DeleteStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *DeleteStockLocationParams, reqEditors ...RequestEditorFn) (*DeleteStockLocationResponse, error)
+
+	// GetStockLocationWithResponse request
+	GetStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStockLocationResponse, error)
+
+	// PutStockLocationWithBodyWithResponse request with any body
+	PutStockLocationWithBodyWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutStockLocationResponse, error)
+
+	PutStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, body PutStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*PutStockLocationResponse, error)
+
+	// GetStockLocationAncestorsWithResponse request
+	GetStockLocationAncestorsWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStockLocationAncestorsResponse, error)
+
+	// GetStockLocationBalancesWithResponse request
+	GetStockLocationBalancesWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStockLocationBalancesResponse, error)
+
+	// GetStockLocationChildrenWithResponse request
+	GetStockLocationChildrenWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *GetStockLocationChildrenParams, reqEditors ...RequestEditorFn) (*GetStockLocationChildrenResponse, error)
+
+	// MoveStockLocationWithBodyWithResponse request with any body
+	MoveStockLocationWithBodyWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveStockLocationResponse, error)
+
+	MoveStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, body MoveStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveStockLocationResponse, error)
+
+	// PurgeStockLocationWithResponse request
+	PurgeStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PurgeStockLocationResponse, error)
+
+	// RestoreStockLocationWithResponse request
+	RestoreStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *RestoreStockLocationParams, reqEditors ...RequestEditorFn) (*RestoreStockLocationResponse, error)
+
+	// GetStockLotsExpiringWithResponse request
+	GetStockLotsExpiringWithResponse(ctx context.Context, params *GetStockLotsExpiringParams, reqEditors ...RequestEditorFn) (*GetStockLotsExpiringResponse, error)
+
+	// GetStockLotSuggestionsWithResponse request
+	GetStockLotSuggestionsWithResponse(ctx context.Context, params *GetStockLotSuggestionsParams, reqEditors ...RequestEditorFn) (*GetStockLotSuggestionsResponse, error)
+
+	// PostStockMovementWithBodyWithResponse request with any body
+	PostStockMovementWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockMovementResponse, error)
+
+	PostStockMovementWithResponse(ctx context.Context, body PostStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockMovementResponse, error)
+
+	// GetStockSerialWithResponse request
+	GetStockSerialWithResponse(ctx context.Context, serial StockSerialNumber, params *GetStockSerialParams, reqEditors ...RequestEditorFn) (*GetStockSerialResponse, error)
+
+	// PostStockTransferWithBodyWithResponse request with any body
+	PostStockTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockTransferResponse, error)
+
+	PostStockTransferWithResponse(ctx context.Context, body PostStockTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockTransferResponse, error)
+}
+
+type GetStockBalancesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockBalances
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockItemsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockItems
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockItemsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockItemsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostStockItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Created
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PostStockItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostStockItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteStockItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteStockItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteStockItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutStockItemResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PutStockItemResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutStockItemResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLocationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockLocations
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLocationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLocationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Created
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PostStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockLocation
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PutStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLocationAncestorsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockLocations
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLocationAncestorsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLocationAncestorsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLocationBalancesResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockBalances
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLocationBalancesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLocationBalancesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLocationChildrenResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockLocations
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLocationChildrenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLocationChildrenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type MoveStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r MoveStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r MoveStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PurgeStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON401 *Unauthorized
+	ApplicationproblemJSON403 *Forbidden
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PurgeStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PurgeStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type RestoreStockLocationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON412 *PreconditionFailed
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r RestoreStockLocationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r RestoreStockLocationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLotsExpiringResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *ExpiringStockLots
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLotsExpiringResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLotsExpiringResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockLotSuggestionsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockLotPicks
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockLotSuggestionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockLotSuggestionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostStockMovementResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Created
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PostStockMovementResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostStockMovementResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStockSerialResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *StockSerials
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON404 *NotFound
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStockSerialResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStockSerialResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostStockTransferResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON201                   *Created
+	ApplicationproblemJSON400 *BadRequest
+	ApplicationproblemJSON409 *Conflict
+	ApplicationproblemJSON415 *UnsupportedMediaType
+	ApplicationproblemJSON500 *InternalServerError
+	ApplicationproblemJSON503 *ServiceUnavailable
+}
+
+// Status returns HTTPResponse.Status
+func (r PostStockTransferResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostStockTransferResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetStockBalancesWithResponse request returning *GetStockBalancesResponse
+func (c *ClientWithResponses) GetStockBalancesWithResponse(ctx context.Context, params *GetStockBalancesParams, reqEditors ...RequestEditorFn) (*GetStockBalancesResponse, error) {
+	rsp, err := c.GetStockBalances(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockBalancesResponse(rsp)
+}
+
+// GetStockItemsWithResponse request returning *GetStockItemsResponse
+func (c *ClientWithResponses) GetStockItemsWithResponse(ctx context.Context, params *GetStockItemsParams, reqEditors ...RequestEditorFn) (*GetStockItemsResponse, error) {
+	rsp, err := c.GetStockItems(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockItemsResponse(rsp)
+}
+
+// PostStockItemWithBodyWithResponse request with arbitrary body returning *PostStockItemResponse
+func (c *ClientWithResponses) PostStockItemWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockItemResponse, error) {
+	rsp, err := c.PostStockItemWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostStockItemWithResponse(ctx context.Context, body PostStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockItemResponse, error) {
+	rsp, err := c.PostStockItem(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockItemResponse(rsp)
+}
+
+// DeleteStockItemWithResponse request returning *DeleteStockItemResponse
+func (c *ClientWithResponses) DeleteStockItemWithResponse(ctx context.Context, stockItemId openapi_types.UUID, params *DeleteStockItemParams, reqEditors ...RequestEditorFn) (*DeleteStockItemResponse, error) {
+	rsp, err := c.DeleteStockItem(ctx, stockItemId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteStockItemResponse(rsp)
+}
+
+// PutStockItemWithBodyWithResponse request with arbitrary body returning *PutStockItemResponse
+func (c *ClientWithResponses) PutStockItemWithBodyWithResponse(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutStockItemResponse, error) {
+	rsp, err := c.PutStockItemWithBody(ctx, stockItemId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutStockItemResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutStockItemWithResponse(ctx context.Context, stockItemId openapi_types.UUID, params *PutStockItemParams, body PutStockItemJSONRequestBody, reqEditors ...RequestEditorFn) (*PutStockItemResponse, error) {
+	rsp, err := c.PutStockItem(ctx, stockItemId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutStockItemResponse(rsp)
+}
+
+// GetStockLocationsWithResponse request returning *GetStockLocationsResponse
+func (c *ClientWithResponses) GetStockLocationsWithResponse(ctx context.Context, params *GetStockLocationsParams, reqEditors ...RequestEditorFn) (*GetStockLocationsResponse, error) {
+	rsp, err := c.GetStockLocations(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLocationsResponse(rsp)
+}
+
+// PostStockLocationWithBodyWithResponse request with arbitrary body returning *PostStockLocationResponse
+func (c *ClientWithResponses) PostStockLocationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockLocationResponse, error) {
+	rsp, err := c.PostStockLocationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockLocationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostStockLocationWithResponse(ctx context.Context, body PostStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockLocationResponse, error) {
+	rsp, err := c.PostStockLocation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockLocationResponse(rsp)
+}
+
+// DeleteStockLocationWithResponse request returning *DeleteStockLocationResponse
+func (c *ClientWithResponses) DeleteStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *DeleteStockLocationParams, reqEditors ...RequestEditorFn) (*DeleteStockLocationResponse, error) {
+	rsp, err := c.DeleteStockLocation(ctx, stockLocationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteStockLocationResponse(rsp)
+}
+
+// GetStockLocationWithResponse request returning *GetStockLocationResponse
+func (c *ClientWithResponses) GetStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStockLocationResponse, error) {
+	rsp, err := c.GetStockLocation(ctx, stockLocationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLocationResponse(rsp)
+}
+
+// PutStockLocationWithBodyWithResponse request with arbitrary body returning *PutStockLocationResponse
+func (c *ClientWithResponses) PutStockLocationWithBodyWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutStockLocationResponse, error) {
+	rsp, err := c.PutStockLocationWithBody(ctx, stockLocationId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutStockLocationResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *PutStockLocationParams, body PutStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*PutStockLocationResponse, error) {
+	rsp, err := c.PutStockLocation(ctx, stockLocationId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutStockLocationResponse(rsp)
+}
+
+// GetStockLocationAncestorsWithResponse request returning *GetStockLocationAncestorsResponse
+func (c *ClientWithResponses) GetStockLocationAncestorsWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStockLocationAncestorsResponse, error) {
+	rsp, err := c.GetStockLocationAncestors(ctx, stockLocationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLocationAncestorsResponse(rsp)
+}
+
+// GetStockLocationBalancesWithResponse request returning *GetStockLocationBalancesResponse
+func (c *ClientWithResponses) GetStockLocationBalancesWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*GetStockLocationBalancesResponse, error) {
+	rsp, err := c.GetStockLocationBalances(ctx, stockLocationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLocationBalancesResponse(rsp)
+}
+
+// GetStockLocationChildrenWithResponse request returning *GetStockLocationChildrenResponse
+func (c *ClientWithResponses) GetStockLocationChildrenWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *GetStockLocationChildrenParams, reqEditors ...RequestEditorFn) (*GetStockLocationChildrenResponse, error) {
+	rsp, err := c.GetStockLocationChildren(ctx, stockLocationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLocationChildrenResponse(rsp)
+}
+
+// MoveStockLocationWithBodyWithResponse request with arbitrary body returning *MoveStockLocationResponse
+func (c *ClientWithResponses) MoveStockLocationWithBodyWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*MoveStockLocationResponse, error) {
+	rsp, err := c.MoveStockLocationWithBody(ctx, stockLocationId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveStockLocationResponse(rsp)
+}
+
+func (c *ClientWithResponses) MoveStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *MoveStockLocationParams, body MoveStockLocationJSONRequestBody, reqEditors ...RequestEditorFn) (*MoveStockLocationResponse, error) {
+	rsp, err := c.MoveStockLocation(ctx, stockLocationId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseMoveStockLocationResponse(rsp)
+}
+
+// PurgeStockLocationWithResponse request returning *PurgeStockLocationResponse
+func (c *ClientWithResponses) PurgeStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, reqEditors ...RequestEditorFn) (*PurgeStockLocationResponse, error) {
+	rsp, err := c.PurgeStockLocation(ctx, stockLocationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePurgeStockLocationResponse(rsp)
+}
+
+// RestoreStockLocationWithResponse request returning *RestoreStockLocationResponse
+func (c *ClientWithResponses) RestoreStockLocationWithResponse(ctx context.Context, stockLocationId openapi_types.UUID, params *RestoreStockLocationParams, reqEditors ...RequestEditorFn) (*RestoreStockLocationResponse, error) {
+	rsp, err := c.RestoreStockLocation(ctx, stockLocationId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseRestoreStockLocationResponse(rsp)
+}
+
+// GetStockLotsExpiringWithResponse request returning *GetStockLotsExpiringResponse
+func (c *ClientWithResponses) GetStockLotsExpiringWithResponse(ctx context.Context, params *GetStockLotsExpiringParams, reqEditors ...RequestEditorFn) (*GetStockLotsExpiringResponse, error) {
+	rsp, err := c.GetStockLotsExpiring(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLotsExpiringResponse(rsp)
+}
+
+// GetStockLotSuggestionsWithResponse request returning *GetStockLotSuggestionsResponse
+func (c *ClientWithResponses) GetStockLotSuggestionsWithResponse(ctx context.Context, params *GetStockLotSuggestionsParams, reqEditors ...RequestEditorFn) (*GetStockLotSuggestionsResponse, error) {
+	rsp, err := c.GetStockLotSuggestions(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockLotSuggestionsResponse(rsp)
+}
+
+// PostStockMovementWithBodyWithResponse request with arbitrary body returning *PostStockMovementResponse
+func (c *ClientWithResponses) PostStockMovementWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockMovementResponse, error) {
+	rsp, err := c.PostStockMovementWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockMovementResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostStockMovementWithResponse(ctx context.Context, body PostStockMovementJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockMovementResponse, error) {
+	rsp, err := c.PostStockMovement(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockMovementResponse(rsp)
+}
+
+// GetStockSerialWithResponse request returning *GetStockSerialResponse
+func (c *ClientWithResponses) GetStockSerialWithResponse(ctx context.Context, serial StockSerialNumber, params *GetStockSerialParams, reqEditors ...RequestEditorFn) (*GetStockSerialResponse, error) {
+	rsp, err := c.GetStockSerial(ctx, serial, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStockSerialResponse(rsp)
+}
+
+// PostStockTransferWithBodyWithResponse request with arbitrary body returning *PostStockTransferResponse
+func (c *ClientWithResponses) PostStockTransferWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostStockTransferResponse, error) {
+	rsp, err := c.PostStockTransferWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockTransferResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostStockTransferWithResponse(ctx context.Context, body PostStockTransferJSONRequestBody, reqEditors ...RequestEditorFn) (*PostStockTransferResponse, error) {
+	rsp, err := c.PostStockTransfer(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostStockTransferResponse(rsp)
+}
+
+// ParseGetStockBalancesResponse parses an HTTP response from a GetStockBalancesWithResponse call
+func ParseGetStockBalancesResponse(rsp *http.Response) (*GetStockBalancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockBalancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockBalances
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockItemsResponse parses an HTTP response from a GetStockItemsWithResponse call
+func ParseGetStockItemsResponse(rsp *http.Response) (*GetStockItemsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockItemsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockItems
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostStockItemResponse parses an HTTP response from a PostStockItemWithResponse call
+func ParsePostStockItemResponse(rsp *http.Response) (*PostStockItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostStockItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Created
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteStockItemResponse parses an HTTP response from a DeleteStockItemWithResponse call
+func ParseDeleteStockItemResponse(rsp *http.Response) (*DeleteStockItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteStockItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutStockItemResponse parses an HTTP response from a PutStockItemWithResponse call
+func ParsePutStockItemResponse(rsp *http.Response) (*PutStockItemResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutStockItemResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLocationsResponse parses an HTTP response from a GetStockLocationsWithResponse call
+func ParseGetStockLocationsResponse(rsp *http.Response) (*GetStockLocationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLocationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLocations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostStockLocationResponse parses an HTTP response from a PostStockLocationWithResponse call
+func ParsePostStockLocationResponse(rsp *http.Response) (*PostStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Created
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteStockLocationResponse parses an HTTP response from a DeleteStockLocationWithResponse call
+func ParseDeleteStockLocationResponse(rsp *http.Response) (*DeleteStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLocationResponse parses an HTTP response from a GetStockLocationWithResponse call
+func ParseGetStockLocationResponse(rsp *http.Response) (*GetStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLocation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutStockLocationResponse parses an HTTP response from a PutStockLocationWithResponse call
+func ParsePutStockLocationResponse(rsp *http.Response) (*PutStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLocationAncestorsResponse parses an HTTP response from a GetStockLocationAncestorsWithResponse call
+func ParseGetStockLocationAncestorsResponse(rsp *http.Response) (*GetStockLocationAncestorsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLocationAncestorsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLocations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLocationBalancesResponse parses an HTTP response from a GetStockLocationBalancesWithResponse call
+func ParseGetStockLocationBalancesResponse(rsp *http.Response) (*GetStockLocationBalancesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLocationBalancesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockBalances
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLocationChildrenResponse parses an HTTP response from a GetStockLocationChildrenWithResponse call
+func ParseGetStockLocationChildrenResponse(rsp *http.Response) (*GetStockLocationChildrenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLocationChildrenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLocations
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseMoveStockLocationResponse parses an HTTP response from a MoveStockLocationWithResponse call
+func ParseMoveStockLocationResponse(rsp *http.Response) (*MoveStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &MoveStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePurgeStockLocationResponse parses an HTTP response from a PurgeStockLocationWithResponse call
+func ParsePurgeStockLocationResponse(rsp *http.Response) (*PurgeStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PurgeStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseRestoreStockLocationResponse parses an HTTP response from a RestoreStockLocationWithResponse call
+func ParseRestoreStockLocationResponse(rsp *http.Response) (*RestoreStockLocationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &RestoreStockLocationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest PreconditionFailed
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLotsExpiringResponse parses an HTTP response from a GetStockLotsExpiringWithResponse call
+func ParseGetStockLotsExpiringResponse(rsp *http.Response) (*GetStockLotsExpiringResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLotsExpiringResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ExpiringStockLots
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockLotSuggestionsResponse parses an HTTP response from a GetStockLotSuggestionsWithResponse call
+func ParseGetStockLotSuggestionsResponse(rsp *http.Response) (*GetStockLotSuggestionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockLotSuggestionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockLotPicks
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostStockMovementResponse parses an HTTP response from a PostStockMovementWithResponse call
+func ParsePostStockMovementResponse(rsp *http.Response) (*PostStockMovementResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostStockMovementResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Created
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStockSerialResponse parses an HTTP response from a GetStockSerialWithResponse call
+func ParseGetStockSerialResponse(rsp *http.Response) (*GetStockSerialResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStockSerialResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest StockSerials
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest NotFound
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostStockTransferResponse parses an HTTP response from a PostStockTransferWithResponse call
+func ParsePostStockTransferResponse(rsp *http.Response) (*PostStockTransferResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostStockTransferResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Created
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest BadRequest
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Conflict
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 415:
+		var dest UnsupportedMediaType
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON415 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest InternalServerError
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON500 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest ServiceUnavailable
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationproblemJSON503 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1 @@
+DROP INDEX IF EXISTS stock_location_name_id_idx;
@@ -0,0 +1 @@
+CREATE INDEX IF NOT EXISTS stock_location_name_id_idx ON stock_location (name, id);
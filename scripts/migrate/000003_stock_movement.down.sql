@@ -0,0 +1,5 @@
+DROP TABLE IF EXISTS stock_movement;
+
+DROP FUNCTION IF EXISTS stock_movement_append_only();
+
+ALTER TABLE stock_location DROP COLUMN IF EXISTS allow_negative_stock;
@@ -0,0 +1,26 @@
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS allow_negative_stock BOOLEAN NOT NULL DEFAULT FALSE;
+
+CREATE TABLE IF NOT EXISTS stock_movement (
+    id TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    location_id TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    quantity BIGINT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT stock_movement_pkey PRIMARY KEY ("id"),
+    CONSTRAINT stock_movement_kind_check CHECK (kind IN ('receipt', 'issue', 'adjustment'))
+);
+
+CREATE INDEX IF NOT EXISTS stock_movement_item_id_location_id_idx ON stock_movement (item_id, location_id);
+
+-- The ledger is append-only: balances are derived from it, so rows are never rewritten.
+CREATE OR REPLACE FUNCTION stock_movement_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movement is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movement_append_only
+    BEFORE UPDATE OR DELETE ON stock_movement
+    FOR EACH ROW EXECUTE FUNCTION stock_movement_append_only();
@@ -0,0 +1,10 @@
+DROP TABLE IF EXISTS stock_transfer;
+
+DROP FUNCTION IF EXISTS stock_transfer_append_only();
+
+ALTER TABLE stock_movement DISABLE TRIGGER stock_movement_append_only;
+DELETE FROM stock_movement WHERE kind IN ('transfer_out', 'transfer_in');
+ALTER TABLE stock_movement ENABLE TRIGGER stock_movement_append_only;
+
+ALTER TABLE stock_movement DROP CONSTRAINT IF EXISTS stock_movement_kind_check;
+ALTER TABLE stock_movement ADD CONSTRAINT stock_movement_kind_check CHECK (kind IN ('receipt', 'issue', 'adjustment'));
@@ -0,0 +1,27 @@
+ALTER TABLE stock_movement DROP CONSTRAINT IF EXISTS stock_movement_kind_check;
+ALTER TABLE stock_movement ADD CONSTRAINT stock_movement_kind_check CHECK (kind IN ('receipt', 'issue', 'adjustment', 'transfer_out', 'transfer_in'));
+
+CREATE TABLE IF NOT EXISTS stock_transfer (
+    id TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    from_location_id TEXT NOT NULL,
+    to_location_id TEXT NOT NULL,
+    quantity BIGINT NOT NULL,
+    out_movement_id TEXT NOT NULL,
+    in_movement_id TEXT NOT NULL,
+    created_at TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP,
+
+    CONSTRAINT stock_transfer_pkey PRIMARY KEY ("id"),
+    CONSTRAINT stock_transfer_quantity_check CHECK (quantity > 0),
+    CONSTRAINT stock_transfer_location_check CHECK (from_location_id <> to_location_id)
+);
+
+CREATE OR REPLACE FUNCTION stock_transfer_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_transfer is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_transfer_append_only
+    BEFORE UPDATE OR DELETE ON stock_transfer
+    FOR EACH ROW EXECUTE FUNCTION stock_transfer_append_only();
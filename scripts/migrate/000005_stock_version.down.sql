@@ -0,0 +1,3 @@
+ALTER TABLE stock_item DROP COLUMN IF EXISTS version;
+
+ALTER TABLE stock_location DROP COLUMN IF EXISTS version;
@@ -0,0 +1,3 @@
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
@@ -0,0 +1,5 @@
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS deleted_at TIMESTAMP(6) NULL;
+
+UPDATE stock_location SET deleted_at = updated_at WHERE deleted AND deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS stock_location_deleted_at_idx ON stock_location (deleted_at) WHERE deleted;
@@ -0,0 +1,2 @@
+-- the names normalized or renamed by the up migration are kept
+DROP INDEX IF EXISTS stock_location_name_active_key;
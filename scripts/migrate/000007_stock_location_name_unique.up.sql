@@ -0,0 +1,12 @@
+-- names are stored the way the service normalizes them, without surrounding white space and in NFC
+UPDATE stock_location SET name = btrim(normalize(name, NFC), E' \t\n\r\f\v'), version = version + 1, updated_at = CURRENT_TIMESTAMP
+WHERE name <> btrim(normalize(name, NFC), E' \t\n\r\f\v');
+
+-- of the active locations sharing a name, all but the first created are renamed after their id
+UPDATE stock_location SET name = left(stock_location.name, 61) || ' (' || stock_location.id || ')', version = version + 1, updated_at = CURRENT_TIMESTAMP
+FROM (
+    SELECT id, row_number() OVER (PARTITION BY name ORDER BY created_at, id) AS n FROM stock_location WHERE NOT deleted
+) AS duplicate
+WHERE stock_location.id = duplicate.id AND duplicate.n > 1;
+
+CREATE UNIQUE INDEX IF NOT EXISTS stock_location_name_active_key ON stock_location (name) WHERE NOT deleted;
@@ -0,0 +1,7 @@
+ALTER TABLE stock_item DROP COLUMN IF EXISTS unit_weight;
+ALTER TABLE stock_item DROP COLUMN IF EXISTS unit_volume;
+
+ALTER TABLE stock_location DROP COLUMN IF EXISTS max_weight;
+ALTER TABLE stock_location DROP COLUMN IF EXISTS max_volume;
+ALTER TABLE stock_location DROP COLUMN IF EXISTS max_units;
+ALTER TABLE stock_location DROP COLUMN IF EXISTS type;
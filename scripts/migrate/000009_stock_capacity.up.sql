@@ -0,0 +1,11 @@
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS type TEXT NOT NULL DEFAULT 'warehouse'
+    CONSTRAINT stock_location_type_check CHECK (type IN ('warehouse', 'zone', 'bin', 'staging', 'quarantine', 'virtual'));
+
+-- a NULL limit does not apply
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS max_units BIGINT NULL;
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS max_volume BIGINT NULL;
+ALTER TABLE stock_location ADD COLUMN IF NOT EXISTS max_weight BIGINT NULL;
+
+-- in cubic centimetres and grams per unit, 0 when the item does not declare them
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS unit_volume BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS unit_weight BIGINT NOT NULL DEFAULT 0;
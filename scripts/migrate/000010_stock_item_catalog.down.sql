@@ -0,0 +1,6 @@
+ALTER TABLE stock_item DROP COLUMN IF EXISTS pack_sizes;
+ALTER TABLE stock_item DROP COLUMN IF EXISTS barcodes;
+ALTER TABLE stock_item DROP COLUMN IF EXISTS base_unit;
+
+DROP INDEX IF EXISTS stock_item_sku_active_key;
+ALTER TABLE stock_item DROP COLUMN IF EXISTS sku;
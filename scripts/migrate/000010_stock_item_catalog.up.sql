@@ -0,0 +1,12 @@
+-- the items created before SKUs existed are given their id as SKU, upper-cased as the service stores SKUs
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS sku TEXT NULL;
+UPDATE stock_item SET sku = upper(id::text) WHERE sku IS NULL;
+ALTER TABLE stock_item ALTER COLUMN sku SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS stock_item_sku_active_key ON stock_item (sku) WHERE NOT deleted;
+
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS base_unit TEXT NOT NULL DEFAULT 'each';
+
+-- JSON arrays of the barcodes and of the pack sizes as {"unit": "case", "quantity": 12}
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS barcodes TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE stock_item ADD COLUMN IF NOT EXISTS pack_sizes TEXT NOT NULL DEFAULT '[]';
@@ -0,0 +1,5 @@
+ALTER TABLE stock_movement DROP COLUMN IF EXISTS lot_number;
+
+DROP TABLE IF EXISTS stock_lot;
+
+ALTER TABLE stock_item DROP COLUMN IF EXISTS lot_tracked;
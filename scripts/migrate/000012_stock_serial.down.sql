@@ -0,0 +1,5 @@
+ALTER TABLE stock_movement DROP COLUMN IF EXISTS serials;
+
+DROP TABLE IF EXISTS stock_serial;
+
+ALTER TABLE stock_item DROP COLUMN IF EXISTS serialized;
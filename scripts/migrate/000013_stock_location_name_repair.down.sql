@@ -0,0 +1 @@
+-- the repaired names are kept, as they are valid names
@@ -0,0 +1,34 @@
+-- stock_location_name_unique only trimmed ASCII white space, which left names the service cannot read, such as
+-- those with control characters or surrounding Unicode spaces. Every name is stored the way the service normalized
+-- names when this migration was written: control characters turned into spaces, surrounding white space trimmed,
+-- NFC and at most 100 characters. A name with nothing left is replaced by the id in parentheses, and of the active
+-- locations that end up sharing a name, all but the first created are renamed after their id.
+CREATE TEMPORARY TABLE stock_location_name_repair AS
+WITH spaces AS (
+    -- the white space of Unicode that is not a control character
+    SELECT E' \u00a0\u1680\u2000\u2001\u2002\u2003\u2004\u2005\u2006\u2007\u2008\u2009\u200a\u2028\u2029\u202f\u205f\u3000' AS chars
+), normalized AS (
+    SELECT id, deleted, created_at,
+        btrim(left(normalize(btrim(regexp_replace(name, '[\x01-\x1f\x7f-\x9f]', ' ', 'g'), spaces.chars), NFC), 100), spaces.chars) AS name
+    FROM stock_location, spaces
+), ranked AS (
+    SELECT id, deleted, CASE WHEN name = '' THEN '(' || id || ')' ELSE name END AS name,
+        row_number() OVER (PARTITION BY deleted, CASE WHEN name = '' THEN '(' || id || ')' ELSE name END ORDER BY created_at, id) AS n
+    FROM normalized
+), repaired AS (
+    SELECT id, CASE WHEN NOT deleted AND n > 1 THEN left(name, 61) || ' (' || id || ')' ELSE name END AS name
+    FROM ranked
+)
+SELECT repaired.id, repaired.name
+FROM repaired JOIN stock_location ON stock_location.id = repaired.id
+WHERE stock_location.name <> repaired.name;
+
+-- the names are first moved out of the way to ones no valid name can be, so that the unique index of the active
+-- names never sees a name that another row is about to give up
+UPDATE stock_location SET name = chr(1) || stock_location.id
+FROM stock_location_name_repair AS repair WHERE stock_location.id = repair.id;
+
+UPDATE stock_location SET name = repair.name, version = version + 1, updated_at = CURRENT_TIMESTAMP
+FROM stock_location_name_repair AS repair WHERE stock_location.id = repair.id;
+
+DROP TABLE stock_location_name_repair;
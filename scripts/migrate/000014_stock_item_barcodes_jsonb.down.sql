@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS stock_item_barcodes_active_idx;
+
+ALTER TABLE stock_item ALTER COLUMN barcodes DROP DEFAULT;
+ALTER TABLE stock_item ALTER COLUMN barcodes TYPE TEXT USING barcodes::text;
+ALTER TABLE stock_item ALTER COLUMN barcodes SET DEFAULT '[]';
@@ -0,0 +1,6 @@
+-- barcodes are searched by containment, which the GIN index answers for the active items
+ALTER TABLE stock_item ALTER COLUMN barcodes DROP DEFAULT;
+ALTER TABLE stock_item ALTER COLUMN barcodes TYPE JSONB USING barcodes::jsonb;
+ALTER TABLE stock_item ALTER COLUMN barcodes SET DEFAULT '[]';
+
+CREATE INDEX IF NOT EXISTS stock_item_barcodes_active_idx ON stock_item USING GIN (barcodes jsonb_path_ops) WHERE NOT deleted;
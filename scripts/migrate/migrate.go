@@ -0,0 +1,7 @@
+// Package migrate holds the migrations of the PostgreSQL database.
+package migrate
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
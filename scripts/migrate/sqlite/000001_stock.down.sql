@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS stock_transfer;
+
+DROP TABLE IF EXISTS stock_movement;
+
+DROP TABLE IF EXISTS stock_item;
+
+DROP TABLE IF EXISTS stock_location;
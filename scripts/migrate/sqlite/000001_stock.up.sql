@@ -0,0 +1,78 @@
+CREATE TABLE IF NOT EXISTS stock_location (
+    id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    deleted INTEGER NOT NULL DEFAULT FALSE,
+    allow_negative_stock INTEGER NOT NULL DEFAULT FALSE,
+    version INTEGER NOT NULL DEFAULT 1,
+
+    CONSTRAINT stock_location_pkey PRIMARY KEY ("id")
+);
+
+CREATE INDEX IF NOT EXISTS stock_location_name_id_idx ON stock_location (name, id);
+
+CREATE TABLE IF NOT EXISTS stock_item (
+    id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    updated_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+    deleted INTEGER NOT NULL DEFAULT FALSE,
+    version INTEGER NOT NULL DEFAULT 1,
+
+    CONSTRAINT stock_item_pkey PRIMARY KEY ("id")
+);
+
+CREATE TABLE IF NOT EXISTS stock_movement (
+    id TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    location_id TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+
+    CONSTRAINT stock_movement_pkey PRIMARY KEY ("id"),
+    CONSTRAINT stock_movement_kind_check CHECK (kind IN ('receipt', 'issue', 'adjustment', 'transfer_out', 'transfer_in'))
+);
+
+CREATE INDEX IF NOT EXISTS stock_movement_item_id_location_id_idx ON stock_movement (item_id, location_id);
+
+-- The ledger is append-only: balances are derived from it, so rows are never rewritten.
+CREATE TRIGGER IF NOT EXISTS stock_movement_append_only_update
+    BEFORE UPDATE ON stock_movement
+BEGIN
+    SELECT RAISE(ABORT, 'stock_movement is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS stock_movement_append_only_delete
+    BEFORE DELETE ON stock_movement
+BEGIN
+    SELECT RAISE(ABORT, 'stock_movement is append-only');
+END;
+
+CREATE TABLE IF NOT EXISTS stock_transfer (
+    id TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    from_location_id TEXT NOT NULL,
+    to_location_id TEXT NOT NULL,
+    quantity INTEGER NOT NULL,
+    out_movement_id TEXT NOT NULL,
+    in_movement_id TEXT NOT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+
+    CONSTRAINT stock_transfer_pkey PRIMARY KEY ("id"),
+    CONSTRAINT stock_transfer_quantity_check CHECK (quantity > 0),
+    CONSTRAINT stock_transfer_location_check CHECK (from_location_id <> to_location_id)
+);
+
+CREATE TRIGGER IF NOT EXISTS stock_transfer_append_only_update
+    BEFORE UPDATE ON stock_transfer
+BEGIN
+    SELECT RAISE(ABORT, 'stock_transfer is append-only');
+END;
+
+CREATE TRIGGER IF NOT EXISTS stock_transfer_append_only_delete
+    BEFORE DELETE ON stock_transfer
+BEGIN
+    SELECT RAISE(ABORT, 'stock_transfer is append-only');
+END;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS stock_location_deleted_at_idx;
+
+ALTER TABLE stock_location DROP COLUMN deleted_at;
@@ -0,0 +1,13 @@
+-- SQLite cannot normalize to NFC, which the service does when the names are saved next
+UPDATE stock_location SET name = trim(name, ' ' || char(9, 10, 11, 12, 13)), version = version + 1, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
+WHERE name <> trim(name, ' ' || char(9, 10, 11, 12, 13));
+
+-- of the active locations sharing a name, all but the first created are renamed after their id
+UPDATE stock_location SET name = substr(name, 1, 61) || ' (' || id || ')', version = version + 1, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
+WHERE id IN (
+    SELECT id FROM (
+        SELECT id, row_number() OVER (PARTITION BY name ORDER BY created_at, id) AS n FROM stock_location WHERE NOT deleted
+    ) WHERE n > 1
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS stock_location_name_active_key ON stock_location (name) WHERE NOT deleted;
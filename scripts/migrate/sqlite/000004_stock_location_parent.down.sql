@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS stock_location_parent_id_idx;
+
+ALTER TABLE stock_location DROP COLUMN parent_id;
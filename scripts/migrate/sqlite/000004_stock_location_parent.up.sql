@@ -0,0 +1,3 @@
+ALTER TABLE stock_location ADD COLUMN parent_id TEXT NULL;
+
+CREATE INDEX IF NOT EXISTS stock_location_parent_id_idx ON stock_location (parent_id) WHERE parent_id IS NOT NULL;
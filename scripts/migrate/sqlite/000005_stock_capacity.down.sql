@@ -0,0 +1,7 @@
+ALTER TABLE stock_item DROP COLUMN unit_weight;
+ALTER TABLE stock_item DROP COLUMN unit_volume;
+
+ALTER TABLE stock_location DROP COLUMN max_weight;
+ALTER TABLE stock_location DROP COLUMN max_volume;
+ALTER TABLE stock_location DROP COLUMN max_units;
+ALTER TABLE stock_location DROP COLUMN type;
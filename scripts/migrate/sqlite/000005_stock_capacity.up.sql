@@ -0,0 +1,11 @@
+ALTER TABLE stock_location ADD COLUMN type TEXT NOT NULL DEFAULT 'warehouse'
+    CONSTRAINT stock_location_type_check CHECK (type IN ('warehouse', 'zone', 'bin', 'staging', 'quarantine', 'virtual'));
+
+-- a NULL limit does not apply
+ALTER TABLE stock_location ADD COLUMN max_units INTEGER NULL;
+ALTER TABLE stock_location ADD COLUMN max_volume INTEGER NULL;
+ALTER TABLE stock_location ADD COLUMN max_weight INTEGER NULL;
+
+-- in cubic centimetres and grams per unit, 0 when the item does not declare them
+ALTER TABLE stock_item ADD COLUMN unit_volume INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE stock_item ADD COLUMN unit_weight INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,6 @@
+ALTER TABLE stock_item DROP COLUMN pack_sizes;
+ALTER TABLE stock_item DROP COLUMN barcodes;
+ALTER TABLE stock_item DROP COLUMN base_unit;
+
+DROP INDEX IF EXISTS stock_item_sku_active_key;
+ALTER TABLE stock_item DROP COLUMN sku;
@@ -0,0 +1,11 @@
+-- the items created before SKUs existed are given their id as SKU, upper-cased as the service stores SKUs
+ALTER TABLE stock_item ADD COLUMN sku TEXT NOT NULL DEFAULT '';
+UPDATE stock_item SET sku = upper(id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS stock_item_sku_active_key ON stock_item (sku) WHERE NOT deleted;
+
+ALTER TABLE stock_item ADD COLUMN base_unit TEXT NOT NULL DEFAULT 'each';
+
+-- JSON arrays of the barcodes and of the pack sizes as {"unit": "case", "quantity": 12}
+ALTER TABLE stock_item ADD COLUMN barcodes TEXT NOT NULL DEFAULT '[]';
+ALTER TABLE stock_item ADD COLUMN pack_sizes TEXT NOT NULL DEFAULT '[]';
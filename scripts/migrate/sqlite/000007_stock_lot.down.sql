@@ -0,0 +1,5 @@
+ALTER TABLE stock_movement DROP COLUMN lot_number;
+
+DROP TABLE IF EXISTS stock_lot;
+
+ALTER TABLE stock_item DROP COLUMN lot_tracked;
@@ -0,0 +1,21 @@
+ALTER TABLE stock_item ADD COLUMN lot_tracked INTEGER NOT NULL DEFAULT FALSE;
+
+-- a lot is recorded by the first receipt of its number and never changes; the dates are written
+-- as 2006-01-02, and NULL ones are unknown
+CREATE TABLE IF NOT EXISTS stock_lot (
+    id TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    number TEXT NOT NULL,
+    manufactured_on TEXT NULL,
+    expires_on TEXT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+
+    CONSTRAINT stock_lot_pkey PRIMARY KEY ("id"),
+    CONSTRAINT stock_lot_item_id_number_key UNIQUE (item_id, number),
+    CONSTRAINT stock_lot_dates_check CHECK (expires_on >= manufactured_on)
+);
+
+CREATE INDEX IF NOT EXISTS stock_lot_expires_on_idx ON stock_lot (expires_on);
+
+-- the lot of the movements of lot tracked items, NULL for the other items
+ALTER TABLE stock_movement ADD COLUMN lot_number TEXT NULL;
@@ -0,0 +1,19 @@
+ALTER TABLE stock_item ADD COLUMN serialized INTEGER NOT NULL DEFAULT FALSE;
+
+-- a serial is registered by the first receipt of its number; location_id is where the unit is,
+-- NULL while it is not in stock
+CREATE TABLE IF NOT EXISTS stock_serial (
+    id TEXT NOT NULL,
+    item_id TEXT NOT NULL,
+    number TEXT NOT NULL,
+    location_id TEXT NULL,
+    created_at TEXT NOT NULL DEFAULT (strftime('%Y-%m-%d %H:%M:%f', 'now')),
+
+    CONSTRAINT stock_serial_pkey PRIMARY KEY ("id"),
+    CONSTRAINT stock_serial_item_id_number_key UNIQUE (item_id, number)
+);
+
+CREATE INDEX IF NOT EXISTS stock_serial_number_idx ON stock_serial (number);
+
+-- a JSON array of the serials the movements of serialized items move, empty for the other items
+ALTER TABLE stock_movement ADD COLUMN serials TEXT NOT NULL DEFAULT '[]';
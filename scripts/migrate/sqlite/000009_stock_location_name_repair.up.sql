@@ -0,0 +1,47 @@
+-- stock_location_name_unique only trimmed ASCII white space, which left names the service cannot read, such as
+-- those with control characters or surrounding Unicode spaces. Every name is stored the way the service normalized
+-- names when this migration was written: control characters turned into spaces, surrounding white space trimmed
+-- and at most 100 characters. SQLite cannot normalize to NFC, which the service does when it reads the names.
+-- A name with nothing left is replaced by the id in parentheses, and of the active locations that end up sharing
+-- a name, all but the first created are renamed after their id.
+CREATE TEMP TABLE stock_location_name_repair AS
+WITH RECURSIVE mapped (id, i, name) AS (
+    -- the names are copied a character at a time, control characters as spaces
+    SELECT id, 0, '' FROM stock_location
+    UNION ALL
+    SELECT mapped.id, mapped.i + 1, mapped.name || CASE
+        WHEN unicode(substr(stock_location.name, mapped.i + 1, 1)) BETWEEN 1 AND 31
+            OR unicode(substr(stock_location.name, mapped.i + 1, 1)) BETWEEN 127 AND 159 THEN ' '
+        ELSE substr(stock_location.name, mapped.i + 1, 1)
+    END
+    FROM mapped JOIN stock_location ON stock_location.id = mapped.id
+    WHERE mapped.i < length(stock_location.name)
+), spaces AS (
+    -- the white space of Unicode that is not a control character
+    SELECT ' ' || char(160, 5760, 8192, 8193, 8194, 8195, 8196, 8197, 8198, 8199, 8200, 8201, 8202, 8232, 8233, 8239, 8287, 12288) AS chars
+), normalized AS (
+    SELECT stock_location.id, stock_location.deleted, stock_location.created_at,
+        trim(substr(trim(mapped.name, spaces.chars), 1, 100), spaces.chars) AS name
+    FROM stock_location JOIN mapped ON mapped.id = stock_location.id AND mapped.i = length(stock_location.name), spaces
+), ranked AS (
+    SELECT id, deleted, CASE WHEN name = '' THEN '(' || id || ')' ELSE name END AS name,
+        row_number() OVER (PARTITION BY deleted, CASE WHEN name = '' THEN '(' || id || ')' ELSE name END ORDER BY created_at, id) AS n
+    FROM normalized
+), repaired AS (
+    SELECT id, CASE WHEN NOT deleted AND n > 1 THEN substr(name, 1, 61) || ' (' || id || ')' ELSE name END AS name
+    FROM ranked
+)
+SELECT repaired.id, repaired.name
+FROM repaired JOIN stock_location ON stock_location.id = repaired.id
+WHERE stock_location.name <> repaired.name;
+
+-- the names are first moved out of the way to ones no valid name can be, so that the unique index of the active
+-- names never sees a name that another row is about to give up
+UPDATE stock_location SET name = char(1) || id
+WHERE id IN (SELECT id FROM stock_location_name_repair);
+
+UPDATE stock_location SET name = (SELECT name FROM stock_location_name_repair AS repair WHERE repair.id = stock_location.id),
+    version = version + 1, updated_at = strftime('%Y-%m-%d %H:%M:%f', 'now')
+WHERE id IN (SELECT id FROM stock_location_name_repair);
+
+DROP TABLE stock_location_name_repair;
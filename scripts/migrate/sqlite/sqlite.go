@@ -0,0 +1,8 @@
+// Package sqlite holds the migrations of the SQLite database, which starts from the schema
+// the PostgreSQL migrations in the parent directory have built up.
+package sqlite
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS